@@ -8,10 +8,15 @@ import (
 	"hbd/encryption"
 	"hbd/env"
 	"hbd/helper"
+	"hbd/invitations"
+	"hbd/lists"
 	"hbd/models"
+	"hbd/organizations"
 	"hbd/structs"
 	"hbd/telegram"
+	"log"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -42,13 +47,21 @@ func GetPassword(c *gin.Context) {
 	c.JSON(http.StatusOK, structs.Password{Password: hex.EncodeToString(key)})
 }
 
+// openRegistration checks if anyone can register, from HBD_OPEN_REGISTRATION. Otherwise users can only register through an invitation.
+func openRegistration() bool {
+	return os.Getenv("HBD_OPEN_REGISTRATION") != "false"
+}
+
 // @Summary Register a new user
-// @Description This endpoint registers a new user with their email, Telegram bot API key, and other details.
+// @Description This endpoint registers a new user with their email, Telegram bot API key, and other details. With the token of an invitation the user also joins its shared list or organization, which is required when open registration is disabled.
 // @Accept  json
 // @Produce  json
 // @Param   user  body     structs.RegisterRequest  true  "Register user"
 // @Success 200 {object} structs.LoginSuccess
 // @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 403 {object} structs.Error "Registration is by invitation only"
+// @Failure 403 {object} structs.Error "This invitation was sent to another email"
+// @Failure 404 {object} structs.Error "Invitation not found, it may have expired or been used already"
 // @Failure 409 {object} structs.Error "Email or Telegram bot API key already registered"
 // @Failure 500 {object} structs.Error "Failed to create user"
 // @Router /register [post]
@@ -92,9 +105,24 @@ func Register(c *gin.Context) {
 		return
 	}
 
-	// Hash the password to check for uniqueness
-	PasswordHash := encryption.HashStringWithSHA256(req.Password)
-	if _, err = models.Users(models.UserWhere.PasswordHash.EQ(PasswordHash)).Exists(c, env.DB); helper.HE(c, err, http.StatusInternalServerError, "failed to check existing user", false) {
+	// Check the invitation, which is required to register when open registration is disabled
+	var invitation *models.Invitation
+	if req.InviteToken != "" {
+		invitation, err = invitations.Find(c, env.DB, req.InviteToken, time.Now().UTC())
+		if err == nil {
+			err = invitations.CheckEmail(invitation, emailHash)
+		}
+		if invitations.HE(c, err, "failed to check invitation") {
+			return
+		}
+	} else if !openRegistration() {
+		c.JSON(http.StatusForbidden, structs.Error{Error: "Registration is by invitation only"})
+		return
+	}
+
+	// Hash the password with a random salt
+	passwordHash, err := encryption.HashPassword(req.Password)
+	if helper.HE(c, err, http.StatusInternalServerError, "failed to hash password", false) {
 		return
 	}
 
@@ -125,7 +153,7 @@ func Register(c *gin.Context) {
 	// Create a new user object
 	user := models.User{
 		EmailHash:             emailHash,
-		PasswordHash:          encryption.HashStringWithSHA256(req.Password),
+		PasswordHash:          passwordHash,
 		ReminderTime:          reminderTime.Format("15:04"),
 		Timezone:              req.Timezone,
 		TelegramBotAPIKey:     hex.EncodeToString(encryptedBotAPIKey),
@@ -134,27 +162,44 @@ func Register(c *gin.Context) {
 		TelegramUserIDHash:    encryption.HashStringWithSHA256(req.TelegramUserID),
 	}
 
-	err = user.Insert(c, env.DB, boil.Infer())
-	if helper.HE(c, err, http.StatusInternalServerError, "failed to create user", false) {
+	// Start a new transaction so the user joins the list or organization of the invitation along with the registration
+	tx, err := env.DB.Begin()
+	if helper.HE(c, err, http.StatusInternalServerError, "failed to begin transaction", false) {
 		return
 	}
 
-	// As the user was successfully created, send a telegram message through the bot and ID to confirm the registration
-	telegram.SendTelegramMessage(req.TelegramBotAPIKey, req.TelegramUserID, fmt.Sprintf("🎂 Your user has been successfully registered, through this bot and user ID you'll receive your birthday reminders (if there's any) at %s (Timezone: %s).\n\nIf you encounter any issues using the app or want to give any feedback to us. Please open an issue here: https://github.com/dreth/hbd/issues, thanks and we hope you find the application useful!", req.ReminderTime, req.Timezone))
-
-	// Get the JWT duration from the header or use the default
-	jwtDuration, err := GetJWTDurationFromHeader(c, 720)
+	err = user.Insert(c, tx, boil.Infer())
 	if err != nil {
-		jwtDuration = 720
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "failed to create user", false)
+		return
+	}
+	if invitation != nil {
+		if err = invitations.Accept(c, tx, invitation, user.ID.Int64, time.Now().UTC()); err != nil {
+			tx.Rollback() // Rollback the transaction on error
+			invitations.HE(c, err, "failed to accept invitation")
+			return
+		}
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "failed to commit transaction", false)
+		return
 	}
 
-	// Generate JWT token
-	token, err := GenerateJWT(req.Email, jwtDuration)
+	// As the user was successfully created, send a telegram message through the bot and ID to confirm the registration
+	telegram.SendTelegramMessage(req.TelegramBotAPIKey, req.TelegramUserID, fmt.Sprintf("🎂 Your user has been successfully registered, through this bot and user ID you'll receive your birthday reminders (if there's any) at %s (Timezone: %s).\n\nIf you encounter any issues using the app or want to give any feedback to us. Please open an issue here: https://github.com/dreth/hbd/issues, thanks and we hope you find the application useful!", req.ReminderTime, req.Timezone))
+
+	// Start a session and generate its tokens
+	tokens, err := newSession(c, env.DB, user.ID.Int64, req.Email)
 	if helper.HE(c, err, http.StatusInternalServerError, "failed to generate token", false) {
 		return
 	} else {
 		c.JSON(http.StatusOK, structs.LoginSuccess{
-			Token:             token,
+			Token:             tokens.Token,
+			RefreshToken:      tokens.RefreshToken,
+			ExpiresAt:         tokens.ExpiresAt,
 			TelegramBotAPIKey: req.TelegramBotAPIKey,
 			TelegramUserID:    req.TelegramUserID,
 			ReminderTime:      req.ReminderTime,
@@ -168,15 +213,18 @@ func Register(c *gin.Context) {
 // @Description This endpoint logs in a user by validating their email and password. Upon successful authentication, it generates a JWT token and returns the user's details along with the filtered list of birthdays.
 //
 // The login process includes the following steps:
-// 1. Parses the login request payload to extract email and password.
-// 2. Authenticates the user using the provided credentials.
-// 3. Fetches the user data including decrypted Telegram bot API key, user ID, reminder time in local timezone, and birthdays.
-// 4. Generates a JWT token for the authenticated user.
-// 5. Returns the user's details, JWT token, and birthdays.
+//  1. Parses the login request payload to extract email and password.
+//  2. Authenticates the user using the provided credentials, rehashing passwords stored with an older hashing scheme or cost parameters.
+//     Users with two-factor authentication also need a TOTP code or an unused recovery code, without one a challenge with totp_required is returned.
+//     After 5 wrong codes in a row the second factor is locked for 15 minutes.
+//  3. Fetches the user data including decrypted Telegram bot API key, user ID, reminder time in local timezone, and birthdays.
+//  4. Starts a session and generates its short-lived JWT access token and refresh token.
+//  5. Returns the user's details, tokens, and birthdays.
 //
 // Errors:
 // - Returns 400 if the request payload is invalid.
-// - Returns 401 if the authentication fails due to invalid email or password.
+// - Returns 401 if the authentication fails due to invalid email, password or two-factor authentication code, or if a code is required.
+// - Returns 429 while the second factor is locked after too many wrong codes.
 // - Returns 500 if there is an internal server error while fetching user data or generating the JWT token.
 //
 // @Accept  json
@@ -184,7 +232,8 @@ func Register(c *gin.Context) {
 // @Param   user  body     structs.LoginRequest  true  "Login user"
 // @Success 200 {object} structs.LoginSuccess
 // @Failure 400 {object} structs.Error "Invalid request"
-// @Failure 401 {object} structs.Error "Invalid email or password"
+// @Failure 401 {object} structs.TOTPChallenge "Invalid email, password or code, or a two-factor authentication code is required"
+// @Failure 429 {object} structs.Error "too many failed two-factor authentication attempts, try again later"
 // @Failure 500 {object} structs.Error "Internal server error"
 // @Router /login [post]
 // @Tags auth
@@ -212,17 +261,17 @@ func Login(c *gin.Context) {
 		return
 	}
 
-	// Hash the email and password
+	// Hash the email to find the user
 	emailHash := encryption.HashStringWithSHA256(req.Email)
-	passwordHash := encryption.HashStringWithSHA256(req.Password)
 
-	_, err := models.Users(
+	user, err := models.Users(
 		qm.Where("email_hash = ?", emailHash),
-		qm.Where("password_hash = ?", passwordHash),
 	).One(c.Request.Context(), boil.GetContextDB())
 
-	// If no user is found, return a 401 Unauthorized
+	// If no user is found, hash the password anyway so the response time doesn't reveal which emails are registered,
+	// and return a 401 Unauthorized
 	if err == sql.ErrNoRows {
+		encryption.HashPassword(req.Password)
 		c.JSON(http.StatusUnauthorized, structs.Error{Error: "invalid email or password"})
 		return
 	}
@@ -233,6 +282,47 @@ func Login(c *gin.Context) {
 		return
 	}
 
+	// Check the password against its hash
+	match, needsRehash, err := encryption.VerifyPassword(req.Password, user.PasswordHash)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, structs.Error{Error: "an unexpected error occurred"})
+		return
+	}
+	if !match {
+		c.JSON(http.StatusUnauthorized, structs.Error{Error: "invalid email or password"})
+		return
+	}
+
+	// With two-factor authentication, the login also needs a TOTP code or a recovery code, clients send the
+	// credentials again along with the code when they get the challenge
+	if user.TotpEnabled {
+		if req.TOTPCode == "" {
+			c.JSON(http.StatusUnauthorized, structs.TOTPChallenge{Error: "two-factor authentication code required", TOTPRequired: true})
+			return
+		}
+		ok, err := verifySecondFactor(c, env.DB, user, req.TOTPCode, time.Now())
+		if totpHE(c, err) {
+			return
+		}
+		if !ok {
+			c.JSON(http.StatusUnauthorized, structs.Error{Error: "invalid two-factor authentication code"})
+			return
+		}
+	}
+
+	// Migrate legacy SHA-256 hashes and hashes with outdated cost parameters to a new Argon2id hash,
+	// the login goes on if it fails since the old hash still works
+	if needsRehash {
+		if passwordHash, err := encryption.HashPassword(req.Password); err == nil {
+			user.PasswordHash = passwordHash
+			if _, err = user.Update(c, env.DB, boil.Whitelist(models.UserColumns.PasswordHash)); err != nil {
+				log.Println("Error rehashing password:", err)
+			}
+		} else {
+			log.Println("Error rehashing password:", err)
+		}
+	}
+
 	// Set the user email in the context
 	c.Set("Email", req.Email)
 
@@ -241,21 +331,17 @@ func Login(c *gin.Context) {
 		return
 	}
 
-	// Get the JWT duration from the header or use the default
-	jwtDuration, err := GetJWTDurationFromHeader(c, 720)
-	if err != nil {
-		jwtDuration = 720
-	}
-
-	// Generate JWT token
-	token, err := GenerateJWT(req.Email, jwtDuration)
+	// Start a session and generate its tokens
+	tokens, err := newSession(c, env.DB, user.ID.Int64, req.Email)
 	if helper.HE(c, err, http.StatusInternalServerError, "failed to generate token", false) {
 		return
 	}
 
 	// Return the user's details along with the filtered birthdays
 	c.JSON(http.StatusOK, structs.LoginSuccess{
-		Token:             token,
+		Token:             tokens.Token,
+		RefreshToken:      tokens.RefreshToken,
+		ExpiresAt:         tokens.ExpiresAt,
 		TelegramBotAPIKey: userData.TelegramBotAPIKey,
 		TelegramUserID:    userData.TelegramUserID,
 		ReminderTime:      userData.ReminderTime,
@@ -268,6 +354,7 @@ func Login(c *gin.Context) {
 // @Description This endpoint returns the authenticated user's data including Telegram bot API key, user ID, reminder time, and birthdays. The request must include a valid JWT token.
 // @Produce  json
 // @Success 200 {object} structs.UserData
+// @Header  200 {string} ETag "Version of the user settings"
 // @Failure 500 {object} structs.Error "Internal server error"
 // @Security Bearer
 // @Router /me [get]
@@ -278,18 +365,21 @@ func Me(c *gin.Context) {
 		return
 	}
 
+	c.Header("ETag", helper.ETag(userData.Version))
 	c.JSON(http.StatusOK, userData)
 }
 
 // @Summary Modify a user's details
-// @Description This endpoint modifies a user's details such as Telegram bot API key, reminder time, and more. The request must include a valid JWT token. When modifying the email or password, a new JWT token is generated and returned. Otherwise, the user's data is returned without a new token.
+// @Description This endpoint modifies a user's details such as Telegram bot API key, reminder time, and more. The request must include a valid JWT token. When modifying the email or password, all the sessions of the user are ended and a new session is started, returning its tokens. Modifying the password also revokes the personal API tokens. Otherwise, the user's data is returned without a new token.
 // @Accept  json
 // @Produce  json
 // @Param   user  body     structs.ModifyUserRequest  true  "Modify user"
+// @Param   If-Match  header  string  false  "Only apply the change if the user settings still have this ETag"
 // @Success 200 {object} structs.LoginSuccess "User data and new token if email or password changed"
 // @Success 200 {object} structs.UserData "User data without a new token if no email or password changes"
 // @Failure 400 {object} structs.Error "Invalid request"
 // @Failure 401 {object} structs.Error "Unauthorized"
+// @Failure 412 {object} structs.Error "User settings were changed by another request"
 // @Failure 500 {object} structs.Error "Failed to update user or process request"
 // @Security Bearer
 // @Router /modify-user [put]
@@ -309,6 +399,11 @@ func ModifyUser(c *gin.Context) {
 		return
 	}
 
+	// Check that the user settings weren't changed since the client read them
+	if helper.PreconditionFailed(c, helper.ETag(user.Version)) {
+		return
+	}
+
 	// Check the length of the new email and other fields
 	lengthErrors := helper.CheckArrayStringLength(
 		[]string{"NewEmail", "NewPassword", "NewReminderTime", "NewTimezone", "NewTelegramBotAPIKey", "NewTelegramUserID"},
@@ -362,8 +457,11 @@ func ModifyUser(c *gin.Context) {
 
 	// Validate and hash the user's new password (to be updated)
 	if req.NewPassword != "" {
-		PasswordHash := encryption.HashStringWithSHA256(req.NewPassword)
-		user.PasswordHash = PasswordHash
+		passwordHash, err := encryption.HashPassword(req.NewPassword)
+		if helper.HE(c, err, http.StatusInternalServerError, "failed to hash password", false) {
+			return
+		}
+		user.PasswordHash = passwordHash
 	}
 
 	// Ensure reminderTime is in UTC
@@ -384,27 +482,74 @@ func ModifyUser(c *gin.Context) {
 		return
 	}
 
-	// Perform the update within the transaction
-	_, err = user.Update(c, tx, boil.Infer())
+	// Increment the version, failing if the user settings were changed since they were loaded
+	if err = helper.BumpVersion(c, tx, models.TableNames.Users, user.ID.Int64, user.Version); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.VersionHE(c, err, "failed to update user")
+		return
+	}
+	user.Version++
+
+	// Perform the update within the transaction, the two-factor authentication has its own endpoints
+	_, err = user.Update(c, tx, boil.Blacklist(models.UserColumns.TotpSecret, models.UserColumns.TotpEnabled, models.UserColumns.TotpLastStep, models.UserColumns.TotpFailures, models.UserColumns.TotpLockedUntil))
 	if err != nil {
 		tx.Rollback() // Rollback the transaction on error
 		helper.HE(c, err, http.StatusInternalServerError, "failed to update user", false)
 		return
 	}
 
+	// Changing the email or password logs the user out everywhere, a new session is started below for this device
+	if req.NewEmail != "" || req.NewPassword != "" {
+		if err = revokeSessions(c, tx, user.ID.Int64); err != nil {
+			tx.Rollback() // Rollback the transaction on error
+			helper.HE(c, err, http.StatusInternalServerError, "failed to revoke sessions", false)
+			return
+		}
+	}
+
+	// Changing the password revokes the API tokens too, they may have been created with a leaked password. Otherwise
+	// the API tokens and single sign-on identities keep working, but they need the new email to find the user
+	if req.NewPassword != "" {
+		if err = revokeAPITokens(c, tx, user.ID.Int64); err != nil {
+			tx.Rollback() // Rollback the transaction on error
+			helper.HE(c, err, http.StatusInternalServerError, "failed to revoke API tokens", false)
+			return
+		}
+	}
+	if req.NewEmail != "" {
+		if req.NewPassword == "" {
+			if err = updateAPITokenEmails(c, tx, user.ID.Int64, req.NewEmail); err != nil {
+				tx.Rollback() // Rollback the transaction on error
+				helper.HE(c, err, http.StatusInternalServerError, "failed to update API tokens", false)
+				return
+			}
+		}
+		if err = updateOIDCIdentityEmails(c, tx, user.ID.Int64, req.NewEmail); err != nil {
+			tx.Rollback() // Rollback the transaction on error
+			helper.HE(c, err, http.StatusInternalServerError, "failed to update single sign-on identities", false)
+			return
+		}
+	}
+
 	// Commit the transaction
 	if err = tx.Commit(); err != nil {
 		helper.HE(c, err, http.StatusInternalServerError, "failed to commit transaction", false)
 		return
 	}
 
+	// The user is now found by the new email
+	if req.NewEmail != "" {
+		c.Set("Email", req.NewEmail)
+	}
+
 	// Get user data post-changes
 	userData, err := GetUserData(c)
 	if helper.HE(c, err, http.StatusInternalServerError, "invalid email or password", true) {
 		return
 	}
+	c.Header("ETag", helper.ETag(user.Version))
 
-	// Update the email in the context
+	// Start a new session when the email or password changed
 	if (req.NewEmail != "") || (req.NewPassword != "" && req.NewEmail == "") {
 		// Possible scenarios
 		// 1. New email is empty, but password is not
@@ -414,33 +559,24 @@ func ModifyUser(c *gin.Context) {
 			req.NewEmail = originalEmail
 		}
 
-		// Case 2: New email is not empty (regardless of password)
-		if req.NewEmail != "" {
-			c.Set("Email", req.NewEmail)
-		}
-
-		// After committing the transaction, emit another JWT token with the new email
-		// Get the JWT duration from the header or use the default
-		jwtDuration, err := GetJWTDurationFromHeader(c, 720)
-		if err != nil {
-			jwtDuration = 720
-		}
-
-		// Generate JWT token
-		token, err := GenerateJWT(req.NewEmail, jwtDuration)
+		// After committing the transaction, start a new session with the new email
+		tokens, err := newSession(c, env.DB, user.ID.Int64, req.NewEmail)
 		if helper.HE(c, err, http.StatusInternalServerError, "failed to generate token", false) {
 			return
 		}
 
-		// Return the new token with the new user data
+		// Return the new tokens with the new user data
 		c.JSON(http.StatusOK, structs.LoginSuccess{
-			Token:             token,
+			Token:             tokens.Token,
+			RefreshToken:      tokens.RefreshToken,
+			ExpiresAt:         tokens.ExpiresAt,
 			TelegramBotAPIKey: userData.TelegramBotAPIKey,
 			TelegramUserID:    userData.TelegramUserID,
 			ReminderTime:      userData.ReminderTime,
 			Timezone:          userData.Timezone,
 			Birthdays:         userData.Birthdays,
 		})
+		return
 	}
 
 	// Return the data that calling /me would return
@@ -479,6 +615,64 @@ func DeleteUser(c *gin.Context) {
 		return
 	}
 
+	// Leave the shared lists of the user, handing them over to the other members
+	memberships, err := models.ListMembers(models.ListMemberWhere.UserID.EQ(user.ID.Int64)).All(c, tx)
+	if err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "failed to delete user", false)
+		return
+	}
+	for _, membership := range memberships {
+		if err = lists.RemoveMember(c, tx, membership.ListID, user.ID.Int64); err != nil {
+			tx.Rollback() // Rollback the transaction on error
+			helper.HE(c, err, http.StatusInternalServerError, "failed to delete user", false)
+			return
+		}
+	}
+
+	// Leave the organizations of the user, handing them over to the other members
+	orgMemberships, err := models.OrganizationMembers(models.OrganizationMemberWhere.UserID.EQ(user.ID.Int64)).All(c, tx)
+	if err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "failed to delete user", false)
+		return
+	}
+	for _, membership := range orgMemberships {
+		if err = organizations.RemoveMember(c, tx, membership.OrganizationID, user.ID.Int64); err != nil {
+			tx.Rollback() // Rollback the transaction on error
+			helper.HE(c, err, http.StatusInternalServerError, "failed to delete user", false)
+			return
+		}
+	}
+
+	// End all the sessions of the user
+	if err = revokeSessions(c, tx, user.ID.Int64); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "failed to delete user", false)
+		return
+	}
+
+	// Revoke the API tokens of the user, they hold the email and would work for a new account with it
+	if err = revokeAPITokens(c, tx, user.ID.Int64); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "failed to delete user", false)
+		return
+	}
+
+	// Unlink the single sign-on identities of the user
+	if _, err = models.OidcIdentities(models.OidcIdentityWhere.UserID.EQ(user.ID.Int64)).DeleteAll(c, tx); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "failed to delete user", false)
+		return
+	}
+
+	// Delete the recovery codes of the user
+	if _, err = models.RecoveryCodes(models.RecoveryCodeWhere.UserID.EQ(user.ID.Int64)).DeleteAll(c, tx); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "failed to delete user", false)
+		return
+	}
+
 	// Perform the delete within the transaction
 	_, err = user.Delete(c, tx)
 	if err != nil {
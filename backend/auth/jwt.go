@@ -3,7 +3,6 @@ package auth
 import (
 	"errors"
 	"fmt"
-	"hbd/env"
 	"hbd/structs"
 	"strconv"
 	"time"
@@ -12,37 +11,29 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-var jwtKey = []byte(env.MK)
-
-// GenerateJWT generates a JWT token with the given email and duration
-func GenerateJWT(email string, duration int) (string, error) {
-	// If the duration is 0, default to 720
-	if duration == 0 {
-		duration = 720
-	}
-
-	// Set the expiration time for the token
-	expirationTime := time.Now().Add(time.Duration(duration) * time.Hour)
-
-	// Create the JWT claims, which includes the email and expiry time
+// GenerateJWT generates a JWT access token with the given email and session that expires at the given time
+func GenerateJWT(email string, sessionID int64, expirationTime time.Time) (string, error) {
+	// Create the JWT claims, which includes the email, session and expiry time
 	claims := &structs.Claims{
-		Email: email,
+		Email:     email,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
-	return token.SignedString(jwtKey)
+	// Sign the token with the current signing key, naming it in the kid header
+	token := jwt.NewWithClaims(keys.signing.method, claims)
+	token.Header["kid"] = keys.signing.id
+
+	return token.SignedString(keys.signing.private)
 }
 
 // ValidateJWT validates a JWT token and returns the claims
 func ValidateJWT(tokenStr string) (*structs.Claims, error) {
-	// Parse the JWT token
+	// Parse the JWT token with the key of its kid header
 	claims := &structs.Claims{}
-	token, err := jwt.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (interface{}, error) {
-		return jwtKey, nil
-	})
+	token, err := jwt.ParseWithClaims(tokenStr, claims, keys.verificationKey)
 
 	// Check if the token is valid
 	if err != nil {
@@ -56,7 +47,7 @@ func ValidateJWT(tokenStr string) (*structs.Claims, error) {
 	return claims, nil
 }
 
-// GetJWTDurationFromHeader gets the JWT duration from the header
+// GetJWTDurationFromHeader gets the requested session duration in hours from the header
 func GetJWTDurationFromHeader(c *gin.Context, defaultDuration int) (int, error) {
 	// Get the JWT duration from the header
 	jwtDurationStr := c.GetHeader("X-Jwt-Token-Duration")
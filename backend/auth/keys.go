@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	"hbd/env"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Algorithms of the JWT signing keys
+const (
+	keyHS256   = "hs256"
+	keyEd25519 = "ed25519"
+)
+
+// Key ID of the signing key derived from the master key when HBD_JWT_KEYS isn't set
+const masterKeyID = "master"
+
+// signingKey is a key of the keyring, HS256 keys sign and verify tokens with the same secret
+type signingKey struct {
+	id      string
+	method  jwt.SigningMethod
+	private any
+	public  any
+}
+
+// keyring holds every key that tokens are verified with, along with the one new tokens are signed with
+type keyring struct {
+	keys    map[string]*signingKey
+	order   []string
+	signing *signingKey
+}
+
+var keys = loadKeyring()
+
+// parseSigningKey parses a key in the kid:algorithm:base64 format of HBD_JWT_KEYS. HS256 keys are secrets of at least
+// 32 bytes and Ed25519 keys are 32 byte seeds, so `openssl rand -base64 32` generates either.
+func parseSigningKey(str string) (*signingKey, error) {
+	parts := strings.Split(strings.TrimSpace(str), ":")
+	if len(parts) != 3 || parts[0] == "" {
+		return nil, fmt.Errorf("keys must be in the kid:algorithm:base64 format")
+	}
+	raw, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("key %s isn't valid base64", parts[0])
+	}
+
+	switch strings.ToLower(parts[1]) {
+	case keyHS256:
+		if len(raw) < 32 {
+			return nil, fmt.Errorf("HS256 key %s must be at least 32 bytes long", parts[0])
+		}
+		return &signingKey{id: parts[0], method: jwt.SigningMethodHS256, private: raw, public: raw}, nil
+	case keyEd25519:
+		if len(raw) != ed25519.SeedSize {
+			return nil, fmt.Errorf("Ed25519 key %s must be a %d byte seed", parts[0], ed25519.SeedSize)
+		}
+		private := ed25519.NewKeyFromSeed(raw)
+		return &signingKey{id: parts[0], method: jwt.SigningMethodEdDSA, private: private, public: private.Public()}, nil
+	default:
+		return nil, fmt.Errorf("key %s has an unknown algorithm, use %s or %s", parts[0], keyHS256, keyEd25519)
+	}
+}
+
+// loadKeyring loads the JWT keys from HBD_JWT_KEYS, a comma separated list of keys, and signs with the one named by
+// HBD_JWT_SIGNING_KEY or the first one. The other keys are only used to verify tokens, so during a rotation a new key
+// can be published before it signs tokens and an old one kept until the tokens it signed expire. Without HBD_JWT_KEYS,
+// an HS256 key is derived from the master key so it's never used directly to sign tokens.
+func loadKeyring() *keyring {
+	ring, err := parseKeyring(os.Getenv("HBD_JWT_KEYS"), os.Getenv("HBD_JWT_SIGNING_KEY"), env.MK)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return ring
+}
+
+// parseKeyring builds the keyring from the values of HBD_JWT_KEYS and HBD_JWT_SIGNING_KEY, or from the master key
+func parseKeyring(config, signingID, masterKey string) (*keyring, error) {
+	ring := &keyring{keys: map[string]*signingKey{}}
+
+	if config == "" {
+		mac := hmac.New(sha256.New, []byte(masterKey))
+		mac.Write([]byte("hbd jwt signing key"))
+		secret := mac.Sum(nil)
+		ring.keys[masterKeyID] = &signingKey{id: masterKeyID, method: jwt.SigningMethodHS256, private: secret, public: secret}
+		ring.order = []string{masterKeyID}
+		ring.signing = ring.keys[masterKeyID]
+		return ring, nil
+	}
+
+	for _, str := range strings.Split(config, ",") {
+		if strings.TrimSpace(str) == "" {
+			continue
+		}
+		key, err := parseSigningKey(str)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid HBD_JWT_KEYS: %w", err)
+		}
+		if _, ok := ring.keys[key.id]; ok {
+			return nil, fmt.Errorf("Invalid HBD_JWT_KEYS: key %s is repeated", key.id)
+		}
+		ring.keys[key.id] = key
+		ring.order = append(ring.order, key.id)
+	}
+	if len(ring.order) == 0 {
+		return nil, fmt.Errorf("Invalid HBD_JWT_KEYS: no keys")
+	}
+
+	if signingID == "" {
+		signingID = ring.order[0]
+	}
+	signing, ok := ring.keys[signingID]
+	if !ok {
+		return nil, fmt.Errorf("HBD_JWT_SIGNING_KEY %s isn't in HBD_JWT_KEYS", signingID)
+	}
+	ring.signing = signing
+
+	return ring, nil
+}
+
+// verificationKey returns the key of the kid header of a token, only if the token was signed with its algorithm
+func (ring *keyring) verificationKey(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := ring.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key")
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method")
+	}
+	return key.public, nil
+}
+
+// @Summary Get the token verification keys
+// @Description This endpoint publishes the public keys of the Ed25519 keys that sign the access tokens as a JSON Web Key Set, so other services can verify the tokens. The kid header of a token is the ID of the key that signed it. HS256 keys are secret and never published.
+// @Produce  json
+// @Success 200 {object} structs.JWKS
+// @Router /.well-known/jwks.json [get]
+// @Tags auth
+// @x-order 71
+func JWKS(c *gin.Context) {
+	response := structs.JWKS{Keys: []structs.JWK{}}
+	for _, id := range keys.order {
+		key := keys.keys[id]
+		public, ok := key.public.(ed25519.PublicKey)
+		if !ok {
+			continue
+		}
+		response.Keys = append(response.Keys, structs.JWK{
+			KeyType: "OKP",
+			Curve:   "Ed25519",
+			X:       base64.RawURLEncoding.EncodeToString(public),
+			KeyID:   key.id,
+			Alg:     key.method.Alg(),
+			Use:     "sig",
+		})
+	}
+
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Keys of the tests, 32 bytes in base64
+var (
+	testSecret = base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	testSeed   = base64.StdEncoding.EncodeToString([]byte("fedcba9876543210fedcba9876543210"))
+)
+
+func TestParseSigningKey(t *testing.T) {
+	tests := []struct {
+		str    string
+		id     string
+		method jwt.SigningMethod
+		ok     bool
+	}{
+		{"a:hs256:" + testSecret, "a", jwt.SigningMethodHS256, true},
+		{" a:HS256:" + testSecret + " ", "a", jwt.SigningMethodHS256, true},
+		{"b:ed25519:" + testSeed, "b", jwt.SigningMethodEdDSA, true},
+		{"b:Ed25519:" + testSeed, "b", jwt.SigningMethodEdDSA, true},
+		{"a:hs256:" + base64.StdEncoding.EncodeToString([]byte("too short")), "", nil, false},
+		{"b:ed25519:" + base64.StdEncoding.EncodeToString(make([]byte, 64)), "", nil, false},
+		{"a:hs256:not base64!", "", nil, false},
+		{"a:rs256:" + testSecret, "", nil, false},
+		{"a:none:" + testSecret, "", nil, false},
+		{":hs256:" + testSecret, "", nil, false},
+		{"hs256:" + testSecret, "", nil, false},
+		{"a:b:hs256:" + testSecret, "", nil, false},
+		{"", "", nil, false},
+	}
+	for _, tt := range tests {
+		key, err := parseSigningKey(tt.str)
+		if (err == nil) != tt.ok {
+			t.Errorf("parseSigningKey(%q) error = %v, want ok %v", tt.str, err, tt.ok)
+			continue
+		}
+		if tt.ok && (key.id != tt.id || key.method != tt.method) {
+			t.Errorf("parseSigningKey(%q) = %s %s, want %s %s", tt.str, key.id, key.method.Alg(), tt.id, tt.method.Alg())
+		}
+	}
+
+	// Ed25519 keys publish the public key of the seed
+	key, err := parseSigningKey("b:ed25519:" + testSeed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seed, _ := base64.StdEncoding.DecodeString(testSeed)
+	if !ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey).Equal(key.public) {
+		t.Error("the public key of an Ed25519 key isn't the one of its seed")
+	}
+}
+
+func TestParseKeyring(t *testing.T) {
+	hs, ed := "a:hs256:"+testSecret, "b:ed25519:"+testSeed
+	tests := []struct {
+		name      string
+		config    string
+		signingID string
+		order     []string
+		signing   string
+		ok        bool
+	}{
+		{"signs with the first key", hs + "," + ed, "", []string{"a", "b"}, "a", true},
+		{"signs with the named key", hs + "," + ed, "b", []string{"a", "b"}, "b", true},
+		{"empty entries are ignored", " ," + ed + ",,", "", []string{"b"}, "b", true},
+		{"unknown signing key", hs + "," + ed, "c", nil, "", false},
+		{"repeated key ID", hs + ",a:ed25519:" + testSeed, "", nil, "", false},
+		{"invalid key", hs + ",b:ed25519:short", "", nil, "", false},
+		{"no keys", ",,", "", nil, "", false},
+	}
+	for _, tt := range tests {
+		ring, err := parseKeyring(tt.config, tt.signingID, "master key")
+		if (err == nil) != tt.ok {
+			t.Errorf("%s: parseKeyring() error = %v, want ok %v", tt.name, err, tt.ok)
+			continue
+		}
+		if !tt.ok {
+			continue
+		}
+		if strings.Join(ring.order, ",") != strings.Join(tt.order, ",") || ring.signing.id != tt.signing {
+			t.Errorf("%s: parseKeyring() = keys %v signing with %s, want keys %v signing with %s", tt.name, ring.order, ring.signing.id, tt.order, tt.signing)
+		}
+	}
+
+	// Without HBD_JWT_KEYS the key is derived from the master key, it's never the master key itself
+	ring, err := parseKeyring("", "", "master key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret := ring.signing.private.([]byte)
+	if ring.signing.id != masterKeyID || ring.signing.method != jwt.SigningMethodHS256 || len(secret) != 32 || string(secret) == "master key" {
+		t.Errorf("parseKeyring() without keys = %s %s, want an HS256 key derived from the master key", ring.signing.id, ring.signing.method.Alg())
+	}
+	other, _ := parseKeyring("", "", "other master key")
+	again, _ := parseKeyring("", "", "master key")
+	if string(other.signing.private.([]byte)) == string(secret) || string(again.signing.private.([]byte)) != string(secret) {
+		t.Error("the derived key doesn't depend only on the master key")
+	}
+}
+
+func TestVerificationKey(t *testing.T) {
+	ring, err := parseKeyring("a:hs256:"+testSecret+",b:ed25519:"+testSeed, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, attacker, _ := ed25519.GenerateKey(nil)
+
+	sign := func(method jwt.SigningMethod, kid any, key any) string {
+		token := jwt.NewWithClaims(method, jwt.MapClaims{"email": "example@lotiguere.com", "exp": time.Now().Add(time.Hour).Unix()})
+		if kid != nil {
+			token.Header["kid"] = kid
+		}
+		str, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return str
+	}
+	hsSecret := ring.keys["a"].private
+	edPublic := []byte(ring.keys["b"].public.(ed25519.PublicKey))
+
+	tests := []struct {
+		name  string
+		token string
+		ok    bool
+	}{
+		{"HS256 key", sign(jwt.SigningMethodHS256, "a", hsSecret), true},
+		{"Ed25519 key", sign(jwt.SigningMethodEdDSA, "b", ring.keys["b"].private), true},
+		// The public Ed25519 key used as an HMAC secret must not verify
+		{"HS256 token for an Ed25519 key", sign(jwt.SigningMethodHS256, "b", edPublic), false},
+		{"HS384 token for an HS256 key", sign(jwt.SigningMethodHS384, "a", hsSecret), false},
+		{"EdDSA token for an HS256 key", sign(jwt.SigningMethodEdDSA, "a", attacker), false},
+		{"unknown key ID", sign(jwt.SigningMethodHS256, "c", hsSecret), false},
+		{"no key ID", sign(jwt.SigningMethodHS256, nil, hsSecret), false},
+		{"key ID that isn't a string", sign(jwt.SigningMethodHS256, 1, hsSecret), false},
+		{"signed with another key", sign(jwt.SigningMethodEdDSA, "b", attacker), false},
+		{"unsigned", sign(jwt.SigningMethodNone, "a", jwt.UnsafeAllowNoneSignatureType), false},
+	}
+	for _, tt := range tests {
+		_, err := jwt.Parse(tt.token, ring.verificationKey)
+		if (err == nil) != tt.ok {
+			t.Errorf("%s: verification error = %v, want ok %v", tt.name, err, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package auth
+
+import (
+	"os"
+	"testing"
+
+	"hbd/db/dbtest"
+	"hbd/env"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	boil.SetDB(env.DB)
+	os.Exit(dbtest.Run(env.DB, m.Run))
+}
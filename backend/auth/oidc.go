@@ -0,0 +1,426 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"hbd/encryption"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/invitations"
+	"hbd/models"
+	"hbd/oidc"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// The state, nonce and PKCE verifier of a login are kept in an encrypted cookie of the browser until the provider
+// redirects back, which also ties the callback to the browser that started the login
+const (
+	oidcCookie       = "hbd_oidc"
+	oidcCookiePath   = "/api/oidc"
+	oidcFlowLifetime = 10 * time.Minute
+)
+
+// Reminder time and timezone of the accounts created through OpenID Connect, users can change them along with their
+// Telegram settings, which are empty until then
+const (
+	oidcReminderTime = "09:00"
+	oidcTimezone     = "UTC"
+)
+
+// Error of the logins through the provider to accounts with two-factor authentication
+const oidcTOTPError = "this account uses two-factor authentication, log in with its password"
+
+// oidcFlow is the content of the cookie of a login, with the invitation the login was started with if any
+type oidcFlow struct {
+	State       string `json:"state"`
+	Nonce       string `json:"nonce"`
+	Verifier    string `json:"verifier"`
+	InviteToken string `json:"invite_token,omitempty"`
+	ExpiresAt   int64  `json:"expires_at"`
+}
+
+var (
+	oidcMu           sync.Mutex
+	oidcProviderInst *oidc.Provider
+)
+
+// errOIDCNotConfigured is returned when HBD_OIDC_ISSUER isn't set
+var errOIDCNotConfigured = errors.New("OpenID Connect isn't configured")
+
+// oidcConfig reads the OpenID Connect settings: HBD_OIDC_ISSUER, HBD_OIDC_CLIENT_ID, HBD_OIDC_CLIENT_SECRET,
+// HBD_OIDC_REDIRECT_URL, HBD_OIDC_SCOPES (space separated), HBD_OIDC_EMAIL_CLAIM, HBD_OIDC_EMAIL_VERIFIED_CLAIM and
+// HBD_OIDC_TRUST_EMAIL
+func oidcConfig() (oidc.Config, bool) {
+	issuer := os.Getenv("HBD_OIDC_ISSUER")
+	if issuer == "" {
+		return oidc.Config{}, false
+	}
+	return oidc.Config{
+		Issuer:             issuer,
+		ClientID:           os.Getenv("HBD_OIDC_CLIENT_ID"),
+		ClientSecret:       os.Getenv("HBD_OIDC_CLIENT_SECRET"),
+		RedirectURL:        os.Getenv("HBD_OIDC_REDIRECT_URL"),
+		Scopes:             strings.Fields(os.Getenv("HBD_OIDC_SCOPES")),
+		EmailClaim:         os.Getenv("HBD_OIDC_EMAIL_CLAIM"),
+		EmailVerifiedClaim: os.Getenv("HBD_OIDC_EMAIL_VERIFIED_CLAIM"),
+		TrustEmail:         os.Getenv("HBD_OIDC_TRUST_EMAIL") == "true",
+	}, true
+}
+
+// oidcAutoProvision checks if users without an account get one when they first log in, unless
+// HBD_OIDC_AUTO_PROVISION is "false" they do
+func oidcAutoProvision() bool {
+	return os.Getenv("HBD_OIDC_AUTO_PROVISION") != "false"
+}
+
+// oidcProvider returns the configured provider, discovering it on first use so the server starts even if it's down
+func oidcProvider(ctx context.Context) (*oidc.Provider, error) {
+	oidcMu.Lock()
+	defer oidcMu.Unlock()
+	if oidcProviderInst != nil {
+		return oidcProviderInst, nil
+	}
+	config, ok := oidcConfig()
+	if !ok {
+		return nil, errOIDCNotConfigured
+	}
+	provider, err := oidc.NewProvider(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	oidcProviderInst = provider
+	return provider, nil
+}
+
+// oidcProviderHE responds to errors getting the provider, 404 if OpenID Connect isn't configured
+func oidcProviderHE(c *gin.Context, err error) bool {
+	if errors.Is(err, errOIDCNotConfigured) {
+		c.JSON(http.StatusNotFound, structs.Error{Error: "Single sign-on isn't enabled"})
+		return true
+	}
+	if err != nil {
+		log.Println("Error discovering the OpenID Connect provider:", err)
+	}
+	return helper.HE(c, err, http.StatusBadGateway, "The identity provider is unavailable", false)
+}
+
+// setOIDCCookie stores the flow of a login in its cookie, an empty value deletes it
+func setOIDCCookie(c *gin.Context, value string, maxAge int) {
+	secure := c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https"
+	// Lax so the cookie is sent when the provider redirects back with a top-level navigation
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcCookie, value, maxAge, oidcCookiePath, "", secure, true)
+}
+
+// readOIDCFlow decrypts the flow of the login from its cookie and checks it's the one of the state the provider returned
+func readOIDCFlow(c *gin.Context, state string) (*oidcFlow, bool) {
+	cookie, err := c.Cookie(oidcCookie)
+	if err != nil {
+		return nil, false
+	}
+	plaintext, err := encryption.Decrypt(env.MK, cookie)
+	if err != nil {
+		return nil, false
+	}
+	var flow oidcFlow
+	if err = json.Unmarshal([]byte(plaintext), &flow); err != nil {
+		return nil, false
+	}
+	if subtle.ConstantTimeCompare([]byte(flow.State), []byte(state)) != 1 || time.Now().Unix() > flow.ExpiresAt {
+		return nil, false
+	}
+	return &flow, true
+}
+
+// updateOIDCIdentityEmails stores the new email of the user in their linked identities, so they can still log in
+func updateOIDCIdentityEmails(ctx context.Context, exec boil.ContextExecutor, userID int64, email string) error {
+	encryptedEmail, err := encryption.Encrypt(env.MK, email)
+	if err != nil {
+		return err
+	}
+	_, err = models.OidcIdentities(models.OidcIdentityWhere.UserID.EQ(userID)).UpdateAll(ctx, exec, models.M{
+		models.OidcIdentityColumns.Email: hex.EncodeToString(encryptedEmail),
+	})
+	return err
+}
+
+// provisionOIDCUser creates an account for an identity, with a random password that nobody knows and no Telegram settings
+func provisionOIDCUser(ctx context.Context, exec boil.ContextExecutor, email string) (*models.User, error) {
+	password, err := encryption.GenerateToken()
+	if err != nil {
+		return nil, err
+	}
+	passwordHash, err := encryption.HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+	encryptedBotAPIKey, err := encryption.Encrypt(env.MK, "")
+	if err != nil {
+		return nil, err
+	}
+	encryptedUserID, err := encryption.Encrypt(env.MK, "")
+	if err != nil {
+		return nil, err
+	}
+
+	user := &models.User{
+		EmailHash:             encryption.HashStringWithSHA256(email),
+		PasswordHash:          passwordHash,
+		ReminderTime:          oidcReminderTime,
+		Timezone:              oidcTimezone,
+		TelegramBotAPIKey:     hex.EncodeToString(encryptedBotAPIKey),
+		TelegramBotAPIKeyHash: encryption.HashStringWithSHA256(""),
+		TelegramUserID:        hex.EncodeToString(encryptedUserID),
+		TelegramUserIDHash:    encryption.HashStringWithSHA256(""),
+	}
+	if err = user.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// linkOIDCIdentity links an identity to a user
+func linkOIDCIdentity(ctx context.Context, exec boil.ContextExecutor, identity *oidc.Identity, userID int64, now time.Time) error {
+	encryptedEmail, err := encryption.Encrypt(env.MK, identity.Email)
+	if err != nil {
+		return err
+	}
+	link := &models.OidcIdentity{
+		UserID:      userID,
+		Issuer:      identity.Issuer,
+		Subject:     identity.Subject,
+		Email:       hex.EncodeToString(encryptedEmail),
+		LastLoginAt: null.TimeFrom(now),
+	}
+	return link.Insert(ctx, exec, boil.Infer())
+}
+
+// oidcUser finds the user of an identity. Identities that were linked before are found by their subject, otherwise
+// they're linked to the account with their email if the provider verified it, or get a new account like when
+// registering, which needs open registration or an invitation. It responds with an error and returns false if there's
+// no user for the identity.
+func oidcUser(c *gin.Context, identity *oidc.Identity, inviteToken string) (int64, string, bool) {
+	now := time.Now().UTC()
+
+	link, err := models.OidcIdentities(
+		models.OidcIdentityWhere.Issuer.EQ(identity.Issuer),
+		models.OidcIdentityWhere.Subject.EQ(identity.Subject),
+	).One(c, env.DB)
+	if err == nil {
+		// Logins through the provider skip the second factor, so they're refused once the account has one
+		user, err := models.FindUser(c, env.DB, null.Int64From(link.UserID))
+		if helper.HE(c, err, http.StatusInternalServerError, "failed to log in", false) {
+			return 0, "", false
+		}
+		if user.TotpEnabled {
+			c.JSON(http.StatusForbidden, structs.Error{Error: oidcTOTPError})
+			return 0, "", false
+		}
+		email, err := encryption.Decrypt(env.MK, link.Email)
+		if helper.HE(c, err, http.StatusInternalServerError, "failed to log in", false) {
+			return 0, "", false
+		}
+		link.LastLoginAt = null.TimeFrom(now)
+		if _, err = link.Update(c, env.DB, boil.Whitelist(models.OidcIdentityColumns.LastLoginAt)); err != nil {
+			log.Println("Error recording OpenID Connect login:", err)
+		}
+		return link.UserID, email, true
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		helper.HE(c, err, http.StatusInternalServerError, "failed to log in", false)
+		return 0, "", false
+	}
+
+	// Accounts are only linked or created by email when the provider vouches for it
+	if !identity.EmailVerified {
+		c.JSON(http.StatusForbidden, structs.Error{Error: "the identity provider didn't verify the email of this account"})
+		return 0, "", false
+	}
+
+	emailHash := encryption.HashStringWithSHA256(identity.Email)
+	user, err := models.Users(models.UserWhere.EmailHash.EQ(emailHash)).One(c, env.DB)
+	if err == nil {
+		// Nor are accounts with a second factor linked by email
+		if user.TotpEnabled {
+			c.JSON(http.StatusForbidden, structs.Error{Error: oidcTOTPError})
+			return 0, "", false
+		}
+		if err = linkOIDCIdentity(c, env.DB, identity, user.ID.Int64, now); err != nil {
+			helper.HE(c, err, http.StatusInternalServerError, "failed to link account", false)
+			return 0, "", false
+		}
+		return user.ID.Int64, identity.Email, true
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		helper.HE(c, err, http.StatusInternalServerError, "failed to log in", false)
+		return 0, "", false
+	}
+
+	if !oidcAutoProvision() {
+		c.JSON(http.StatusForbidden, structs.Error{Error: "there's no account with this email"})
+		return 0, "", false
+	}
+
+	// Check the invitation, which is required to get an account when open registration is disabled
+	var invitation *models.Invitation
+	if inviteToken != "" {
+		invitation, err = invitations.Find(c, env.DB, inviteToken, now)
+		if err == nil {
+			err = invitations.CheckEmail(invitation, emailHash)
+		}
+		if invitations.HE(c, err, "failed to check invitation") {
+			return 0, "", false
+		}
+	} else if !openRegistration() {
+		c.JSON(http.StatusForbidden, structs.Error{Error: "Registration is by invitation only"})
+		return 0, "", false
+	}
+
+	// Start a new transaction so the account is only created along with its link
+	tx, err := env.DB.Begin()
+	if helper.HE(c, err, http.StatusInternalServerError, "failed to begin transaction", false) {
+		return 0, "", false
+	}
+	user, err = provisionOIDCUser(c, tx, identity.Email)
+	if err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "failed to create user", false)
+		return 0, "", false
+	}
+	if err = linkOIDCIdentity(c, tx, identity, user.ID.Int64, now); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "failed to link account", false)
+		return 0, "", false
+	}
+	if invitation != nil {
+		if err = invitations.Accept(c, tx, invitation, user.ID.Int64, now); err != nil {
+			tx.Rollback() // Rollback the transaction on error
+			invitations.HE(c, err, "failed to accept invitation")
+			return 0, "", false
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "failed to commit transaction", false)
+		return 0, "", false
+	}
+	return user.ID.Int64, identity.Email, true
+}
+
+// @Summary Log in with single sign-on
+// @Description This endpoint starts a login with the OpenID Connect provider, redirecting the browser to it with the authorization code flow and PKCE. The provider redirects back to /oidc/callback. The token of an invitation lets users without an account get one when open registration is disabled, and they join its shared list or organization. It's only available when single sign-on is configured.
+// @Param   invite_token  query    string  false  "Token of an invitation"
+// @Success 302 "Redirect to the identity provider"
+// @Failure 404 {object} structs.Error "Single sign-on isn't enabled"
+// @Failure 502 {object} structs.Error "The identity provider is unavailable"
+// @Router /oidc/login [get]
+// @Tags auth
+// @x-order 80
+func OIDCLogin(c *gin.Context) {
+	provider, err := oidcProvider(c)
+	if oidcProviderHE(c, err) {
+		return
+	}
+
+	state, nonce, verifier, err := oidc.NewFlow()
+	if helper.HE(c, err, http.StatusInternalServerError, "failed to start login", false) {
+		return
+	}
+	flow, err := json.Marshal(oidcFlow{
+		State:       state,
+		Nonce:       nonce,
+		Verifier:    verifier,
+		InviteToken: c.Query("invite_token"),
+		ExpiresAt:   time.Now().Add(oidcFlowLifetime).Unix(),
+	})
+	if helper.HE(c, err, http.StatusInternalServerError, "failed to start login", false) {
+		return
+	}
+	encryptedFlow, err := encryption.Encrypt(env.MK, string(flow))
+	if helper.HE(c, err, http.StatusInternalServerError, "failed to start login", false) {
+		return
+	}
+
+	setOIDCCookie(c, hex.EncodeToString(encryptedFlow), int(oidcFlowLifetime.Seconds()))
+	c.Redirect(http.StatusFound, provider.AuthCodeURL(state, nonce, verifier))
+}
+
+// @Summary Finish a single sign-on login
+// @Description This endpoint is where the OpenID Connect provider redirects back to. It redeems the authorization code, verifies the ID token and starts a session for the user, found by the identity linked before or by the email verified by the provider. Accounts with two-factor authentication can't log in this way, even if they were linked before. Users without an account get one unless HBD_OIDC_AUTO_PROVISION is false, if registration is open or the login was started with an invitation, and set up Telegram afterwards. When HBD_OIDC_FRONTEND_URL is set, the browser is redirected to it with the tokens in the fragment of the URL, otherwise the tokens are returned.
+// @Produce  json
+// @Param   code   query    string  true  "Authorization code"
+// @Param   state  query    string  true  "State of the login"
+// @Success 200 {object} structs.SessionTokens
+// @Success 302 "Redirect to the frontend with the tokens"
+// @Failure 400 {object} structs.Error "Invalid or expired login, start it again"
+// @Failure 401 {object} structs.Error "Login with the identity provider failed"
+// @Failure 403 {object} structs.Error "the identity provider didn't verify the email of this account"
+// @Failure 404 {object} structs.Error "Single sign-on isn't enabled, or the invitation wasn't found"
+// @Failure 500 {object} structs.Error "failed to log in"
+// @Router /oidc/callback [get]
+// @Tags auth
+// @x-order 81
+func OIDCCallback(c *gin.Context) {
+	provider, err := oidcProvider(c)
+	if oidcProviderHE(c, err) {
+		return
+	}
+
+	// The flow can only be finished once
+	flow, ok := readOIDCFlow(c, c.Query("state"))
+	setOIDCCookie(c, "", -1)
+	if !ok {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid or expired login, start it again"})
+		return
+	}
+	if errorCode := c.Query("error"); errorCode != "" {
+		log.Println("OpenID Connect login failed:", errorCode, c.Query("error_description"))
+		c.JSON(http.StatusUnauthorized, structs.Error{Error: "Login with the identity provider failed"})
+		return
+	}
+
+	identity, err := provider.Exchange(c, c.Query("code"), flow.Verifier, flow.Nonce)
+	if err != nil {
+		log.Println("OpenID Connect login failed:", err)
+		c.JSON(http.StatusUnauthorized, structs.Error{Error: "Login with the identity provider failed"})
+		return
+	}
+
+	userID, email, ok := oidcUser(c, identity, flow.InviteToken)
+	if !ok {
+		return
+	}
+
+	// Start a session and generate its tokens
+	tokens, err := newSession(c, env.DB, userID, email)
+	if helper.HE(c, err, http.StatusInternalServerError, "failed to generate token", false) {
+		return
+	}
+
+	// Browsers don't send the fragment to servers, so the tokens don't end up in logs along the way
+	if frontendURL := os.Getenv("HBD_OIDC_FRONTEND_URL"); frontendURL != "" {
+		fragment := url.Values{}
+		fragment.Set("token", tokens.Token)
+		fragment.Set("refresh_token", tokens.RefreshToken)
+		fragment.Set("expires_at", tokens.ExpiresAt)
+		c.Redirect(http.StatusFound, frontendURL+"#"+fragment.Encode())
+		return
+	}
+
+	c.JSON(http.StatusOK, tokens)
+}
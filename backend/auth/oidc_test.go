@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"hbd/encryption"
+	"hbd/env"
+	"hbd/models"
+	"hbd/oidc"
+	"hbd/oidc/oidctest"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// oidcLogin logs in to the mock provider as the user with the claims and returns the identity of the ID token
+func oidcLogin(t *testing.T, m *oidctest.Provider, p *oidc.Provider, claims map[string]any) *oidc.Identity {
+	t.Helper()
+	m.SetClaims(claims)
+	state, nonce, verifier, err := oidc.NewFlow()
+	if err != nil {
+		t.Fatal(err)
+	}
+	location := m.Authorize(p.AuthCodeURL(state, nonce, verifier))
+	identity, err := p.Exchange(context.Background(), location.Query().Get("code"), verifier, nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return identity
+}
+
+// callOIDCUser calls oidcUser in a request and returns the status of its response if it failed
+func callOIDCUser(identity *oidc.Identity, inviteToken string) (int64, string, int) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/oidc/callback", nil)
+	userID, email, ok := oidcUser(c, identity, inviteToken)
+	if ok {
+		return userID, email, http.StatusOK
+	}
+	return 0, "", w.Code
+}
+
+// usersWithEmail counts the accounts with the email
+func usersWithEmail(t *testing.T, email string) int64 {
+	t.Helper()
+	count, err := models.Users(models.UserWhere.EmailHash.EQ(encryption.HashStringWithSHA256(email))).Count(context.Background(), env.DB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return count
+}
+
+func TestOIDCUser(t *testing.T) {
+	ctx := context.Background()
+	m := oidctest.NewProvider(t)
+	p, err := oidc.NewProvider(ctx, oidc.Config{
+		Issuer:       m.Issuer,
+		ClientID:     oidctest.ClientID,
+		ClientSecret: oidctest.ClientSecret,
+		RedirectURL:  "http://localhost:8417/api/oidc/callback",
+		Now:          func() time.Time { return oidctest.Now },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("provisions an account and finds it by the link", func(t *testing.T) {
+		identity := oidcLogin(t, m, p, map[string]any{"sub": "new", "email": "New.User@Example.com", "email_verified": true})
+		userID, email, status := callOIDCUser(identity, "")
+		if status != http.StatusOK || email != "New.User@Example.com" || usersWithEmail(t, email) != 1 {
+			t.Fatalf("oidcUser() for a new user = %d %q %d, want a new account with the email", userID, email, status)
+		}
+
+		// The link is found by the subject, even if the email changed at the provider
+		identity = oidcLogin(t, m, p, map[string]any{"sub": "new", "email": "renamed@example.com", "email_verified": true})
+		linkedID, email, status := callOIDCUser(identity, "")
+		if status != http.StatusOK || linkedID != userID || email != "New.User@Example.com" {
+			t.Errorf("oidcUser() for a linked identity = %d %q %d, want user %d", linkedID, email, status, userID)
+		}
+		if usersWithEmail(t, "renamed@example.com") != 0 {
+			t.Error("oidcUser() created an account for the new email of a linked identity")
+		}
+	})
+
+	t.Run("links the account with the email", func(t *testing.T) {
+		user, _, _ := newTestUser(t, "Mixed.Case@Example.com", "pw", false)
+		identity := oidcLogin(t, m, p, map[string]any{"sub": "mixed", "email": "Mixed.Case@Example.com", "email_verified": true})
+		userID, _, status := callOIDCUser(identity, "")
+		if status != http.StatusOK || userID != user.ID.Int64 {
+			t.Errorf("oidcUser() for an account with a mixed case email = %d %d, want user %d", userID, status, user.ID.Int64)
+		}
+		if usersWithEmail(t, "Mixed.Case@Example.com") != 1 || usersWithEmail(t, "mixed.case@example.com") != 0 {
+			t.Error("oidcUser() created a duplicate account")
+		}
+	})
+
+	t.Run("doesn't link accounts with two-factor authentication", func(t *testing.T) {
+		user, _, _ := newTestUser(t, "sso-totp@example.com", "pw", true)
+		identity := oidcLogin(t, m, p, map[string]any{"sub": "totp", "email": "sso-totp@example.com", "email_verified": true})
+		if _, _, status := callOIDCUser(identity, ""); status != http.StatusForbidden {
+			t.Errorf("oidcUser() for an account with two-factor authentication = %d, want 403", status)
+		}
+		if exists, _ := models.OidcIdentities(models.OidcIdentityWhere.UserID.EQ(user.ID.Int64)).Exists(ctx, env.DB); exists {
+			t.Error("oidcUser() linked an account with two-factor authentication")
+		}
+	})
+
+	t.Run("refuses linked accounts that enable two-factor authentication", func(t *testing.T) {
+		user, _, _ := newTestUser(t, "sso-later-totp@example.com", "pw", false)
+		identity := oidcLogin(t, m, p, map[string]any{"sub": "later-totp", "email": "sso-later-totp@example.com", "email_verified": true})
+		if userID, _, status := callOIDCUser(identity, ""); status != http.StatusOK || userID != user.ID.Int64 {
+			t.Fatalf("oidcUser() before two-factor authentication = %d %d, want user %d", userID, status, user.ID.Int64)
+		}
+		user.TotpEnabled = true
+		if _, err := user.Update(ctx, env.DB, boil.Whitelist(models.UserColumns.TotpEnabled)); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, status := callOIDCUser(identity, ""); status != http.StatusForbidden {
+			t.Errorf("oidcUser() for a linked account with two-factor authentication = %d, want 403", status)
+		}
+	})
+
+	t.Run("needs a verified email", func(t *testing.T) {
+		identity := oidcLogin(t, m, p, map[string]any{"sub": "unverified", "email": "unverified@example.com", "email_verified": false})
+		if _, _, status := callOIDCUser(identity, ""); status != http.StatusForbidden || usersWithEmail(t, "unverified@example.com") != 0 {
+			t.Errorf("oidcUser() with an unverified email = %d, want 403", status)
+		}
+	})
+
+	t.Run("provisioning follows the registration settings", func(t *testing.T) {
+		identity := oidcLogin(t, m, p, map[string]any{"sub": "invited", "email": "invited@example.com", "email_verified": true})
+
+		t.Setenv("HBD_OIDC_AUTO_PROVISION", "false")
+		if _, _, status := callOIDCUser(identity, ""); status != http.StatusForbidden {
+			t.Errorf("oidcUser() without auto provisioning = %d, want 403", status)
+		}
+		t.Setenv("HBD_OIDC_AUTO_PROVISION", "true")
+		t.Setenv("HBD_OPEN_REGISTRATION", "false")
+		if _, _, status := callOIDCUser(identity, ""); status != http.StatusForbidden {
+			t.Errorf("oidcUser() with closed registration = %d, want 403", status)
+		}
+		if usersWithEmail(t, "invited@example.com") != 0 {
+			t.Fatal("oidcUser() created an account it wasn't allowed to")
+		}
+
+		// An invitation lets the user in, and they join its list
+		owner, _, _ := newTestUser(t, "sso-owner@example.com", "pw", false)
+		list := &models.List{Name: "Family"}
+		if err := list.Insert(ctx, env.DB, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+		invite := func(token, email string) {
+			invitation := &models.Invitation{
+				ListID:    list.ID,
+				UserID:    owner.ID.Int64,
+				Role:      "viewer",
+				TokenHash: encryption.HashStringWithSHA256(token),
+				ExpiresAt: time.Now().Add(time.Hour),
+			}
+			if email != "" {
+				invitation.EmailHash = null.StringFrom(encryption.HashStringWithSHA256(email))
+			}
+			if err := invitation.Insert(ctx, env.DB, boil.Infer()); err != nil {
+				t.Fatal(err)
+			}
+		}
+		invite("for-someone-else", "someone@example.com")
+		if _, _, status := callOIDCUser(identity, "for-someone-else"); status != http.StatusForbidden {
+			t.Errorf("oidcUser() with an invitation for another email = %d, want 403", status)
+		}
+		if _, _, status := callOIDCUser(identity, "unknown"); status != http.StatusNotFound {
+			t.Errorf("oidcUser() with an unknown invitation = %d, want 404", status)
+		}
+
+		invite("for-anyone", "")
+		userID, _, status := callOIDCUser(identity, "for-anyone")
+		if status != http.StatusOK {
+			t.Fatalf("oidcUser() with an invitation = %d, want 200", status)
+		}
+		member, _ := models.ListMembers(models.ListMemberWhere.ListID.EQ(list.ID.Int64), models.ListMemberWhere.UserID.EQ(userID)).Exists(ctx, env.DB)
+		if !member {
+			t.Error("oidcUser() didn't accept the invitation")
+		}
+	})
+}
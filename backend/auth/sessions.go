@@ -0,0 +1,355 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"hbd/encryption"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Lifetime of the access tokens and maximum lifetime of the sessions when HBD_ACCESS_TOKEN_MINUTES and
+// HBD_MAX_SESSION_HOURS aren't set, sessions last as long as the maximum unless the client asks for less
+const (
+	defaultAccessTokenMinutes = 15
+	defaultMaxSessionHours    = 720
+)
+
+// Maximum length of the user agent stored with a session
+const maxUserAgentLength = 200
+
+// envDuration reads a positive number of time units from an environment variable, falling back to the default if it's unset or invalid
+func envDuration(name string, def int, unit time.Duration) time.Duration {
+	value := def
+	if str := os.Getenv(name); str != "" {
+		parsed, err := strconv.Atoi(str)
+		if err != nil || parsed <= 0 {
+			log.Println("Invalid", name+", using the default of", def)
+		} else {
+			value = parsed
+		}
+	}
+	return time.Duration(value) * unit
+}
+
+// accessTokenLifetime returns how long access tokens are valid, from HBD_ACCESS_TOKEN_MINUTES
+func accessTokenLifetime() time.Duration {
+	return envDuration("HBD_ACCESS_TOKEN_MINUTES", defaultAccessTokenMinutes, time.Minute)
+}
+
+// maxSessionLifetime returns the longest a session can last, from HBD_MAX_SESSION_HOURS
+func maxSessionLifetime() time.Duration {
+	return envDuration("HBD_MAX_SESSION_HOURS", defaultMaxSessionHours, time.Hour)
+}
+
+// sessionLifetime returns the lifetime of a new session, clients can ask for a shorter one in hours with the
+// X-Jwt-Token-Duration header but never for more than the maximum
+func sessionLifetime(c *gin.Context) time.Duration {
+	maxLifetime := maxSessionLifetime()
+	hours, err := GetJWTDurationFromHeader(c, 0)
+	if err != nil || hours <= 0 || time.Duration(hours)*time.Hour > maxLifetime {
+		return maxLifetime
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// sessionTokens issues an access token for the session, which never outlives the session, along with its refresh token
+func sessionTokens(email string, session *models.Session, refreshToken string, now time.Time) (structs.SessionTokens, error) {
+	expiresAt := now.Add(accessTokenLifetime())
+	if expiresAt.After(session.ExpiresAt) {
+		expiresAt = session.ExpiresAt
+	}
+	token, err := GenerateJWT(email, session.ID.Int64, expiresAt)
+	if err != nil {
+		return structs.SessionTokens{}, err
+	}
+	return structs.SessionTokens{Token: token, RefreshToken: refreshToken, ExpiresAt: expiresAt.UTC().Format(time.RFC3339)}, nil
+}
+
+// newSession starts a session for the user on the device of the request and returns its tokens
+func newSession(c *gin.Context, exec boil.ContextExecutor, userID int64, email string) (structs.SessionTokens, error) {
+	refreshToken, err := encryption.GenerateToken()
+	if err != nil {
+		return structs.SessionTokens{}, err
+	}
+	encryptedEmail, err := encryption.Encrypt(env.MK, email)
+	if err != nil {
+		return structs.SessionTokens{}, err
+	}
+
+	userAgent := c.Request.UserAgent()
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+	now := time.Now().UTC()
+	session := &models.Session{
+		UserID:           userID,
+		Email:            hex.EncodeToString(encryptedEmail),
+		RefreshTokenHash: encryption.HashStringWithSHA256(refreshToken),
+		UserAgent:        userAgent,
+		IP:               c.ClientIP(),
+		ExpiresAt:        now.Add(sessionLifetime(c)),
+		LastUsedAt:       null.TimeFrom(now),
+	}
+	if err = session.Insert(c, exec, boil.Infer()); err != nil {
+		return structs.SessionTokens{}, err
+	}
+	return sessionTokens(email, session, refreshToken, now)
+}
+
+// ValidateSession checks that the session of an access token is still active. It's checked on every authenticated
+// request, so revoked sessions stop working right away instead of when their access tokens expire.
+func ValidateSession(ctx context.Context, sessionID int64) (bool, error) {
+	return models.Sessions(
+		models.SessionWhere.ID.EQ(null.Int64From(sessionID)),
+		models.SessionWhere.ExpiresAt.GT(time.Now().UTC()),
+	).Exists(ctx, env.DB)
+}
+
+// revokeSessions deletes all the sessions of the user, which logs them out on every device
+func revokeSessions(ctx context.Context, exec boil.ContextExecutor, userID int64) error {
+	_, err := models.Sessions(models.SessionWhere.UserID.EQ(userID)).DeleteAll(ctx, exec)
+	return err
+}
+
+// PurgeSessions runs periodically to delete the expired sessions.
+func PurgeSessions() {
+	deleted, err := models.Sessions(models.SessionWhere.ExpiresAt.LTE(time.Now().UTC())).DeleteAll(context.Background(), env.DB)
+	if err != nil {
+		log.Println("Error purging sessions:", err)
+		return
+	}
+	if deleted > 0 {
+		log.Println("Purged", deleted, "expired sessions")
+	}
+}
+
+// @Summary Refresh the access token
+// @Description This endpoint issues a new access token for a session with its refresh token. Refresh tokens rotate: each one can only be used once and the response has the next one. Using a refresh token that was already used revokes the session, since it may have been stolen.
+// @Accept  json
+// @Produce  json
+// @Param   refresh  body     structs.RefreshRequest  true  "Refresh token"
+// @Success 200 {object} structs.SessionTokens
+// @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 401 {object} structs.Error "Invalid or expired refresh token"
+// @Failure 500 {object} structs.Error "Failed to refresh session"
+// @Router /refresh [post]
+// @Tags auth
+// @x-order 66
+func RefreshSession(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.RefreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Find the session of the refresh token
+	now := time.Now().UTC()
+	tokenHash := encryption.HashStringWithSHA256(req.RefreshToken)
+	session, err := models.Sessions(models.SessionWhere.RefreshTokenHash.EQ(tokenHash)).One(c, env.DB)
+	if errors.Is(err, sql.ErrNoRows) {
+		// A rotated refresh token was used again, revoke its session
+		if _, err = models.Sessions(
+			models.SessionWhere.PreviousRefreshTokenHash.EQ(null.StringFrom(tokenHash)),
+		).DeleteAll(c, env.DB); err != nil {
+			log.Println("Error revoking session:", err)
+		}
+		c.JSON(http.StatusUnauthorized, structs.Error{Error: "Invalid or expired refresh token"})
+		return
+	}
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to refresh session", false) {
+		return
+	}
+	if !session.ExpiresAt.After(now) {
+		c.JSON(http.StatusUnauthorized, structs.Error{Error: "Invalid or expired refresh token"})
+		return
+	}
+	email, err := encryption.Decrypt(env.MK, session.Email)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to refresh session", false) {
+		return
+	}
+
+	// Rotate the refresh token, only if no other request rotated it in the meantime
+	refreshToken, err := encryption.GenerateToken()
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to generate token", false) {
+		return
+	}
+	affected, err := models.Sessions(
+		models.SessionWhere.ID.EQ(session.ID),
+		models.SessionWhere.RefreshTokenHash.EQ(tokenHash),
+	).UpdateAll(c, env.DB, models.M{
+		models.SessionColumns.RefreshTokenHash:         encryption.HashStringWithSHA256(refreshToken),
+		models.SessionColumns.PreviousRefreshTokenHash: tokenHash,
+		models.SessionColumns.LastUsedAt:               now,
+		models.SessionColumns.IP:                       c.ClientIP(),
+	})
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to refresh session", false) {
+		return
+	}
+	if affected == 0 {
+		c.JSON(http.StatusUnauthorized, structs.Error{Error: "Invalid or expired refresh token"})
+		return
+	}
+
+	tokens, err := sessionTokens(email, session, refreshToken, now)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to generate token", false) {
+		return
+	}
+
+	c.JSON(http.StatusOK, tokens)
+}
+
+// @Summary Log out
+// @Description This endpoint ends the session of the access token of the request, its access and refresh tokens stop working right away. When the request is made with a personal API token, that token is revoked instead. The request must include a valid JWT token.
+// @Produce  json
+// @Success 200 {object} structs.Success
+// @Failure 500 {object} structs.Error "Failed to log out"
+// @Security Bearer
+// @Router /logout [post]
+// @Tags auth
+// @x-order 67
+func Logout(c *gin.Context) {
+	// API tokens have no session, logging out with one revokes it
+	if tokenID := c.GetInt64("APITokenID"); tokenID != 0 {
+		if _, err := models.APITokens(models.APITokenWhere.ID.EQ(null.Int64From(tokenID))).DeleteAll(c, env.DB); err != nil {
+			helper.HE(c, err, http.StatusInternalServerError, "Failed to log out", false)
+			return
+		}
+		c.JSON(http.StatusOK, structs.Success{Success: true})
+		return
+	}
+
+	if _, err := models.Sessions(
+		models.SessionWhere.ID.EQ(null.Int64From(c.GetInt64("SessionID"))),
+	).DeleteAll(c, env.DB); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to log out", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.Success{Success: true})
+}
+
+// @Summary Log out everywhere
+// @Description This endpoint ends all the sessions of the authenticated user, including the current one, on every device. The request must include a valid JWT token.
+// @Produce  json
+// @Success 200 {object} structs.Success
+// @Failure 401 {object} structs.Error "Invalid email"
+// @Failure 500 {object} structs.Error "Failed to log out"
+// @Security Bearer
+// @Router /logout-everywhere [post]
+// @Tags auth
+// @x-order 68
+func LogoutEverywhere(c *gin.Context) {
+	// Get the user from the context
+	user, _, err := GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	if err = revokeSessions(c, env.DB, user.ID.Int64); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to log out", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.Success{Success: true})
+}
+
+// @Summary List active sessions
+// @Description This endpoint lists the active sessions of the authenticated user with the device and address they were last used from, most recently used first. The request must include a valid JWT token.
+// @Produce  json
+// @Success 200 {array} structs.SessionFull
+// @Failure 401 {object} structs.Error "Invalid email"
+// @Failure 500 {object} structs.Error "Failed to fetch sessions"
+// @Security Bearer
+// @Router /sessions [get]
+// @Tags auth
+// @x-order 69
+func ListSessions(c *gin.Context) {
+	// Get the user from the context
+	user, _, err := GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	sessions, err := models.Sessions(
+		models.SessionWhere.UserID.EQ(user.ID.Int64),
+		models.SessionWhere.ExpiresAt.GT(time.Now().UTC()),
+		qm.OrderBy("last_used_at DESC, id DESC"),
+	).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch sessions", false) {
+		return
+	}
+
+	response := []structs.SessionFull{}
+	for _, session := range sessions {
+		response = append(response, structs.SessionFull{
+			ID:         session.ID.Int64,
+			UserAgent:  session.UserAgent,
+			IP:         session.IP,
+			CreatedAt:  session.CreatedAt.Time.UTC().Format(time.RFC3339),
+			LastUsedAt: session.LastUsedAt.Time.UTC().Format(time.RFC3339),
+			ExpiresAt:  session.ExpiresAt.UTC().Format(time.RFC3339),
+			Current:    session.ID.Int64 == c.GetInt64("SessionID"),
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary End a session
+// @Description This endpoint ends one of the sessions of the authenticated user, for example on a lost device. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   session  body     structs.SessionID  true  "Session"
+// @Success 200 {object} structs.Success
+// @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 404 {object} structs.Error "Session not found"
+// @Failure 500 {object} structs.Error "Failed to delete session"
+// @Security Bearer
+// @Router /delete-session [delete]
+// @Tags auth
+// @x-order 70
+func DeleteSession(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.SessionID
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	deleted, err := models.Sessions(
+		models.SessionWhere.ID.EQ(null.Int64From(req.ID)),
+		models.SessionWhere.UserID.EQ(user.ID.Int64),
+	).DeleteAll(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to delete session", false) {
+		return
+	}
+	if deleted == 0 {
+		c.JSON(http.StatusNotFound, structs.Error{Error: "Session not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.Success{Success: true})
+}
@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"hbd/env"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestSessionLifetime(t *testing.T) {
+	tests := []struct {
+		header   string
+		maxHours string
+		want     time.Duration
+	}{
+		{"", "", defaultMaxSessionHours * time.Hour},
+		{"24", "", 24 * time.Hour},
+		{"720", "", 720 * time.Hour},
+		{"721", "", defaultMaxSessionHours * time.Hour},
+		{"100000", "", defaultMaxSessionHours * time.Hour},
+		{"0", "", defaultMaxSessionHours * time.Hour},
+		{"-5", "", defaultMaxSessionHours * time.Hour},
+		{"a day", "", defaultMaxSessionHours * time.Hour},
+		{"24", "48", 24 * time.Hour},
+		{"100", "48", 48 * time.Hour},
+		{"", "48", 48 * time.Hour},
+		{"", "invalid", defaultMaxSessionHours * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Setenv("HBD_MAX_SESSION_HOURS", tt.maxHours)
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodPost, "/", nil)
+		if tt.header != "" {
+			c.Request.Header.Set("X-Jwt-Token-Duration", tt.header)
+		}
+		if got := sessionLifetime(c); got != tt.want {
+			t.Errorf("sessionLifetime() with %q and a maximum of %q = %s, want %s", tt.header, tt.maxHours, got, tt.want)
+		}
+	}
+}
+
+// startSession starts a session for the user like logging in does
+func startSession(t *testing.T, userID int64, email string) structs.SessionTokens {
+	t.Helper()
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/", nil)
+	tokens, err := newSession(c, env.DB, userID, email)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tokens
+}
+
+// refresh calls RefreshSession with a refresh token and returns the status and the new tokens
+func refresh(t *testing.T, refreshToken string) (int, string, string) {
+	t.Helper()
+	status, response := serve(t, RefreshSession, "", structs.RefreshRequest{RefreshToken: refreshToken})
+	token, _ := response["token"].(string)
+	next, _ := response["refresh_token"].(string)
+	return status, token, next
+}
+
+func TestRefreshSession(t *testing.T) {
+	const email = "refresh@example.com"
+	user, _, _ := newTestUser(t, email, "pw", false)
+
+	t.Run("refresh tokens rotate and can't be reused", func(t *testing.T) {
+		tokens := startSession(t, user.ID.Int64, email)
+		status, token, next := refresh(t, tokens.RefreshToken)
+		if status != http.StatusOK || token == "" || next == "" || next == tokens.RefreshToken {
+			t.Fatalf("RefreshSession() = %d, want new tokens", status)
+		}
+		claims, err := ValidateJWT(token)
+		if err != nil || claims.Email != email {
+			t.Fatalf("the refreshed access token isn't valid: %v", err)
+		}
+
+		// The rotated token was stolen: using it again ends the session, along with the newest refresh token
+		if status, _, _ = refresh(t, tokens.RefreshToken); status != http.StatusUnauthorized {
+			t.Errorf("RefreshSession() with a rotated token = %d, want 401", status)
+		}
+		if active, _ := ValidateSession(context.Background(), claims.SessionID); active {
+			t.Error("reusing a rotated refresh token didn't revoke the session")
+		}
+		if status, _, _ = refresh(t, next); status != http.StatusUnauthorized {
+			t.Errorf("RefreshSession() after the session was revoked = %d, want 401", status)
+		}
+	})
+
+	t.Run("logging out ends the refresh token", func(t *testing.T) {
+		tokens := startSession(t, user.ID.Int64, email)
+		claims, err := ValidateJWT(tokens.Token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/", nil)
+		c.Set("Email", email)
+		c.Set("SessionID", claims.SessionID)
+		Logout(c)
+		if w.Code != http.StatusOK {
+			t.Fatalf("Logout() = %d, want 200", w.Code)
+		}
+		if status, _, _ := refresh(t, tokens.RefreshToken); status != http.StatusUnauthorized {
+			t.Errorf("RefreshSession() after logging out = %d, want 401", status)
+		}
+	})
+
+	t.Run("unknown and expired tokens", func(t *testing.T) {
+		if status, _, _ := refresh(t, "unknown"); status != http.StatusUnauthorized {
+			t.Errorf("RefreshSession() with an unknown token = %d, want 401", status)
+		}
+		tokens := startSession(t, user.ID.Int64, email)
+		claims, _ := ValidateJWT(tokens.Token)
+		if _, err := env.DB.Exec("UPDATE sessions SET expires_at = $1 WHERE id = $2", time.Now().UTC().Add(-time.Minute), claims.SessionID); err != nil {
+			t.Fatal(err)
+		}
+		if status, _, _ := refresh(t, tokens.RefreshToken); status != http.StatusUnauthorized {
+			t.Errorf("RefreshSession() with an expired session = %d, want 401", status)
+		}
+	})
+}
@@ -0,0 +1,380 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"hbd/encryption"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Scopes of the personal API tokens. birthdays:write includes birthdays:read, and account:admin includes every scope
+const (
+	ScopeBirthdaysRead  = "birthdays:read"
+	ScopeBirthdaysWrite = "birthdays:write"
+	ScopeAccountAdmin   = "account:admin"
+)
+
+// AllScopes are the scopes of the session tokens, which can do everything the user can
+var AllScopes = []string{ScopeBirthdaysRead, ScopeBirthdaysWrite, ScopeAccountAdmin}
+
+// Personal API tokens start with this prefix, so they're told apart from JWT tokens and easy to find if leaked
+const apiTokenPrefix = "hbd_pat_"
+
+// ErrInvalidAPIToken is returned for unknown, revoked and expired API tokens
+var ErrInvalidAPIToken = errors.New("invalid API token")
+
+// IsAPIToken checks if a bearer token is a personal API token
+func IsAPIToken(token string) bool {
+	return strings.HasPrefix(token, apiTokenPrefix)
+}
+
+// ValidateAPIToken finds an active API token and returns its ID, the email of its user and its scopes. The last use of
+// the token is recorded at most once a minute.
+func ValidateAPIToken(ctx context.Context, token string) (int64, string, []string, error) {
+	now := time.Now().UTC()
+	apiToken, err := models.APITokens(
+		models.APITokenWhere.TokenHash.EQ(encryption.HashStringWithSHA256(token)),
+	).One(ctx, env.DB)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, "", nil, ErrInvalidAPIToken
+	}
+	if err != nil {
+		return 0, "", nil, err
+	}
+	if apiToken.ExpiresAt.Valid && !apiToken.ExpiresAt.Time.After(now) {
+		return 0, "", nil, ErrInvalidAPIToken
+	}
+
+	email, err := encryption.Decrypt(env.MK, apiToken.Email)
+	if err != nil {
+		return 0, "", nil, err
+	}
+
+	if !apiToken.LastUsedAt.Valid || now.Sub(apiToken.LastUsedAt.Time) >= time.Minute {
+		apiToken.LastUsedAt = null.TimeFrom(now)
+		if _, err = apiToken.Update(ctx, env.DB, boil.Whitelist(models.APITokenColumns.LastUsedAt)); err != nil {
+			log.Println("Error recording API token use:", err)
+		}
+	}
+
+	return apiToken.ID.Int64, email, parseScopes(apiToken.Scopes), nil
+}
+
+// HasScope checks if the token of the request has a scope, the scopes are set by the JWT middleware
+func HasScope(c *gin.Context, scope string) bool {
+	for _, granted := range c.GetStringSlice("Scopes") {
+		if granted == scope || granted == ScopeAccountAdmin || (granted == ScopeBirthdaysWrite && scope == ScopeBirthdaysRead) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseScopes reads the comma separated scopes of a token
+func parseScopes(str string) []string {
+	if str == "" {
+		return []string{}
+	}
+	return strings.Split(str, ",")
+}
+
+// validateScopes checks that the requested scopes exist and that the token of the request has them, so tokens can't
+// grant more than they can do. The scopes are returned without duplicates in a fixed order.
+func validateScopes(c *gin.Context, requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return nil, fmt.Errorf("At least one scope is required")
+	}
+	wanted := map[string]bool{}
+	for _, scope := range requested {
+		known := false
+		for _, s := range AllScopes {
+			known = known || s == scope
+		}
+		if !known {
+			return nil, fmt.Errorf("Invalid scope %s, the scopes are %s", scope, strings.Join(AllScopes, ", "))
+		}
+		if !HasScope(c, scope) {
+			return nil, fmt.Errorf("This token can't grant the %s scope", scope)
+		}
+		wanted[scope] = true
+	}
+
+	scopes := []string{}
+	for _, scope := range AllScopes {
+		if wanted[scope] {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, nil
+}
+
+// updateAPITokenEmails stores the new email of the user in their API tokens, so they keep working after the email changes
+func updateAPITokenEmails(ctx context.Context, exec boil.ContextExecutor, userID int64, email string) error {
+	encryptedEmail, err := encryption.Encrypt(env.MK, email)
+	if err != nil {
+		return err
+	}
+	_, err = models.APITokens(models.APITokenWhere.UserID.EQ(userID)).UpdateAll(ctx, exec, models.M{
+		models.APITokenColumns.Email: hex.EncodeToString(encryptedEmail),
+	})
+	return err
+}
+
+// revokeAPITokens deletes all the API tokens of the user
+func revokeAPITokens(ctx context.Context, exec boil.ContextExecutor, userID int64) error {
+	_, err := models.APITokens(models.APITokenWhere.UserID.EQ(userID)).DeleteAll(ctx, exec)
+	return err
+}
+
+// apiTokenFull builds the response of an API token, which never includes the token itself
+func apiTokenFull(apiToken *models.APIToken, now time.Time) structs.APITokenFull {
+	response := structs.APITokenFull{
+		ID:        apiToken.ID.Int64,
+		Name:      apiToken.Name,
+		Scopes:    parseScopes(apiToken.Scopes),
+		Expired:   apiToken.ExpiresAt.Valid && !apiToken.ExpiresAt.Time.After(now),
+		CreatedAt: apiToken.CreatedAt.Time.UTC().Format(time.RFC3339),
+	}
+	if apiToken.ExpiresAt.Valid {
+		response.ExpiresAt = apiToken.ExpiresAt.Time.UTC().Format(time.RFC3339)
+	}
+	if apiToken.LastUsedAt.Valid {
+		response.LastUsedAt = apiToken.LastUsedAt.Time.UTC().Format(time.RFC3339)
+	}
+	return response
+}
+
+// findAPIToken finds an API token of the user, responding with a 404 if it doesn't exist
+func findAPIToken(c *gin.Context, userID, tokenID int64) (*models.APIToken, bool) {
+	apiToken, err := models.APITokens(
+		models.APITokenWhere.ID.EQ(null.Int64From(tokenID)),
+		models.APITokenWhere.UserID.EQ(userID),
+	).One(c, env.DB)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, structs.Error{Error: "API token not found"})
+		return nil, false
+	}
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch API token", false) {
+		return nil, false
+	}
+	return apiToken, true
+}
+
+// @Summary Create a personal API token
+// @Description This endpoint creates a personal API token for the CLI and automations, which is used as a bearer token like the JWT tokens but can only access the routes of its scopes: birthdays:read, birthdays:write (which includes birthdays:read) or account:admin (which includes every scope). The token is only returned in this response, and it never expires unless it's given an expiry time. A request made with an API token can only grant the scopes it has. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   token  body     structs.APITokenAdd  true  "API token"
+// @Success 200 {object} structs.APITokenFull
+// @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 401 {object} structs.Error "Invalid email"
+// @Failure 500 {object} structs.Error "Failed to insert API token"
+// @Security Bearer
+// @Router /add-api-token [post]
+// @Tags auth
+// @x-order 72
+func AddAPIToken(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.APITokenAdd
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, email, err := GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	if err = helper.CheckStringLength("Name", req.Name, 100, 1, 0, false); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: err.Error()})
+		return
+	}
+	scopes, err := validateScopes(c, req.Scopes)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: err.Error()})
+		return
+	}
+
+	// Parse the expiry time, which must be in the future
+	now := time.Now().UTC()
+	var expiresAt null.Time
+	if req.ExpiresAt != "" {
+		t, err := time.Parse(time.RFC3339, req.ExpiresAt)
+		if err != nil || !t.After(now) {
+			c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid expiry time, it must be a future RFC 3339 time"})
+			return
+		}
+		expiresAt = null.TimeFrom(t.UTC())
+	}
+
+	// Generate the token, only its hash is stored
+	token, err := encryption.GenerateToken()
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to generate token", false) {
+		return
+	}
+	token = apiTokenPrefix + token
+	encryptedEmail, err := encryption.Encrypt(env.MK, email)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to encrypt email", false) {
+		return
+	}
+
+	apiToken := &models.APIToken{
+		UserID:    user.ID.Int64,
+		Name:      req.Name,
+		Email:     hex.EncodeToString(encryptedEmail),
+		TokenHash: encryption.HashStringWithSHA256(token),
+		Scopes:    strings.Join(scopes, ","),
+		ExpiresAt: expiresAt,
+	}
+	if err = apiToken.Insert(c, env.DB, boil.Infer()); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to insert API token", false)
+		return
+	}
+
+	response := apiTokenFull(apiToken, now)
+	response.Token = token
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary List personal API tokens
+// @Description This endpoint lists the personal API tokens of the authenticated user with their scopes and when they were last used, newest first. The tokens themselves are never returned. The request must include a valid JWT token.
+// @Produce  json
+// @Success 200 {array} structs.APITokenFull
+// @Failure 401 {object} structs.Error "Invalid email"
+// @Failure 500 {object} structs.Error "Failed to fetch API tokens"
+// @Security Bearer
+// @Router /api-tokens [get]
+// @Tags auth
+// @x-order 73
+func ListAPITokens(c *gin.Context) {
+	// Get the user from the context
+	user, _, err := GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	apiTokens, err := models.APITokens(
+		models.APITokenWhere.UserID.EQ(user.ID.Int64),
+		qm.OrderBy("id DESC"),
+	).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch API tokens", false) {
+		return
+	}
+
+	now := time.Now().UTC()
+	response := []structs.APITokenFull{}
+	for _, apiToken := range apiTokens {
+		response = append(response, apiTokenFull(apiToken, now))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Modify a personal API token
+// @Description This endpoint renames a personal API token of the authenticated user and replaces its scopes. A request made with an API token can only grant the scopes it has. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   token  body     structs.APITokenModify  true  "API token"
+// @Success 200 {object} structs.APITokenFull
+// @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 404 {object} structs.Error "API token not found"
+// @Failure 500 {object} structs.Error "Failed to update API token"
+// @Security Bearer
+// @Router /modify-api-token [put]
+// @Tags auth
+// @x-order 74
+func ModifyAPIToken(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.APITokenModify
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	if err = helper.CheckStringLength("Name", req.Name, 100, 1, 0, false); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: err.Error()})
+		return
+	}
+	scopes, err := validateScopes(c, req.Scopes)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: err.Error()})
+		return
+	}
+
+	apiToken, ok := findAPIToken(c, user.ID.Int64, req.ID)
+	if !ok {
+		return
+	}
+
+	apiToken.Name = req.Name
+	apiToken.Scopes = strings.Join(scopes, ",")
+	if _, err = apiToken.Update(c, env.DB, boil.Whitelist(models.APITokenColumns.Name, models.APITokenColumns.Scopes)); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to update API token", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, apiTokenFull(apiToken, time.Now().UTC()))
+}
+
+// @Summary Revoke a personal API token
+// @Description This endpoint deletes a personal API token of the authenticated user, it stops working right away. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   token  body     structs.APITokenID  true  "API token"
+// @Success 200 {object} structs.Success
+// @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 404 {object} structs.Error "API token not found"
+// @Failure 500 {object} structs.Error "Failed to delete API token"
+// @Security Bearer
+// @Router /delete-api-token [delete]
+// @Tags auth
+// @x-order 75
+func DeleteAPIToken(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.APITokenID
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	apiToken, ok := findAPIToken(c, user.ID.Int64, req.ID)
+	if !ok {
+		return
+	}
+	if _, err = apiToken.Delete(c, env.DB); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to delete API token", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.Success{Success: true})
+}
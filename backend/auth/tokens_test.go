@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"hbd/encryption"
+	"hbd/env"
+	"hbd/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// scopedContext is a request whose token has the scopes
+func scopedContext(scopes ...string) *gin.Context {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Set("Scopes", scopes)
+	return c
+}
+
+func TestHasScope(t *testing.T) {
+	tests := []struct {
+		granted []string
+		scope   string
+		want    bool
+	}{
+		{[]string{ScopeBirthdaysRead}, ScopeBirthdaysRead, true},
+		{[]string{ScopeBirthdaysRead}, ScopeBirthdaysWrite, false},
+		{[]string{ScopeBirthdaysRead}, ScopeAccountAdmin, false},
+		{[]string{ScopeBirthdaysWrite}, ScopeBirthdaysRead, true},
+		{[]string{ScopeBirthdaysWrite}, ScopeBirthdaysWrite, true},
+		{[]string{ScopeBirthdaysWrite}, ScopeAccountAdmin, false},
+		{[]string{ScopeAccountAdmin}, ScopeBirthdaysRead, true},
+		{[]string{ScopeAccountAdmin}, ScopeBirthdaysWrite, true},
+		{[]string{ScopeAccountAdmin}, ScopeAccountAdmin, true},
+		{[]string{}, ScopeBirthdaysRead, false},
+		{[]string{"birthdays:*"}, ScopeBirthdaysRead, false},
+	}
+	for _, tt := range tests {
+		if got := HasScope(scopedContext(tt.granted...), tt.scope); got != tt.want {
+			t.Errorf("HasScope(%v, %s) = %v, want %v", tt.granted, tt.scope, got, tt.want)
+		}
+	}
+
+	// Requests without scopes, which the middleware always sets, have none
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	if HasScope(c, ScopeBirthdaysRead) {
+		t.Error("HasScope() without scopes = true, want false")
+	}
+}
+
+func TestValidateScopes(t *testing.T) {
+	tests := []struct {
+		granted   []string
+		requested []string
+		want      []string
+		ok        bool
+	}{
+		{AllScopes, []string{ScopeAccountAdmin, ScopeBirthdaysRead, ScopeBirthdaysRead}, []string{ScopeBirthdaysRead, ScopeAccountAdmin}, true},
+		{[]string{ScopeBirthdaysWrite}, []string{ScopeBirthdaysRead}, []string{ScopeBirthdaysRead}, true},
+		{[]string{ScopeBirthdaysWrite}, []string{ScopeBirthdaysWrite}, []string{ScopeBirthdaysWrite}, true},
+		// Tokens can't create tokens wider than themselves
+		{[]string{ScopeBirthdaysRead}, []string{ScopeBirthdaysWrite}, nil, false},
+		{[]string{ScopeBirthdaysWrite}, []string{ScopeAccountAdmin}, nil, false},
+		{[]string{ScopeBirthdaysWrite}, []string{ScopeBirthdaysRead, ScopeAccountAdmin}, nil, false},
+		{AllScopes, []string{"birthdays:delete"}, nil, false},
+		{AllScopes, []string{}, nil, false},
+	}
+	for _, tt := range tests {
+		scopes, err := validateScopes(scopedContext(tt.granted...), tt.requested)
+		if (err == nil) != tt.ok {
+			t.Errorf("validateScopes(%v, %v) error = %v, want ok %v", tt.granted, tt.requested, err, tt.ok)
+			continue
+		}
+		if tt.ok && strings.Join(scopes, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("validateScopes(%v, %v) = %v, want %v", tt.granted, tt.requested, scopes, tt.want)
+		}
+	}
+}
+
+func TestValidateAPIToken(t *testing.T) {
+	ctx := context.Background()
+	user, _, _ := newTestUser(t, "api-tokens@example.com", "pw", false)
+	encryptedEmail, _ := encryption.Encrypt(env.MK, "api-tokens@example.com")
+	insert := func(token string, expiresAt null.Time) *models.APIToken {
+		apiToken := &models.APIToken{
+			UserID:    user.ID.Int64,
+			Name:      token,
+			Email:     hex.EncodeToString(encryptedEmail),
+			TokenHash: encryption.HashStringWithSHA256(token),
+			Scopes:    ScopeBirthdaysRead + "," + ScopeBirthdaysWrite,
+			ExpiresAt: expiresAt,
+		}
+		if err := apiToken.Insert(ctx, env.DB, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+		return apiToken
+	}
+
+	valid := insert(apiTokenPrefix+"valid", null.TimeFrom(time.Now().Add(time.Hour)))
+	tokenID, email, scopes, err := ValidateAPIToken(ctx, apiTokenPrefix+"valid")
+	if err != nil || tokenID != valid.ID.Int64 || email != "api-tokens@example.com" || len(scopes) != 2 {
+		t.Errorf("ValidateAPIToken() = %d %q %v %v, want the token", tokenID, email, scopes, err)
+	}
+	if valid, _ = models.FindAPIToken(ctx, env.DB, valid.ID); !valid.LastUsedAt.Valid {
+		t.Error("ValidateAPIToken() didn't record the use of the token")
+	}
+
+	insert(apiTokenPrefix+"expired", null.TimeFrom(time.Now().Add(-time.Second)))
+	revoked := insert(apiTokenPrefix+"revoked", null.Time{})
+	if _, err = revoked.Delete(ctx, env.DB); err != nil {
+		t.Fatal(err)
+	}
+	for _, token := range []string{apiTokenPrefix + "expired", apiTokenPrefix + "revoked", apiTokenPrefix + "unknown"} {
+		if _, _, _, err = ValidateAPIToken(ctx, token); !errors.Is(err, ErrInvalidAPIToken) {
+			t.Errorf("ValidateAPIToken(%q) error = %v, want %v", token, err, ErrInvalidAPIToken)
+		}
+	}
+
+	// Revoking all the tokens of the user, like changing the password does
+	if err = revokeAPITokens(ctx, env.DB, user.ID.Int64); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err = ValidateAPIToken(ctx, apiTokenPrefix+"valid"); !errors.Is(err, ErrInvalidAPIToken) {
+		t.Errorf("ValidateAPIToken() after revoking the tokens of the user error = %v, want %v", err, ErrInvalidAPIToken)
+	}
+}
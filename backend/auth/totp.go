@@ -0,0 +1,402 @@
+package auth
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"time"
+
+	"hbd/encryption"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Issuer shown next to the account in authenticator apps
+const totpIssuer = "HBD"
+
+// Failed attempts in a row after which the second factor of a user is locked, and for how long, so the codes can't be
+// guessed by trying them all
+const (
+	maxTOTPFailures = 5
+	totpLockout     = 15 * time.Minute
+)
+
+// errTOTPLocked is returned while the second factor of a user is locked after too many failed attempts
+var errTOTPLocked = errors.New("too many failed two-factor authentication attempts, try again later")
+
+// totpLocked checks if the second factor of the user is locked after too many failed attempts
+func totpLocked(user *models.User, now time.Time) bool {
+	return user.TotpLockedUntil.Valid && user.TotpLockedUntil.Time.After(now)
+}
+
+// recordTOTPFailure counts a failed attempt of the user, locking their second factor once there are too many in a row.
+// The counter is updated in the database so concurrent requests all count.
+func recordTOTPFailure(ctx context.Context, exec boil.ContextExecutor, userID int64, now time.Time) error {
+	_, err := queries.Raw(`UPDATE users SET
+		totp_locked_until = CASE WHEN totp_failures + 1 >= $1 THEN $2 ELSE totp_locked_until END,
+		totp_failures = CASE WHEN totp_failures + 1 >= $1 THEN 0 ELSE totp_failures + 1 END
+		WHERE id = $3`, maxTOTPFailures, now.Add(totpLockout).UTC(), userID).ExecContext(ctx, exec)
+	return err
+}
+
+// totpHE handles the errors of verifySecondFactor, responding with 429 Too Many Requests while the second factor is
+// locked and with 500 on any other error
+func totpHE(c *gin.Context, err error) bool {
+	if errors.Is(err, errTOTPLocked) {
+		return helper.HE(c, err, http.StatusTooManyRequests, err.Error(), false)
+	}
+	return helper.HE(c, err, http.StatusInternalServerError, "an unexpected error occurred", false)
+}
+
+// verifySecondFactor checks a TOTP code or a recovery code of a user with two-factor authentication. The step of the
+// TOTP code is recorded and recovery codes are marked as used, with conditional updates so concurrent requests can't
+// use the same code twice. Failed attempts are counted, and errTOTPLocked is returned while there were too many.
+func verifySecondFactor(ctx context.Context, exec boil.ContextExecutor, user *models.User, code string, now time.Time) (bool, error) {
+	if !user.TotpEnabled || !user.TotpSecret.Valid {
+		return false, nil
+	}
+	if totpLocked(user, now) {
+		return false, errTOTPLocked
+	}
+	secret, err := encryption.Decrypt(env.MK, user.TotpSecret.String)
+	if err != nil {
+		return false, err
+	}
+
+	if step, ok := encryption.ValidateTOTP(secret, code, now, user.TotpLastStep.Int64); ok {
+		affected, err := models.Users(
+			models.UserWhere.ID.EQ(user.ID),
+			qm.Where("(totp_last_step IS NULL OR totp_last_step < ?)", step),
+		).UpdateAll(ctx, exec, models.M{models.UserColumns.TotpLastStep: step, models.UserColumns.TotpFailures: 0})
+		if err != nil {
+			return false, err
+		}
+		user.TotpLastStep = null.Int64From(step)
+		return affected == 1, nil
+	}
+
+	affected, err := models.RecoveryCodes(
+		models.RecoveryCodeWhere.UserID.EQ(user.ID.Int64),
+		models.RecoveryCodeWhere.CodeHash.EQ(encryption.HashRecoveryCode(code)),
+		models.RecoveryCodeWhere.UsedAt.IsNull(),
+	).UpdateAll(ctx, exec, models.M{models.RecoveryCodeColumns.UsedAt: now})
+	if err != nil {
+		return false, err
+	}
+	if affected == 1 {
+		_, err = models.Users(models.UserWhere.ID.EQ(user.ID)).UpdateAll(ctx, exec, models.M{models.UserColumns.TotpFailures: 0})
+		return err == nil, err
+	}
+	return false, recordTOTPFailure(ctx, exec, user.ID.Int64, now)
+}
+
+// replaceRecoveryCodes generates new recovery codes for the user, replacing the old ones, and returns them
+func replaceRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, userID int64) ([]string, error) {
+	if _, err := models.RecoveryCodes(models.RecoveryCodeWhere.UserID.EQ(userID)).DeleteAll(ctx, exec); err != nil {
+		return nil, err
+	}
+	codes, err := encryption.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	for _, code := range codes {
+		recoveryCode := &models.RecoveryCode{UserID: userID, CodeHash: encryption.HashRecoveryCode(code)}
+		if err = recoveryCode.Insert(ctx, exec, boil.Infer()); err != nil {
+			return nil, err
+		}
+	}
+	return codes, nil
+}
+
+// reauthenticate checks the password and a TOTP or recovery code of the user before sensitive changes to the two-factor
+// authentication, responding with an error if they don't match
+func reauthenticate(c *gin.Context, user *models.User, password, code string) bool {
+	if !user.TotpEnabled {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "two-factor authentication isn't enabled"})
+		return false
+	}
+	match, _, err := encryption.VerifyPassword(password, user.PasswordHash)
+	if helper.HE(c, err, http.StatusInternalServerError, "an unexpected error occurred", false) {
+		return false
+	}
+	if !match {
+		c.JSON(http.StatusUnauthorized, structs.Error{Error: "invalid password"})
+		return false
+	}
+	ok, err := verifySecondFactor(c, env.DB, user, code, time.Now())
+	if totpHE(c, err) {
+		return false
+	}
+	if !ok {
+		c.JSON(http.StatusUnauthorized, structs.Error{Error: "invalid two-factor authentication code"})
+		return false
+	}
+	return true
+}
+
+// @Summary Start the setup of two-factor authentication
+// @Description This endpoint generates a new TOTP secret for the authenticated user, returned along with its otpauth:// URI to show as a QR code in an authenticator app. Two-factor authentication isn't enabled until a code of the secret is verified with /enable-totp. Calling it again replaces the secret. The request must include a valid JWT token.
+// @Produce  json
+// @Success 200 {object} structs.TOTPSetup
+// @Failure 401 {object} structs.Error "invalid email"
+// @Failure 409 {object} structs.Error "two-factor authentication is already enabled"
+// @Failure 500 {object} structs.Error "failed to set up two-factor authentication"
+// @Security Bearer
+// @Router /setup-totp [post]
+// @Tags auth
+// @x-order 76
+func SetupTOTP(c *gin.Context) {
+	// Get the user from the context
+	user, email, err := GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "invalid email", false) {
+		return
+	}
+	if user.TotpEnabled {
+		c.JSON(http.StatusConflict, structs.Error{Error: "two-factor authentication is already enabled"})
+		return
+	}
+
+	// Generate the secret, it's stored encrypted until it's verified
+	secret, err := encryption.GenerateTOTPSecret()
+	if helper.HE(c, err, http.StatusInternalServerError, "failed to set up two-factor authentication", false) {
+		return
+	}
+	encryptedSecret, err := encryption.Encrypt(env.MK, secret)
+	if helper.HE(c, err, http.StatusInternalServerError, "failed to set up two-factor authentication", false) {
+		return
+	}
+	user.TotpSecret = null.StringFrom(hex.EncodeToString(encryptedSecret))
+	if _, err = user.Update(c, env.DB, boil.Whitelist(models.UserColumns.TotpSecret)); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "failed to set up two-factor authentication", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.TOTPSetup{Secret: secret, URI: encryption.TOTPURI(secret, totpIssuer, email)})
+}
+
+// @Summary Enable two-factor authentication
+// @Description This endpoint enables two-factor authentication once a code of the secret from /setup-totp is verified, and returns the recovery codes, which are only shown once. From then on logging in needs a TOTP code or a recovery code, and the other sessions and the API tokens of the user are ended. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   code  body     structs.TOTPCode  true  "TOTP code"
+// @Success 200 {object} structs.RecoveryCodes
+// @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 401 {object} structs.Error "invalid two-factor authentication code"
+// @Failure 409 {object} structs.Error "two-factor authentication is already enabled"
+// @Failure 429 {object} structs.Error "too many failed two-factor authentication attempts, try again later"
+// @Failure 500 {object} structs.Error "failed to enable two-factor authentication"
+// @Security Bearer
+// @Router /enable-totp [post]
+// @Tags auth
+// @x-order 77
+func EnableTOTP(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.TOTPCode
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "invalid email", false) {
+		return
+	}
+	if user.TotpEnabled {
+		c.JSON(http.StatusConflict, structs.Error{Error: "two-factor authentication is already enabled"})
+		return
+	}
+	if !user.TotpSecret.Valid {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "set up two-factor authentication first"})
+		return
+	}
+
+	// Verify the code of the new secret, failed attempts count like at login
+	now := time.Now()
+	if totpLocked(user, now) {
+		c.JSON(http.StatusTooManyRequests, structs.Error{Error: errTOTPLocked.Error()})
+		return
+	}
+	secret, err := encryption.Decrypt(env.MK, user.TotpSecret.String)
+	if helper.HE(c, err, http.StatusInternalServerError, "failed to enable two-factor authentication", false) {
+		return
+	}
+	step, ok := encryption.ValidateTOTP(secret, req.Code, now, 0)
+	if !ok {
+		if err = recordTOTPFailure(c, env.DB, user.ID.Int64, now); helper.HE(c, err, http.StatusInternalServerError, "failed to enable two-factor authentication", false) {
+			return
+		}
+		c.JSON(http.StatusUnauthorized, structs.Error{Error: "invalid two-factor authentication code"})
+		return
+	}
+
+	// Start a new transaction
+	tx, err := env.DB.Begin()
+	if helper.HE(c, err, http.StatusInternalServerError, "failed to begin transaction", false) {
+		return
+	}
+
+	user.TotpEnabled = true
+	user.TotpLastStep = null.Int64From(step)
+	user.TotpFailures = 0
+	if _, err = user.Update(c, tx, boil.Whitelist(models.UserColumns.TotpEnabled, models.UserColumns.TotpLastStep, models.UserColumns.TotpFailures)); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "failed to enable two-factor authentication", false)
+		return
+	}
+	codes, err := replaceRecoveryCodes(c, tx, user.ID.Int64)
+	if err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "failed to enable two-factor authentication", false)
+		return
+	}
+
+	// End the other sessions, they may have been started with a leaked password
+	if _, err = models.Sessions(
+		models.SessionWhere.UserID.EQ(user.ID.Int64),
+		models.SessionWhere.ID.NEQ(null.Int64From(c.GetInt64("SessionID"))),
+	).DeleteAll(c, tx); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "failed to revoke sessions", false)
+		return
+	}
+
+	// Revoke the API tokens for the same reason
+	if err = revokeAPITokens(c, tx, user.ID.Int64); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "failed to revoke API tokens", false)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "failed to commit transaction", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.RecoveryCodes{Codes: codes})
+}
+
+// @Summary Regenerate the recovery codes
+// @Description This endpoint replaces the recovery codes of the authenticated user with new ones, which are only shown once. It needs the password and a TOTP or recovery code. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   reauth  body     structs.TOTPReauth  true  "Password and code"
+// @Success 200 {object} structs.RecoveryCodes
+// @Failure 400 {object} structs.Error "two-factor authentication isn't enabled"
+// @Failure 401 {object} structs.Error "invalid password or two-factor authentication code"
+// @Failure 429 {object} structs.Error "too many failed two-factor authentication attempts, try again later"
+// @Failure 500 {object} structs.Error "failed to regenerate recovery codes"
+// @Security Bearer
+// @Router /regenerate-recovery-codes [post]
+// @Tags auth
+// @x-order 78
+func RegenerateRecoveryCodes(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.TOTPReauth
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "invalid email", false) {
+		return
+	}
+	if !reauthenticate(c, user, req.Password, req.Code) {
+		return
+	}
+
+	// Start a new transaction
+	tx, err := env.DB.Begin()
+	if helper.HE(c, err, http.StatusInternalServerError, "failed to begin transaction", false) {
+		return
+	}
+
+	codes, err := replaceRecoveryCodes(c, tx, user.ID.Int64)
+	if err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "failed to regenerate recovery codes", false)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "failed to commit transaction", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.RecoveryCodes{Codes: codes})
+}
+
+// @Summary Disable two-factor authentication
+// @Description This endpoint disables two-factor authentication for the authenticated user, deleting the TOTP secret and the recovery codes. It needs the password and a TOTP or recovery code. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   reauth  body     structs.TOTPReauth  true  "Password and code"
+// @Success 200 {object} structs.Success
+// @Failure 400 {object} structs.Error "two-factor authentication isn't enabled"
+// @Failure 401 {object} structs.Error "invalid password or two-factor authentication code"
+// @Failure 429 {object} structs.Error "too many failed two-factor authentication attempts, try again later"
+// @Failure 500 {object} structs.Error "failed to disable two-factor authentication"
+// @Security Bearer
+// @Router /disable-totp [post]
+// @Tags auth
+// @x-order 79
+func DisableTOTP(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.TOTPReauth
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "invalid email", false) {
+		return
+	}
+	if !reauthenticate(c, user, req.Password, req.Code) {
+		return
+	}
+
+	// Start a new transaction
+	tx, err := env.DB.Begin()
+	if helper.HE(c, err, http.StatusInternalServerError, "failed to begin transaction", false) {
+		return
+	}
+
+	user.TotpEnabled = false
+	user.TotpSecret = null.String{}
+	user.TotpLastStep = null.Int64{}
+	user.TotpFailures = 0
+	user.TotpLockedUntil = null.Time{}
+	if _, err = user.Update(c, tx, boil.Whitelist(models.UserColumns.TotpEnabled, models.UserColumns.TotpSecret, models.UserColumns.TotpLastStep, models.UserColumns.TotpFailures, models.UserColumns.TotpLockedUntil)); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "failed to disable two-factor authentication", false)
+		return
+	}
+	if _, err = models.RecoveryCodes(models.RecoveryCodeWhere.UserID.EQ(user.ID.Int64)).DeleteAll(c, tx); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "failed to disable two-factor authentication", false)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "failed to commit transaction", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.Success{Success: true})
+}
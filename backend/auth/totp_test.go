@@ -0,0 +1,300 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"hbd/encryption"
+	"hbd/env"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// newTestUser inserts a user with the password and returns it, with two-factor authentication enabled it also returns
+// the TOTP secret and the recovery codes
+func newTestUser(t *testing.T, email, password string, totp bool) (*models.User, string, []string) {
+	t.Helper()
+	ctx := context.Background()
+	passwordHash, err := encryption.HashPassword(password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	botAPIKey, _ := encryption.Encrypt(env.MK, "123:abc")
+	userID, _ := encryption.Encrypt(env.MK, "42")
+	user := &models.User{
+		EmailHash:             encryption.HashStringWithSHA256(email),
+		PasswordHash:          passwordHash,
+		ReminderTime:          "10:00",
+		Timezone:              "UTC",
+		TelegramBotAPIKey:     hex.EncodeToString(botAPIKey),
+		TelegramBotAPIKeyHash: encryption.HashStringWithSHA256("123:abc"),
+		TelegramUserID:        hex.EncodeToString(userID),
+		TelegramUserIDHash:    encryption.HashStringWithSHA256("42"),
+	}
+	if !totp {
+		if err = user.Insert(ctx, env.DB, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+		return user, "", nil
+	}
+
+	secret, err := encryption.GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	encryptedSecret, _ := encryption.Encrypt(env.MK, secret)
+	user.TotpSecret = null.StringFrom(hex.EncodeToString(encryptedSecret))
+	user.TotpEnabled = true
+	if err = user.Insert(ctx, env.DB, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	codes, err := replaceRecoveryCodes(ctx, env.DB, user.ID.Int64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return user, secret, codes
+}
+
+// reloadUser fetches the user again, verifySecondFactor reads the lockout from the user it's given
+func reloadUser(t *testing.T, user *models.User) *models.User {
+	t.Helper()
+	user, err := models.FindUser(context.Background(), env.DB, user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return user
+}
+
+// totpCode computes the code of the secret at a time
+func totpCode(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+	code, err := encryption.TOTPCode(secret, encryption.TOTPStep(at))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+// serve calls a handler with a JSON body as the user with the email, and returns the status and the response
+func serve(t *testing.T, handler gin.HandlerFunc, email string, body any) (int, map[string]any) {
+	t.Helper()
+	payload, _ := json.Marshal(body)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(payload))
+	c.Request.Header.Set("Content-Type", "application/json")
+	if email != "" {
+		c.Set("Email", email)
+	}
+	handler(c)
+	response := map[string]any{}
+	json.Unmarshal(w.Body.Bytes(), &response)
+	return w.Code, response
+}
+
+func TestVerifySecondFactor(t *testing.T) {
+	ctx := context.Background()
+	// Fixed clock in the middle of a step
+	now := time.Unix(1700000010, 0)
+
+	t.Run("TOTP codes can't be replayed", func(t *testing.T) {
+		user, secret, _ := newTestUser(t, "verify-totp@example.com", "pw", true)
+		code := totpCode(t, secret, now)
+		if ok, err := verifySecondFactor(ctx, env.DB, user, code, now); !ok || err != nil {
+			t.Fatalf("verifySecondFactor() = %v, %v, want a valid code", ok, err)
+		}
+		if ok, _ := verifySecondFactor(ctx, env.DB, user, code, now.Add(time.Second)); ok {
+			t.Error("verifySecondFactor() accepted the same code twice")
+		}
+		// The user of a concurrent request hasn't seen the step of the code yet
+		if ok, _ := verifySecondFactor(ctx, env.DB, reloadUser(t, user), totpCode(t, secret, now.Add(-30*time.Second)), now); ok {
+			t.Error("verifySecondFactor() accepted the code of an earlier step")
+		}
+		stale := reloadUser(t, user)
+		stale.TotpLastStep = null.Int64{}
+		if ok, _ := verifySecondFactor(ctx, env.DB, stale, code, now); ok {
+			t.Error("verifySecondFactor() accepted a code already used by a concurrent request")
+		}
+		if ok, err := verifySecondFactor(ctx, env.DB, user, totpCode(t, secret, now.Add(30*time.Second)), now.Add(30*time.Second)); !ok || err != nil {
+			t.Errorf("verifySecondFactor() with the code of the next step = %v, %v, want a valid code", ok, err)
+		}
+	})
+
+	t.Run("recovery codes work once", func(t *testing.T) {
+		user, _, codes := newTestUser(t, "verify-recovery@example.com", "pw", true)
+		if ok, err := verifySecondFactor(ctx, env.DB, user, codes[0], now); !ok || err != nil {
+			t.Fatalf("verifySecondFactor() with a recovery code = %v, %v, want a valid code", ok, err)
+		}
+		if ok, _ := verifySecondFactor(ctx, env.DB, user, codes[0], now); ok {
+			t.Error("verifySecondFactor() accepted a recovery code twice")
+		}
+		if ok, _ := verifySecondFactor(ctx, env.DB, user, codes[1], now); !ok {
+			t.Error("using a recovery code used the others too")
+		}
+	})
+
+	t.Run("users without two-factor authentication", func(t *testing.T) {
+		user, _, _ := newTestUser(t, "verify-none@example.com", "pw", false)
+		if ok, err := verifySecondFactor(ctx, env.DB, user, "000000", now); ok || err != nil {
+			t.Errorf("verifySecondFactor() = %v, %v, want an invalid code", ok, err)
+		}
+	})
+
+	t.Run("failed attempts lock the second factor", func(t *testing.T) {
+		user, secret, codes := newTestUser(t, "verify-lockout@example.com", "pw", true)
+
+		// A valid code resets the failed attempts
+		for i := 0; i < maxTOTPFailures-1; i++ {
+			verifySecondFactor(ctx, env.DB, user, "000000", now)
+		}
+		if ok, _ := verifySecondFactor(ctx, env.DB, reloadUser(t, user), codes[0], now); !ok {
+			t.Fatal("verifySecondFactor() rejected a valid code before the limit")
+		}
+		for i := 0; i < maxTOTPFailures-1; i++ {
+			verifySecondFactor(ctx, env.DB, user, "000000", now)
+		}
+		if user = reloadUser(t, user); totpLocked(user, now) {
+			t.Fatal("the failed attempts before a valid code counted")
+		}
+
+		verifySecondFactor(ctx, env.DB, user, "000000", now)
+		user = reloadUser(t, user)
+		if _, err := verifySecondFactor(ctx, env.DB, user, totpCode(t, secret, now), now); !errors.Is(err, errTOTPLocked) {
+			t.Fatalf("verifySecondFactor() after %d failed attempts error = %v, want %v", maxTOTPFailures, err, errTOTPLocked)
+		}
+
+		later := now.Add(totpLockout + time.Second)
+		if ok, err := verifySecondFactor(ctx, env.DB, user, totpCode(t, secret, later), later); !ok || err != nil {
+			t.Errorf("verifySecondFactor() after the lockout = %v, %v, want a valid code", ok, err)
+		}
+	})
+}
+
+func TestLoginTOTP(t *testing.T) {
+	const email, password = "login-totp@example.com", "correct horse"
+	_, secret, codes := newTestUser(t, email, password, true)
+	login := func(password, code string) (int, map[string]any) {
+		return serve(t, Login, "", structs.LoginRequest{Email: email, Password: password, TOTPCode: code})
+	}
+
+	status, response := login(password, "")
+	if status != http.StatusUnauthorized || response["totp_required"] != true {
+		t.Errorf("Login() without a code = %d %v, want a 401 challenge", status, response)
+	}
+	if status, _ = login("wrong", totpCode(t, secret, time.Now())); status != http.StatusUnauthorized {
+		t.Errorf("Login() with a wrong password = %d, want 401", status)
+	}
+	if status, response = login(password, "000000"); status != http.StatusUnauthorized || response["totp_required"] != nil {
+		t.Errorf("Login() with a wrong code = %d %v, want 401", status, response)
+	}
+
+	code := totpCode(t, secret, time.Now())
+	if status, response = login(password, code); status != http.StatusOK || response["token"] == "" {
+		t.Errorf("Login() with a valid code = %d %v, want 200 with a token", status, response)
+	}
+	if status, _ = login(password, code); status != http.StatusUnauthorized {
+		t.Errorf("Login() with a used code = %d, want 401", status)
+	}
+	if status, _ = login(password, codes[0]); status != http.StatusOK {
+		t.Errorf("Login() with a recovery code = %d, want 200", status)
+	}
+
+	// The second factor is locked after too many wrong codes, even for valid codes
+	for i := 0; i < maxTOTPFailures; i++ {
+		login(password, "000000")
+	}
+	if status, _ = login(password, codes[1]); status != http.StatusTooManyRequests {
+		t.Errorf("Login() after %d wrong codes = %d, want 429", maxTOTPFailures, status)
+	}
+
+	// Users without two-factor authentication don't need a code
+	newTestUser(t, "login-password@example.com", password, false)
+	if status, _ = serve(t, Login, "", structs.LoginRequest{Email: "login-password@example.com", Password: password}); status != http.StatusOK {
+		t.Errorf("Login() without two-factor authentication = %d, want 200", status)
+	}
+}
+
+func TestEnableTOTP(t *testing.T) {
+	const email = "enable-totp@example.com"
+	user, _, _ := newTestUser(t, email, "pw", false)
+
+	if status, _ := serve(t, EnableTOTP, email, structs.TOTPCode{Code: "000000"}); status != http.StatusBadRequest {
+		t.Errorf("EnableTOTP() before the setup = %d, want 400", status)
+	}
+	status, response := serve(t, SetupTOTP, email, nil)
+	secret, _ := response["secret"].(string)
+	if status != http.StatusOK || secret == "" {
+		t.Fatalf("SetupTOTP() = %d %v, want a secret", status, response)
+	}
+
+	// API tokens are revoked when it's enabled
+	apiToken := &models.APIToken{UserID: user.ID.Int64, Name: "cli", TokenHash: "hash", Scopes: ScopeAccountAdmin}
+	if err := apiToken.Insert(context.Background(), env.DB, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if status, _ = serve(t, EnableTOTP, email, structs.TOTPCode{Code: "000000"}); status != http.StatusUnauthorized {
+		t.Errorf("EnableTOTP() with a wrong code = %d, want 401", status)
+	}
+	if reloadUser(t, user).TotpEnabled {
+		t.Fatal("EnableTOTP() enabled two-factor authentication with a wrong code")
+	}
+
+	status, response = serve(t, EnableTOTP, email, structs.TOTPCode{Code: totpCode(t, secret, time.Now())})
+	codes, _ := response["codes"].([]any)
+	if status != http.StatusOK || len(codes) != encryption.RecoveryCodeCount {
+		t.Fatalf("EnableTOTP() with a valid code = %d %v, want the recovery codes", status, response)
+	}
+	if user = reloadUser(t, user); !user.TotpEnabled || !user.TotpLastStep.Valid {
+		t.Error("EnableTOTP() didn't enable two-factor authentication or record the step of the code")
+	}
+	if exists, _ := models.APITokens(models.APITokenWhere.UserID.EQ(user.ID.Int64)).Exists(context.Background(), env.DB); exists {
+		t.Error("EnableTOTP() didn't revoke the API tokens")
+	}
+	if status, _ = serve(t, EnableTOTP, email, structs.TOTPCode{Code: totpCode(t, secret, time.Now())}); status != http.StatusConflict {
+		t.Errorf("EnableTOTP() when it's enabled = %d, want 409", status)
+	}
+}
+
+func TestDisableTOTP(t *testing.T) {
+	const email, password = "disable-totp@example.com", "pw"
+	user, secret, codes := newTestUser(t, email, password, true)
+	disable := func(password, code string) int {
+		status, _ := serve(t, DisableTOTP, email, structs.TOTPReauth{Password: password, Code: code})
+		return status
+	}
+
+	if status := disable("wrong", totpCode(t, secret, time.Now())); status != http.StatusUnauthorized {
+		t.Errorf("DisableTOTP() with a wrong password = %d, want 401", status)
+	}
+	if status := disable(password, "000000"); status != http.StatusUnauthorized {
+		t.Errorf("DisableTOTP() with a wrong code = %d, want 401", status)
+	}
+	if !reloadUser(t, user).TotpEnabled {
+		t.Fatal("DisableTOTP() disabled two-factor authentication without reauthentication")
+	}
+
+	if status := disable(password, codes[0]); status != http.StatusOK {
+		t.Fatalf("DisableTOTP() with the password and a recovery code = %d, want 200", status)
+	}
+	if user = reloadUser(t, user); user.TotpEnabled || user.TotpSecret.Valid {
+		t.Error("DisableTOTP() didn't remove the TOTP secret")
+	}
+	if exists, _ := models.RecoveryCodes(models.RecoveryCodeWhere.UserID.EQ(user.ID.Int64)).Exists(context.Background(), env.DB); exists {
+		t.Error("DisableTOTP() didn't delete the recovery codes")
+	}
+	if status := disable(password, codes[1]); status != http.StatusBadRequest {
+		t.Errorf("DisableTOTP() when it's disabled = %d, want 400", status)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"hbd/encryption"
 	"hbd/env"
+	"hbd/helper"
+	"hbd/lists"
 	"hbd/models"
 	"hbd/structs"
 	"time"
@@ -106,8 +108,13 @@ func GetUserData(c *gin.Context) (*structs.UserData, error) {
 	// Convert the combined time to the user's timezone
 	reminderTimeLocal := reminderTime.In(location).Format("15:04")
 
-	// Find the birthdays by user id
-	birthdays, err := models.Birthdays(models.BirthdayWhere.UserID.EQ(user.ID.Int64), qm.Select("id", "name", "date")).All(c, env.DB)
+	// Find the birthdays of the user and of their shared lists with their tags, grouped by event type
+	birthdays, err := models.Birthdays(
+		lists.ReadableBirthdays(user.ID.Int64),
+		qm.Select("id", "name", "date", "year_known", "event_type", "years_label", "calendar", "notes", "telegram_username", "phone", "email", "list_id"),
+		qm.Load(models.BirthdayRels.BirthdayTags),
+		qm.OrderBy("event_type, id"),
+	).All(c, env.DB)
 	if err != nil {
 		return nil, errors.New("failed to fetch birthdays")
 	}
@@ -117,11 +124,7 @@ func GetUserData(c *gin.Context) (*structs.UserData, error) {
 
 	// Iterate over the birthdays and append the filtered data to the new slice
 	for _, birthday := range birthdays {
-		filteredBirthdays = append(filteredBirthdays, structs.BirthdayFull{
-			ID:   birthday.ID.Int64,
-			Name: birthday.Name,
-			Date: birthday.Date.Format("2006-01-02"),
-		})
+		filteredBirthdays = append(filteredBirthdays, helper.BirthdayFull(birthday))
 	}
 
 	// User data
@@ -132,6 +135,10 @@ func GetUserData(c *gin.Context) (*structs.UserData, error) {
 		ReminderTime:      reminderTimeLocal,
 		Timezone:          user.Timezone,
 		Birthdays:         filteredBirthdays,
+		Milestones:        helper.ParseMilestones(user.Milestones),
+		MilestoneLeadDays: int(user.MilestoneLeadDays),
+		TOTPEnabled:       user.TotpEnabled,
+		Version:           user.Version,
 	}
 
 	return &userData, nil
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"hbd/encryption"
+	"hbd/env"
+	"hbd/models"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// The rows of a user go away with it through the foreign keys, which SQLite only enforces when they're turned on
+func TestUserRowsCascade(t *testing.T) {
+	ctx := context.Background()
+	owner, _, _ := newTestUser(t, "cascade-owner@example.com", "pw", false)
+	user, _, _ := newTestUser(t, "cascade@example.com", "pw", false)
+	list := &models.List{Name: "Friends"}
+	if err := list.Insert(ctx, env.DB, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	rows := []interface {
+		Insert(context.Context, boil.ContextExecutor, boil.Columns) error
+	}{
+		&models.ListMember{ListID: list.ID.Int64, UserID: owner.ID.Int64, Role: "owner"},
+		&models.ListMember{ListID: list.ID.Int64, UserID: user.ID.Int64, Role: "editor"},
+		&models.ListShare{ListID: list.ID.Int64, UserID: user.ID.Int64, TokenHash: encryption.HashStringWithSHA256("share")},
+		&models.Invitation{ListID: list.ID, UserID: user.ID.Int64, Role: "viewer", TokenHash: encryption.HashStringWithSHA256("invite"), ExpiresAt: time.Now().Add(time.Hour)},
+	}
+	for _, row := range rows {
+		if err := row.Insert(ctx, env.DB, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := user.Delete(ctx, env.DB); err != nil {
+		t.Fatal(err)
+	}
+	shares, _ := models.ListShares(models.ListShareWhere.UserID.EQ(user.ID.Int64)).Count(ctx, env.DB)
+	invitations, _ := models.Invitations(models.InvitationWhere.UserID.EQ(user.ID.Int64)).Count(ctx, env.DB)
+	members, _ := models.ListMembers(models.ListMemberWhere.ListID.EQ(list.ID.Int64)).Count(ctx, env.DB)
+	if shares != 0 || invitations != 0 || members != 1 {
+		t.Errorf("after deleting the user there are %d shares, %d invitations and %d members, want 0, 0 and 1", shares, invitations, members)
+	}
+}
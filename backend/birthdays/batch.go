@@ -0,0 +1,214 @@
+package birthdays
+
+import (
+	"errors"
+	"net/http"
+
+	"hbd/auth"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/lists"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Operations of a batch
+const (
+	batchCreate = "create"
+	batchUpdate = "update"
+	batchDelete = "delete"
+)
+
+// batchStep is a validated operation of a batch, ready to be applied
+type batchStep struct {
+	Op        string
+	Birthday  *models.Birthday
+	Tags      []string
+	OldValues *structs.BirthdayFull
+}
+
+// batchOperation returns the name of the operation and the ID of the birthday it changes, if any
+func batchOperation(op structs.BatchOperation) (string, int64, error) {
+	var name string
+	var id int64
+	var request any
+	set := 0
+	if op.Create != nil {
+		name, request = batchCreate, op.Create
+		set++
+	}
+	if op.Update != nil {
+		name, id, request = batchUpdate, op.Update.ID, op.Update
+		set++
+	}
+	if op.Delete != nil {
+		name, id, request = batchDelete, op.Delete.ID, op.Delete
+		set++
+	}
+	if set != 1 {
+		return "", 0, errors.New("exactly one of create, update and delete must be set")
+	}
+
+	// The operations are validated one by one so the errors can be reported for each of them
+	if err := binding.Validator.ValidateStruct(request); err != nil {
+		return name, id, errors.New("invalid " + name + " operation, missing required fields")
+	}
+	return name, id, nil
+}
+
+// @Summary Apply a batch of changes to birthdays
+// @Description This endpoint creates, updates and deletes birthdays of the authenticated user and of the shared lists they can edit in a single request, with up to 100 operations. The operations are applied in order within a transaction, if any of them fails validation none are applied and the errors are returned for each operation. Deleted birthdays are moved to the trash. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   batch  body     structs.BatchBirthdaysRequest  true  "Operations"
+// @Success 200 {object} structs.BatchBirthdaysResult
+// @Failure 400 {object} structs.BatchBirthdaysResult "Invalid operations, nothing was applied"
+// @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 500 {object} structs.Error "Failed to fetch lists"
+// @Failure 500 {object} structs.Error "Failed to fetch birthdays"
+// @Failure 500 {object} structs.Error "Failed to apply operations"
+// @Security Bearer
+// @Router /batch-birthdays [post]
+// @Tags birthdays
+// @x-order 25
+func BatchBirthdays(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.BatchBirthdaysRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Check the shape of each operation and collect the birthdays they change
+	results := make([]structs.BatchOperationResult, len(req.Operations))
+	var ids []int64
+	for i, op := range req.Operations {
+		name, id, err := batchOperation(op)
+		results[i] = structs.BatchOperationResult{Index: i, Op: name, ID: id}
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		if id != 0 {
+			ids = append(ids, id)
+		}
+	}
+
+	// Get the roles of the user in their shared lists
+	roles, err := lists.Roles(c, env.DB, userData.ID)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch lists", false) {
+		return
+	}
+
+	// Get the changed birthdays with their tags
+	existing := map[int64]*models.Birthday{}
+	if len(ids) > 0 {
+		birthdays, err := models.Birthdays(
+			lists.ReadableBirthdays(userData.ID),
+			models.BirthdayWhere.ID.IN(ids),
+			qm.Load(models.BirthdayRels.BirthdayTags),
+		).All(c, env.DB)
+		if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch birthdays", false) {
+			return
+		}
+		for _, b := range birthdays {
+			existing[b.ID.Int64] = b
+		}
+	}
+
+	// Validate every operation before changing anything
+	steps := make([]batchStep, len(req.Operations))
+	changed := map[int64]bool{}
+	valid := true
+	for i, op := range req.Operations {
+		result := &results[i]
+		if result.Error != "" {
+			valid = false
+			continue
+		}
+
+		step := batchStep{Op: result.Op}
+		var err error
+		switch result.Op {
+		case batchCreate:
+			step.Birthday, step.Tags, err = newBirthday(userData.ID, *op.Create)
+			if err == nil && op.Create.ListID != 0 && !lists.CanEdit(roles[op.Create.ListID]) {
+				err = errors.New("not allowed to add birthdays to this list")
+			}
+		default:
+			b, ok := existing[result.ID]
+			switch {
+			case !ok:
+				err = errors.New("birthday doesn't exist")
+			case !lists.CanEdit(lists.BirthdayRole(b, userData.ID, roles)):
+				err = errors.New("not allowed to change this birthday")
+			case changed[result.ID]:
+				err = errors.New("birthday is already changed by another operation")
+			case result.Op == batchUpdate:
+				step.Birthday, step.OldValues = b, snapshot(b)
+				step.Tags, err = modifyBirthday(b, *op.Update)
+			default:
+				step.Birthday = b
+			}
+			changed[result.ID] = true
+		}
+		if err != nil {
+			result.Error = err.Error()
+			valid = false
+			continue
+		}
+		steps[i] = step
+	}
+	if !valid {
+		c.JSON(http.StatusBadRequest, structs.BatchBirthdaysResult{Success: false, Results: results})
+		return
+	}
+
+	// Start a new transaction so either all operations are applied or none
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Apply the operations in order within the transaction
+	for i, step := range steps {
+		switch step.Op {
+		case batchCreate:
+			err = insertBirthday(c, tx, step.Birthday, step.Tags, userData.ID)
+		case batchUpdate:
+			err = updateBirthday(c, tx, step.Birthday, step.Tags, step.OldValues, userData.ID)
+		case batchDelete:
+			err = trashBirthday(c, tx, step.Birthday, userData.ID)
+		}
+		if err != nil {
+			tx.Rollback() // Rollback the transaction on error
+			helper.VersionHE(c, err, "Failed to apply operations")
+			return
+		}
+
+		results[i].ID = step.Birthday.ID.Int64
+		if step.Op != batchDelete {
+			full := helper.BirthdayFull(step.Birthday)
+			results[i].Birthday = &full
+		}
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.BatchBirthdaysResult{Success: true, Results: results})
+}
@@ -1,19 +1,24 @@
 package birthdays
 
 import (
+	"context"
 	"hbd/auth"
 	"hbd/env"
 	"hbd/helper"
+	"hbd/lists"
 	"hbd/models"
 	"hbd/structs"
 	"net/http"
-	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
+// Maximum length of the name of a birthday
+const maxNameLength = 100
+
 // @Summary Check user reminders
 // @Description This endpoint checks for user reminders through a POST request. The request must include a valid JWT token.
 // @Accept  json
@@ -41,12 +46,14 @@ func CallReminderChecker(c *gin.Context) {
 }
 
 // @Summary Add a new birthday
-// @Description This endpoint adds a new birthday for the authenticated user. The request must include a valid JWT token.
+// @Description This endpoint adds a new birthday for the authenticated user, or to one of their shared lists they can edit. The request must include a valid JWT token.
 // @Accept  json
 // @Produce  json
 // @Param   birthday  body     structs.BirthdayNameDateAdd  true  "Add birthday"
 // @Success 200 {object} structs.BirthdayFull
-// @Failure 400 {object} structs.Error "Invalid request or date format"
+// @Header  200 {string} ETag "Version of the birthday"
+// @Failure 400 {object} structs.Error "Invalid request, name, date format, event type, calendar or details"
+// @Failure 403 {object} structs.Error "Not allowed to add birthdays to this list"
 // @Failure 500 {object} structs.Error "Failed to insert birthday"
 // @Security Bearer
 // @Router /add-birthday [post]
@@ -66,41 +73,54 @@ func AddBirthday(c *gin.Context) {
 		return
 	}
 
-	// Parse the date from the request
-	date, err := time.Parse("2006-01-02", req.Date)
+	// Create a Birthday model with the parsed data
+	b, tags, err := newBirthday(userData.ID, req)
 	if err != nil {
-		helper.HE(c, err, http.StatusBadRequest, "Invalid date format", true)
+		helper.HE(c, err, http.StatusBadRequest, "Invalid name, date format, event type, calendar or details", true)
 		return
 	}
 
-	// Create a Birthday model with the parsed data
-	b := models.Birthday{
-		UserID: userData.ID,
-		Name:   req.Name,
-		Date:   date,
+	// Check that the user can add birthdays to the list
+	if !checkListEditable(c, userData.ID, req.ListID) {
+		return
+	}
+
+	// Start a new transaction so the birthday is inserted along with its tags
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
 	}
 
 	// Insert the birthday into the database
-	err = b.Insert(c, env.DB, boil.Infer())
-	if helper.HE(c, err, http.StatusInternalServerError, "Failed to insert birthday", false) {
+	if err = insertBirthday(c, tx, b, tags, userData.ID); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to insert birthday", false)
 		return
 	}
 
-	// Respond with a success message
-	c.JSON(http.StatusOK, structs.BirthdayFull{
-		ID:   b.ID.Int64,
-		Name: b.Name,
-		Date: b.Date.Format("2006-01-02"),
-	})
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	// Respond with the birthday and its version
+	c.Header("ETag", helper.ETag(b.Version))
+	c.JSON(http.StatusOK, helper.BirthdayFull(b))
 }
 
 // @Summary Delete a birthday
-// @Description This endpoint deletes a birthday for the authenticated user. The request must include a valid JWT token.
+// @Description This endpoint moves a birthday of the authenticated user to the trash, from where it can be restored until it's purged after the retention period. Birthdays of shared lists can be deleted by the owners and editors of the list. The request must include a valid JWT token.
 // @Accept  json
 // @Produce  json
 // @Param   birthday  body     structs.BirthdayNameDateModify  true  "Delete birthday"
+// @Param   If-Match  header  string  false  "Only apply the change if the birthday still has this ETag"
 // @Success 200 {object} structs.Success
 // @Failure 400 {object} structs.Error "Invalid request or date format"
+// @Failure 403 {object} structs.Error "Not allowed to change this birthday"
+// @Failure 412 {object} structs.Error "Birthday was changed by another request"
+// @Failure 500 {object} structs.Error "Birthday doesn't exist"
 // @Failure 500 {object} structs.Error "Failed to delete birthday"
 // @Security Bearer
 // @Router /delete-birthday [delete]
@@ -120,12 +140,43 @@ func DeleteBirthday(c *gin.Context) {
 		return
 	}
 
-	// Perform the delete operation on the birthdays matching the criteria
-	_, err = models.Birthdays(
-		models.BirthdayWhere.UserID.EQ(userData.ID),
+	// Get the birthday with its tags, from the user's own birthdays or their shared lists
+	birthday, err := models.Birthdays(
+		lists.ReadableBirthdays(userData.ID),
 		models.BirthdayWhere.ID.EQ(null.Int64From(req.ID)),
-	).DeleteAll(c, env.DB)
-	if helper.HE(c, err, http.StatusInternalServerError, "Failed to delete birthday", false) {
+		qm.Load(models.BirthdayRels.BirthdayTags),
+	).One(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Birthday doesn't exist", false) {
+		return
+	}
+
+	// Check that the user isn't only a viewer of the list of the birthday
+	if !checkEditable(c, userData.ID, birthday) {
+		return
+	}
+
+	// Check that the birthday wasn't changed since the client read it
+	if helper.PreconditionFailed(c, helper.ETag(birthday.Version)) {
+		return
+	}
+
+	// Start a new transaction so the deletion is recorded in the history
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Move the birthday to the trash within the transaction
+	if err = trashBirthday(c, tx, birthday, userData.ID); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.VersionHE(c, err, "Failed to delete birthday")
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
 		return
 	}
 
@@ -134,12 +185,16 @@ func DeleteBirthday(c *gin.Context) {
 }
 
 // @Summary Modify a birthday
-// @Description This endpoint modifies a birthday for the authenticated user. The request must include a valid JWT token.
+// @Description This endpoint modifies a birthday for the authenticated user. Birthdays of shared lists can be modified by the owners and editors of the list. The request must include a valid JWT token.
 // @Accept  json
 // @Produce  json
 // @Param   birthday  body     structs.BirthdayNameDateModify  true  "Modify birthday"
+// @Param   If-Match  header  string  false  "Only apply the change if the birthday still has this ETag"
 // @Success 200 {object} structs.Success
-// @Failure 400 {object} structs.Error "Invalid request or date format"
+// @Header  200 {string} ETag "Version of the birthday"
+// @Failure 400 {object} structs.Error "Invalid request, name, date format, event type, calendar or details"
+// @Failure 403 {object} structs.Error "Not allowed to change this birthday"
+// @Failure 412 {object} structs.Error "Birthday was changed by another request"
 // @Failure 500 {object} structs.Error "Birthday doesn't exist"
 // @Failure 500 {object} structs.Error "Failed to update birthday"
 // @Security Bearer
@@ -160,27 +215,34 @@ func ModifyBirthday(c *gin.Context) {
 		return
 	}
 
-	// Parse the date from the request
-	date, err := time.Parse("2006-01-02", req.Date)
-	if err != nil {
-		helper.HE(c, err, http.StatusBadRequest, "Invalid date format", true)
-		return
-	}
-
-	// Get the birthday
+	// Get the birthday with its tags, from the user's own birthdays or their shared lists
 	birthday, err := models.Birthdays(
-		models.BirthdayWhere.UserID.EQ(userData.ID),
+		lists.ReadableBirthdays(userData.ID),
 		models.BirthdayWhere.ID.EQ(null.Int64From(req.ID)),
+		qm.Load(models.BirthdayRels.BirthdayTags),
 	).One(c, env.DB)
 	if helper.HE(c, err, http.StatusInternalServerError, "Birthday doesn't exist", false) {
 		return
 	}
 
-	// Update the birthday
-	birthday.Name = req.Name
-	birthday.Date = date
+	// Check that the user isn't only a viewer of the list of the birthday
+	if !checkEditable(c, userData.ID, birthday) {
+		return
+	}
+
+	// Check that the birthday wasn't changed since the client read it
+	if helper.PreconditionFailed(c, helper.ETag(birthday.Version)) {
+		return
+	}
+
+	// Apply the changes to the birthday
+	oldValues := snapshot(birthday)
+	tags, err := modifyBirthday(birthday, req)
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid name, date format, event type, calendar or details", true) {
+		return
+	}
 
-	// Start a new transaction
+	// Start a new transaction so the birthday is updated along with its tags
 	tx, err := env.DB.Begin()
 	if err != nil {
 		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
@@ -188,10 +250,9 @@ func ModifyBirthday(c *gin.Context) {
 	}
 
 	// Perform the update within the transaction
-	_, err = birthday.Update(c, tx, boil.Infer())
-	if err != nil {
+	if err = updateBirthday(c, tx, birthday, tags, oldValues, userData.ID); err != nil {
 		tx.Rollback() // Rollback the transaction on error
-		helper.HE(c, err, http.StatusInternalServerError, "Failed to update birthday", false)
+		helper.VersionHE(c, err, "Failed to update birthday")
 		return
 	}
 
@@ -201,5 +262,173 @@ func ModifyBirthday(c *gin.Context) {
 		return
 	}
 
+	c.Header("ETag", helper.ETag(birthday.Version))
 	c.JSON(http.StatusOK, structs.Success{Success: true})
 }
+
+// newBirthday creates a Birthday model for the user from the request, along with its validated tags
+func newBirthday(userID int64, req structs.BirthdayNameDateAdd) (*models.Birthday, []string, error) {
+	// Check the length of the name
+	if err := helper.CheckStringLength("Name", req.Name, maxNameLength, 1, 0, false); err != nil {
+		return nil, nil, err
+	}
+
+	// Parse the date from the request
+	date, yearKnown, err := helper.ParseBirthdayDate(req.Date)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Validate the event type and years label
+	eventTypeName, err := validateEvent(req.EventType, req.YearsLabel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Validate the calendar system of the date
+	calendar, err := validateCalendar(req.Calendar, yearKnown)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Validate the notes, tags and contact details
+	details, err := validateDetails(birthdayDetails{
+		Notes:            req.Notes,
+		Tags:             req.Tags,
+		TelegramUsername: req.TelegramUsername,
+		Phone:            req.Phone,
+		Email:            req.Email,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := &models.Birthday{
+		UserID:     userID,
+		Name:       req.Name,
+		Date:       date,
+		YearKnown:  yearKnown,
+		EventType:  eventTypeName,
+		YearsLabel: null.NewString(req.YearsLabel, req.YearsLabel != ""),
+		Calendar:   calendar,
+		ListID:     null.NewInt64(req.ListID, req.ListID != 0),
+	}
+	setDetails(b, details)
+	return b, details.Tags, nil
+}
+
+// insertBirthday inserts the birthday with its tags and records it in its history as added by the user
+func insertBirthday(ctx context.Context, exec boil.ContextExecutor, b *models.Birthday, tags []string, userID int64) error {
+	if err := b.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+	if err := setTags(ctx, exec, b, tags); err != nil {
+		return err
+	}
+	return recordHistory(ctx, exec, userID, b.ID.Int64, historyInsert, nil, snapshot(b))
+}
+
+// modifyBirthday applies the changes in the request to the birthday, keeping the optional fields that weren't provided,
+// and returns its validated tags
+func modifyBirthday(b *models.Birthday, req structs.BirthdayNameDateModify) ([]string, error) {
+	// Check the length of the name
+	if err := helper.CheckStringLength("Name", req.Name, maxNameLength, 1, 0, false); err != nil {
+		return nil, err
+	}
+
+	// Parse the date from the request
+	date, yearKnown, err := helper.ParseBirthdayDate(req.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the event type and years label if they weren't provided
+	eventTypeName, yearsLabel := b.EventType, b.YearsLabel.String
+	if req.EventType != nil {
+		eventTypeName = *req.EventType
+	}
+	if req.YearsLabel != nil {
+		yearsLabel = *req.YearsLabel
+	}
+	eventTypeName, err = validateEvent(eventTypeName, yearsLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the calendar system if it wasn't provided
+	calendar := b.Calendar
+	if req.Calendar != nil {
+		calendar = *req.Calendar
+	}
+	calendar, err = validateCalendar(calendar, yearKnown)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the notes, tags and contact details if they weren't provided
+	details := detailsOf(b)
+	if req.Notes != nil {
+		details.Notes = *req.Notes
+	}
+	if req.Tags != nil {
+		details.Tags = *req.Tags
+	}
+	if req.TelegramUsername != nil {
+		details.TelegramUsername = *req.TelegramUsername
+	}
+	if req.Phone != nil {
+		details.Phone = *req.Phone
+	}
+	if req.Email != nil {
+		details.Email = *req.Email
+	}
+	details, err = validateDetails(details)
+	if err != nil {
+		return nil, err
+	}
+
+	b.Name = req.Name
+	b.Date = date
+	b.YearKnown = yearKnown
+	b.EventType = eventTypeName
+	b.YearsLabel = null.NewString(yearsLabel, yearsLabel != "")
+	b.Calendar = calendar
+	setDetails(b, details)
+	return details.Tags, nil
+}
+
+// bumpBirthdayVersion increments the version of the birthday, failing with helper.ErrVersionConflict if it was changed
+// since it was loaded
+func bumpBirthdayVersion(ctx context.Context, exec boil.ContextExecutor, b *models.Birthday) error {
+	if err := helper.BumpVersion(ctx, exec, models.TableNames.Birthdays, b.ID.Int64, b.Version); err != nil {
+		return err
+	}
+	b.Version++
+	return nil
+}
+
+// updateBirthday saves the birthday with its tags and records the change from the old values in its history
+func updateBirthday(ctx context.Context, exec boil.ContextExecutor, b *models.Birthday, tags []string, oldValues *structs.BirthdayFull, userID int64) error {
+	if err := bumpBirthdayVersion(ctx, exec, b); err != nil {
+		return err
+	}
+	if _, err := b.Update(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+	if err := setTags(ctx, exec, b, tags); err != nil {
+		return err
+	}
+	return recordHistory(ctx, exec, userID, b.ID.Int64, historyUpdate, oldValues, snapshot(b))
+}
+
+// trashBirthday moves the birthday to the trash, setting its deletion time, and records the deletion in its history
+func trashBirthday(ctx context.Context, exec boil.ContextExecutor, b *models.Birthday, userID int64) error {
+	oldValues := snapshot(b)
+	if err := bumpBirthdayVersion(ctx, exec, b); err != nil {
+		return err
+	}
+	if _, err := b.Delete(ctx, exec, false); err != nil {
+		return err
+	}
+	return recordHistory(ctx, exec, userID, b.ID.Int64, historyDelete, oldValues, nil)
+}
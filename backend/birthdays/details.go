@@ -0,0 +1,198 @@
+package birthdays
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"hbd/auth"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/lists"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Limits of the notes, tags and contact details of a birthday
+const (
+	maxNotesLength = 1000
+	maxTags        = 20
+	maxTagLength   = 30
+)
+
+var (
+	// Tags are made of letters, digits, spaces, hyphens and underscores, starting with a letter or digit
+	tagRegex = regexp.MustCompile(`^[\p{L}\p{N}][\p{L}\p{N} _-]*$`)
+	// Telegram usernames are 5 to 32 characters long, letters, digits and underscores, starting with a letter
+	telegramUsernameRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{4,31}$`)
+	// Phone numbers are digits with an optional leading + and common separators
+	phoneRegex = regexp.MustCompile(`^\+?[0-9][0-9 ().-]{3,23}[0-9]$`)
+)
+
+// birthdayDetails holds the validated notes, tags and contact details of a birthday
+type birthdayDetails struct {
+	Notes            string
+	Tags             []string
+	TelegramUsername string
+	Phone            string
+	Email            string
+}
+
+// validateDetails checks the notes, tags and contact details of a birthday.
+// Tags are trimmed, lowercased and deduplicated, and a leading @ is removed from the telegram username.
+func validateDetails(details birthdayDetails) (birthdayDetails, error) {
+	details.Notes = strings.TrimSpace(details.Notes)
+	details.TelegramUsername = strings.TrimPrefix(strings.TrimSpace(details.TelegramUsername), "@")
+	details.Phone = strings.TrimSpace(details.Phone)
+	details.Email = strings.TrimSpace(details.Email)
+
+	validationErrors := helper.CheckArrayStringLength(
+		[]string{"Notes", "TelegramUsername", "Phone", "Email"},
+		[]string{details.Notes, details.TelegramUsername, details.Phone, details.Email},
+		[]int{maxNotesLength, 32, 25, 254},
+		[]int{1, 5, 5, 6},
+		[]int{0, 0, 0, 0},
+		[]bool{true, true, true, true},
+	)
+	if details.TelegramUsername != "" && !telegramUsernameRegex.MatchString(details.TelegramUsername) {
+		validationErrors = append(validationErrors, errors.New("Field 'TelegramUsername' is not a valid telegram username"))
+	}
+	if details.Phone != "" && !phoneRegex.MatchString(details.Phone) {
+		validationErrors = append(validationErrors, errors.New("Field 'Phone' is not a valid phone number"))
+	}
+	if details.Email != "" && !helper.IsValidEmail(details.Email) {
+		validationErrors = append(validationErrors, errors.New("Field 'Email' is not a valid email"))
+	}
+
+	// Normalize the tags
+	if len(details.Tags) > maxTags {
+		validationErrors = append(validationErrors, errors.New("Field 'Tags' has too many elements"))
+	}
+	tags := []string{}
+	seen := map[string]bool{}
+	for _, tag := range details.Tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if err := helper.CheckStringLength("Tags", tag, maxTagLength, 1, 0, false); err != nil {
+			validationErrors = append(validationErrors, err)
+			continue
+		}
+		if !tagRegex.MatchString(tag) {
+			validationErrors = append(validationErrors, errors.New("Field 'Tags' can only contain letters, digits, spaces, hyphens and underscores"))
+			continue
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	details.Tags = tags
+
+	if helper.CheckErrors(validationErrors) != nil {
+		return birthdayDetails{}, errors.New(helper.ConcatenateErrors(validationErrors))
+	}
+	return details, nil
+}
+
+// setDetails sets the notes and contact details of the birthday, the tags are stored separately with setTags
+func setDetails(b *models.Birthday, details birthdayDetails) {
+	b.Notes = null.NewString(details.Notes, details.Notes != "")
+	b.TelegramUsername = null.NewString(details.TelegramUsername, details.TelegramUsername != "")
+	b.Phone = null.NewString(details.Phone, details.Phone != "")
+	b.Email = null.NewString(details.Email, details.Email != "")
+}
+
+// setTags replaces the tags of the birthday, the new tags are attached to the model
+func setTags(ctx context.Context, exec boil.ContextExecutor, b *models.Birthday, tags []string) error {
+	_, err := models.BirthdayTags(models.BirthdayTagWhere.BirthdayID.EQ(b.ID.Int64)).DeleteAll(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	birthdayTags := models.BirthdayTagSlice{}
+	for _, tag := range tags {
+		birthdayTag := &models.BirthdayTag{BirthdayID: b.ID.Int64, Tag: tag}
+		if err := birthdayTag.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+		birthdayTags = append(birthdayTags, birthdayTag)
+	}
+
+	if b.R == nil {
+		b.R = b.R.NewStruct()
+	}
+	b.R.BirthdayTags = birthdayTags
+	return nil
+}
+
+// contactLinks returns the quick links to contact the person of a birthday, shown in reminders
+func contactLinks(b *models.Birthday) []string {
+	var links []string
+	if b.TelegramUsername.Valid {
+		links = append(links, "t.me/"+b.TelegramUsername.String)
+	}
+	if b.Phone.Valid {
+		links = append(links, b.Phone.String)
+	}
+	if b.Email.Valid {
+		links = append(links, b.Email.String)
+	}
+	return links
+}
+
+// tagsFilter returns the query mods that keep the birthdays with any of the tags, or none if no tags are given
+func tagsFilter(tags []string) []qm.QueryMod {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, len(tags))
+	for i, tag := range tags {
+		args[i] = strings.ToLower(strings.TrimSpace(tag))
+	}
+	return []qm.QueryMod{qm.WhereIn("id IN (SELECT birthday_id FROM birthday_tags WHERE tag IN ?)", args...)}
+}
+
+// @Summary List birthdays
+// @Description This endpoint lists the birthdays of the authenticated user and of their shared lists with their notes, tags and contact details, optionally filtered by tag. The request must include a valid JWT token.
+// @Produce  json
+// @Param   tag  query     []string  false  "Only list the birthdays with any of these tags"  collectionFormat(multi)
+// @Success 200 {array} structs.BirthdayFull
+// @Failure 500 {object} structs.Error "Invalid encryption key or email"
+// @Failure 500 {object} structs.Error "Failed to fetch birthdays"
+// @Security Bearer
+// @Router /birthdays [get]
+// @Router /v2/birthdays [get]
+// @Tags birthdays
+// @x-order 14
+func ListBirthdays(c *gin.Context) {
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Get the birthdays of the user with their tags
+	mods := append([]qm.QueryMod{
+		lists.ReadableBirthdays(userData.ID),
+		qm.Load(models.BirthdayRels.BirthdayTags),
+		qm.OrderBy("event_type, id"),
+	}, tagsFilter(c.QueryArray("tag"))...)
+	birthdays, err := models.Birthdays(mods...).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch birthdays", false) {
+		return
+	}
+
+	list := []structs.BirthdayFull{}
+	for _, b := range birthdays {
+		list = append(list, helper.BirthdayFull(b))
+	}
+
+	c.JSON(http.StatusOK, list)
+}
@@ -0,0 +1,361 @@
+package birthdays
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"hbd/auth"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/lists"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Minimum name similarity for two birthdays on the same date to be listed as duplicates
+const defaultDuplicateThreshold = 0.75
+
+// nameTokens splits a name into lowercase words, ignoring punctuation
+func nameTokens(name string) []string {
+	return strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// levenshtein calculates the edit distance between two strings
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// stringSimilarity scores two strings between 0 and 1 by their edit distance
+func stringSimilarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 && len(rb) == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(max(len(ra), len(rb)))
+}
+
+// tokenSimilarity scores two words between 0 and 1.
+// Short forms of a name, like Jon for Jonathan, and initials score almost as high as an exact match.
+func tokenSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	short, long := a, b
+	if len([]rune(short)) > len([]rune(long)) {
+		short, long = long, short
+	}
+	if strings.HasPrefix(long, short) && (len([]rune(short)) >= 3 || len([]rune(short)) == 1) {
+		return 0.9
+	}
+	return stringSimilarity(a, b)
+}
+
+// nameSimilarity scores two names between 0 and 1, matching each word with the most similar word of the other name
+func nameSimilarity(a, b string) float64 {
+	tokensA, tokensB := nameTokens(a), nameTokens(b)
+	if len(tokensA) == 0 || len(tokensB) == 0 {
+		return 0
+	}
+	if len(tokensA) > len(tokensB) {
+		tokensA, tokensB = tokensB, tokensA
+	}
+
+	// Match the words of the shorter name greedily, each word of the longer name is used once
+	used := make([]bool, len(tokensB))
+	var total float64
+	for _, tokenA := range tokensA {
+		best, bestIndex := 0.0, -1
+		for j, tokenB := range tokensB {
+			if score := tokenSimilarity(tokenA, tokenB); !used[j] && score > best {
+				best, bestIndex = score, j
+			}
+		}
+		if bestIndex >= 0 {
+			used[bestIndex] = true
+			total += best
+		}
+	}
+	tokenScore := total / float64(len(tokensB))
+
+	// Names written without spaces, or with the words in a different form, are compared as a whole too
+	wholeScore := stringSimilarity(strings.Join(nameTokens(a), ""), strings.Join(nameTokens(b), ""))
+	return max(tokenScore, wholeScore)
+}
+
+// @Summary List probable duplicate birthdays
+// @Description This endpoint lists pairs of birthdays of the authenticated user that are probably the same person: their dates and calendars match exactly and their names are similar. Birthdays of shared lists are compared with the other birthdays of the same list. The request must include a valid JWT token.
+// @Produce  json
+// @Param   threshold  query     number  false  "Minimum name similarity, between 0 and 1 (default 0.75)"
+// @Success 200 {array} structs.BirthdayDuplicate
+// @Failure 400 {object} structs.Error "Invalid threshold"
+// @Failure 500 {object} structs.Error "Failed to fetch birthdays"
+// @Security Bearer
+// @Router /duplicate-birthdays [get]
+// @Tags birthdays
+// @x-order 19
+func DuplicateBirthdays(c *gin.Context) {
+	// Parse the minimum similarity
+	threshold := defaultDuplicateThreshold
+	if str := c.Query("threshold"); str != "" {
+		var err error
+		threshold, err = strconv.ParseFloat(str, 64)
+		if err != nil || threshold < 0 || threshold > 1 {
+			c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid threshold, should be between 0 and 1"})
+			return
+		}
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Get the birthdays the user can change with their tags, including those of their shared lists
+	birthdays, err := models.Birthdays(
+		lists.EditableBirthdays(userData.ID),
+		qm.Load(models.BirthdayRels.BirthdayTags),
+		qm.OrderBy("id"),
+	).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch birthdays", false) {
+		return
+	}
+
+	// Only birthdays with exactly the same date in the same list can be duplicates
+	byDate := map[string]models.BirthdaySlice{}
+	var dates []string
+	for _, b := range birthdays {
+		date := strconv.FormatInt(b.ListID.Int64, 10) + " " + b.Calendar + " " + helper.FormatBirthdayDate(b.Date, b.YearKnown)
+		if len(byDate[date]) == 0 {
+			dates = append(dates, date)
+		}
+		byDate[date] = append(byDate[date], b)
+	}
+
+	duplicates := []structs.BirthdayDuplicate{}
+	for _, date := range dates {
+		candidates := byDate[date]
+		for i := 0; i < len(candidates); i++ {
+			for j := i + 1; j < len(candidates); j++ {
+				similarity := nameSimilarity(candidates[i].Name, candidates[j].Name)
+				if similarity < threshold {
+					continue
+				}
+				duplicates = append(duplicates, structs.BirthdayDuplicate{
+					Birthdays:  []structs.BirthdayFull{helper.BirthdayFull(candidates[i]), helper.BirthdayFull(candidates[j])},
+					Similarity: float64(int(similarity*100+0.5)) / 100,
+				})
+			}
+		}
+	}
+
+	// Show the most similar pairs first
+	sort.SliceStable(duplicates, func(i, j int) bool { return duplicates[i].Similarity > duplicates[j].Similarity })
+
+	c.JSON(http.StatusOK, duplicates)
+}
+
+// mergeDetails combines the notes, tags and contact details of the merged birthday into the one that's kept.
+// Notes are appended, tags are joined and contact details are only taken if the kept birthday doesn't have them.
+func mergeDetails(keep, merge birthdayDetails) (birthdayDetails, error) {
+	notes := keep.Notes
+	if merge.Notes != "" && merge.Notes != keep.Notes {
+		if notes != "" {
+			notes += "\n\n"
+		}
+		notes += merge.Notes
+	}
+	if len(notes) > maxNotesLength {
+		return birthdayDetails{}, errors.New("the combined notes are too long, shorten them before merging")
+	}
+	keep.Notes = notes
+
+	keep.Tags = append(keep.Tags, merge.Tags...)
+	if keep.TelegramUsername == "" {
+		keep.TelegramUsername = merge.TelegramUsername
+	}
+	if keep.Phone == "" {
+		keep.Phone = merge.Phone
+	}
+	if keep.Email == "" {
+		keep.Email = merge.Email
+	}
+
+	return validateDetails(keep)
+}
+
+// detailsOf returns the notes, tags and contact details of a birthday, the tags are taken from the loaded relationship
+func detailsOf(b *models.Birthday) birthdayDetails {
+	return birthdayDetails{
+		Notes:            b.Notes.String,
+		Tags:             helper.BirthdayTags(b),
+		TelegramUsername: b.TelegramUsername.String,
+		Phone:            b.Phone.String,
+		Email:            b.Email.String,
+	}
+}
+
+// @Summary Merge two birthdays
+// @Description This endpoint merges a birthday of the authenticated user into another one. The notes are appended, the tags, groups and gift ideas are joined, and missing contact details are filled in. The merged birthday is then moved to the trash, and the deletion is recorded in its history. Both birthdays must be in the same list. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   birthdays  body     structs.MergeBirthdaysRequest  true  "Birthdays to merge"
+// @Success 200 {object} structs.BirthdayFull
+// @Failure 400 {object} structs.Error "Invalid request or details"
+// @Failure 400 {object} structs.Error "The birthdays must be in the same list"
+// @Failure 500 {object} structs.Error "Birthday doesn't exist"
+// @Failure 500 {object} structs.Error "Failed to merge birthdays"
+// @Security Bearer
+// @Router /merge-birthdays [post]
+// @Tags birthdays
+// @x-order 20
+func MergeBirthdays(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.MergeBirthdaysRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+	if req.KeepID == req.MergeID {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "A birthday can't be merged into itself"})
+		return
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Get both birthdays with their tags and groups
+	var keep, merge *models.Birthday
+	for _, item := range []struct {
+		id     int64
+		target **models.Birthday
+	}{{req.KeepID, &keep}, {req.MergeID, &merge}} {
+		*item.target, err = models.Birthdays(
+			lists.EditableBirthdays(userData.ID),
+			models.BirthdayWhere.ID.EQ(null.Int64From(item.id)),
+			qm.Load(models.BirthdayRels.BirthdayTags),
+			qm.Load(models.BirthdayRels.GroupBirthdayGroups),
+		).One(c, env.DB)
+		if helper.HE(c, err, http.StatusInternalServerError, "Birthday doesn't exist", false) {
+			return
+		}
+	}
+
+	// Birthdays of different lists are seen by different users, so they can't be merged
+	if keep.ListID != merge.ListID {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "The birthdays must be in the same list"})
+		return
+	}
+
+	// Combine the notes, tags and contact details
+	oldValues := snapshot(keep)
+	details, err := mergeDetails(detailsOf(keep), detailsOf(merge))
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid details", true) {
+		return
+	}
+	setDetails(keep, details)
+
+	// Add the groups of the merged birthday that the kept birthday isn't in
+	inGroup := map[int64]bool{}
+	for _, group := range keep.R.GroupBirthdayGroups {
+		inGroup[group.ID.Int64] = true
+	}
+	var newGroups models.BirthdayGroupSlice
+	for _, group := range merge.R.GroupBirthdayGroups {
+		if !inGroup[group.ID.Int64] {
+			newGroups = append(newGroups, group)
+		}
+	}
+
+	// Start a new transaction so the birthdays are merged completely or not at all
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Update the kept birthday, move the tags, groups and gifts, move the merged birthday to the trash and record the changes within the transaction
+	if err = bumpBirthdayVersion(c, tx, keep); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.VersionHE(c, err, "Failed to merge birthdays")
+		return
+	}
+	if _, err = keep.Update(c, tx, boil.Infer()); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to merge birthdays", false)
+		return
+	}
+	if err = setTags(c, tx, keep, details.Tags); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to merge birthdays", false)
+		return
+	}
+	if err = keep.AddGroupBirthdayGroups(c, tx, false, newGroups...); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to merge birthdays", false)
+		return
+	}
+	if err = merge.SetGroupBirthdayGroups(c, tx, false); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to merge birthdays", false)
+		return
+	}
+	if _, err = models.BirthdayTags(models.BirthdayTagWhere.BirthdayID.EQ(merge.ID.Int64)).DeleteAll(c, tx); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to merge birthdays", false)
+		return
+	}
+	if _, err = models.Gifts(models.GiftWhere.BirthdayID.EQ(merge.ID.Int64)).UpdateAll(c, tx, models.M{models.GiftColumns.BirthdayID: keep.ID.Int64}); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to merge birthdays", false)
+		return
+	}
+	if err = trashBirthday(c, tx, merge, userData.ID); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.VersionHE(c, err, "Failed to merge birthdays")
+		return
+	}
+	if err = recordHistory(c, tx, userData.ID, keep.ID.Int64, historyUpdate, oldValues, snapshot(keep)); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to merge birthdays", false)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, helper.BirthdayFull(keep))
+}
@@ -0,0 +1,66 @@
+package birthdays
+
+import (
+	"errors"
+	"hbd/helper"
+	"strconv"
+	"strings"
+)
+
+// eventType holds the wording used in reminders and exported calendars for a type of recurring event
+type eventType struct {
+	// Name of a single event, used in exported calendars
+	Name string
+	// Heading of the reminder section, followed by "for today"
+	Title string
+	// Default label for the years since the event, {years} is replaced by the number of years.
+	// Types without a label don't show the years in reminders.
+	YearsLabel string
+}
+
+// Event types in the order they're shown in reminders
+var eventTypeOrder = []string{"birthday", "wedding_anniversary", "work_anniversary", "name_day", "memorial", "other"}
+
+var eventTypes = map[string]eventType{
+	"birthday":            {Name: "Birthday", Title: "🎂 Birthdays", YearsLabel: "Turns {years}"},
+	"wedding_anniversary": {Name: "Wedding anniversary", Title: "💍 Wedding anniversaries", YearsLabel: "Married {years} years"},
+	"work_anniversary":    {Name: "Work anniversary", Title: "💼 Work anniversaries", YearsLabel: "{years} years at the company"},
+	"name_day":            {Name: "Name day", Title: "📛 Name days"},
+	"memorial":            {Name: "Memorial", Title: "🕯️ Memorial dates", YearsLabel: "{years} years since"},
+	"other":               {Name: "Event", Title: "📅 Other events", YearsLabel: "{years} years"},
+}
+
+// validateEvent checks the event type and the years label, an empty event type defaults to a birthday
+func validateEvent(eventTypeName string, yearsLabel string) (string, error) {
+	if eventTypeName == "" {
+		eventTypeName = "birthday"
+	}
+	if _, ok := eventTypes[eventTypeName]; !ok {
+		return "", errors.New("invalid event type, should be one of: " + strings.Join(eventTypeOrder, ", "))
+	}
+
+	if err := helper.CheckStringLength("YearsLabel", yearsLabel, 100, 1, 0, true); err != nil {
+		return "", err
+	}
+	if yearsLabel != "" && strings.Count(yearsLabel, "{years}") != 1 {
+		return "", errors.New("Field 'YearsLabel' must contain '{years}' exactly once")
+	}
+
+	return eventTypeName, nil
+}
+
+// formatYearsLabel replaces the number of years in the label, using the event type's label if no custom label is set
+func formatYearsLabel(eventTypeName string, customLabel string, years int) string {
+	label := customLabel
+	if label == "" {
+		label = eventTypes[eventTypeName].YearsLabel
+	}
+	if label == "" {
+		return ""
+	}
+
+	if years == 1 {
+		label = strings.Replace(label, "{years} years", "{years} year", 1)
+	}
+	return strings.Replace(label, "{years}", strconv.Itoa(years), 1)
+}
@@ -0,0 +1,97 @@
+package birthdays
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"hbd/auth"
+	"hbd/calendars"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/ics"
+	"hbd/lists"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// @Summary Export birthdays as ICS
+// @Description This endpoint exports the birthdays and events of the authenticated user and of their shared lists as an ICS file. Gregorian dates are exported as yearly recurring events, dates in other calendar systems are exported as one event for each Gregorian occurrence in the next years, since their Gregorian date changes every year. The request must include a valid JWT token.
+// @Produce  text/calendar
+// @Param   years  query     int  false  "Number of years of occurrences to export for dates in non-Gregorian calendars (1-50, default 10)"
+// @Success 200 {string} string "ICS file"
+// @Failure 400 {object} structs.Error "Invalid number of years"
+// @Failure 500 {object} structs.Error "Invalid encryption key or email"
+// @Failure 500 {object} structs.Error "Failed to fetch birthdays"
+// @Security Bearer
+// @Router /export-ics [get]
+// @Tags birthdays
+// @x-order 13
+func ExportICS(c *gin.Context) {
+	// Parse the number of years of occurrences to export
+	years := 10
+	if str := c.Query("years"); str != "" {
+		var err error
+		years, err = strconv.Atoi(str)
+		if err != nil || years < 1 || years > 50 {
+			c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid number of years, should be between 1 and 50"})
+			return
+		}
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Get the birthdays of the user
+	birthdays, err := models.Birthdays(
+		lists.ReadableBirthdays(userData.ID),
+		qm.OrderBy("id"),
+	).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch birthdays", false) {
+		return
+	}
+
+	now := time.Now().UTC()
+	var events []ics.ExportEvent
+	for _, b := range birthdays {
+		summary := eventSummary(b)
+
+		if b.Calendar == calendars.Gregorian {
+			events = append(events, ics.ExportEvent{
+				UID:      fmt.Sprintf("birthday-%d@hbd", b.ID.Int64),
+				Summary:  summary,
+				Start:    b.Date,
+				Yearly:   true,
+				OmitYear: !b.YearKnown,
+			})
+			continue
+		}
+
+		// The Gregorian date of other calendar systems changes every year, so each occurrence is a separate event
+		for _, o := range upcomingOccurrences(models.BirthdaySlice{b}, now, int(now.AddDate(years, 0, 0).Sub(now).Hours()/24)) {
+			events = append(events, ics.ExportEvent{
+				UID:     fmt.Sprintf("birthday-%d-%s@hbd", b.ID.Int64, o.Date.Format("20060102")),
+				Summary: summary,
+				Start:   o.Date,
+			})
+		}
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="birthdays.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(ics.Write(events, now)))
+}
+
+// eventSummary creates the title of a birthday or event in an exported calendar
+func eventSummary(b *models.Birthday) string {
+	if b.EventType == "birthday" {
+		return b.Name + "'s birthday"
+	}
+	return b.Name + " - " + eventTypes[b.EventType].Name
+}
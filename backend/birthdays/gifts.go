@@ -0,0 +1,464 @@
+package birthdays
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"hbd/auth"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/lists"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Statuses of a gift, ideas that haven't been given yet are open and shown in the reminders
+const (
+	giftIdea   = "idea"
+	giftBought = "bought"
+	giftGiven  = "given"
+)
+
+// Limits of a gift
+const (
+	maxGiftNameLength  = 100
+	maxGiftNotesLength = 500
+	maxGiftLinkLength  = 500
+	maxGiftPrice       = 1000000
+)
+
+// giftValues holds the fields of a gift
+type giftValues struct {
+	Name      string
+	Status    string
+	Price     *float64
+	Link      string
+	YearGiven *int
+	Notes     string
+}
+
+// validateGift checks the fields of a gift, an empty status defaults to idea.
+// Gifts that are given default to the current year.
+func validateGift(values giftValues) (giftValues, error) {
+	values.Name = strings.TrimSpace(values.Name)
+	values.Link = strings.TrimSpace(values.Link)
+	values.Notes = strings.TrimSpace(values.Notes)
+	if values.Status == "" {
+		values.Status = giftIdea
+	}
+
+	validationErrors := helper.CheckArrayStringLength(
+		[]string{"Name", "Link", "Notes"},
+		[]string{values.Name, values.Link, values.Notes},
+		[]int{maxGiftNameLength, maxGiftLinkLength, maxGiftNotesLength},
+		[]int{1, 1, 1},
+		[]int{0, 0, 0},
+		[]bool{false, true, true},
+	)
+	switch values.Status {
+	case giftIdea, giftBought, giftGiven:
+	default:
+		validationErrors = append(validationErrors, errors.New("Field 'Status' should be one of: idea, bought, given"))
+	}
+	if values.Price != nil && (math.IsNaN(*values.Price) || *values.Price < 0 || *values.Price > maxGiftPrice) {
+		validationErrors = append(validationErrors, errors.New("Field 'Price' should be between 0 and "+strconv.Itoa(maxGiftPrice)))
+	}
+	if values.Link != "" {
+		if link, err := url.Parse(values.Link); err != nil || (link.Scheme != "http" && link.Scheme != "https") || link.Host == "" {
+			validationErrors = append(validationErrors, errors.New("Field 'Link' is not a valid http or https URL"))
+		}
+	}
+
+	// Only given gifts have a year, which can't be in the future
+	currentYear := time.Now().UTC().Year()
+	if values.Status != giftGiven {
+		values.YearGiven = nil
+	} else if values.YearGiven == nil {
+		values.YearGiven = &currentYear
+	} else if *values.YearGiven < 1900 || *values.YearGiven > currentYear {
+		validationErrors = append(validationErrors, errors.New("Field 'YearGiven' should be between 1900 and "+strconv.Itoa(currentYear)))
+	}
+
+	if helper.CheckErrors(validationErrors) != nil {
+		return giftValues{}, errors.New(helper.ConcatenateErrors(validationErrors))
+	}
+	return values, nil
+}
+
+// setGiftValues sets the validated fields of the gift
+func setGiftValues(g *models.Gift, values giftValues) {
+	g.Name = values.Name
+	g.Status = values.Status
+	g.Price = null.Float64FromPtr(values.Price)
+	g.Link = null.NewString(values.Link, values.Link != "")
+	g.YearGiven = null.Int64{}
+	if values.YearGiven != nil {
+		g.YearGiven = null.Int64From(int64(*values.YearGiven))
+	}
+	g.Notes = null.NewString(values.Notes, values.Notes != "")
+}
+
+// giftFull converts a Gift model into its response representation
+func giftFull(g *models.Gift) structs.GiftFull {
+	gift := structs.GiftFull{
+		ID:         g.ID.Int64,
+		BirthdayID: g.BirthdayID,
+		Name:       g.Name,
+		Status:     g.Status,
+		Price:      g.Price.Ptr(),
+		Link:       g.Link.String,
+		Notes:      g.Notes.String,
+		CreatedAt:  g.CreatedAt.Time.UTC().Format(time.RFC3339),
+	}
+	if g.YearGiven.Valid {
+		year := int(g.YearGiven.Int64)
+		gift.YearGiven = &year
+	}
+	return gift
+}
+
+// giftLocation returns the URL of a gift in the v2 API
+func giftLocation(g *models.Gift) string {
+	return birthdayLocation(g.BirthdayID) + "/gift-ideas/" + strconv.FormatInt(g.ID.Int64, 10)
+}
+
+// openGiftsLine lists the gift ideas of a birthday that haven't been given yet, from the loaded relationship
+func openGiftsLine(b *models.Birthday) string {
+	if b.R == nil || len(b.R.Gifts) == 0 {
+		return ""
+	}
+	var names []string
+	for _, g := range b.R.Gifts {
+		if g.Status == giftGiven {
+			continue
+		}
+		name := g.Name
+		if g.Status == giftBought {
+			name += " (bought)"
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	return "Gift ideas: " + helper.JoinStrings(names, ", ")
+}
+
+// findGift gets a gift of the birthday, responding with 404 if it doesn't exist
+func findGift(c *gin.Context, birthday *models.Birthday) (*models.Gift, bool) {
+	// Parse the gift ID
+	id, err := strconv.ParseInt(c.Param("gift_id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid gift ID"})
+		return nil, false
+	}
+
+	gift, err := models.Gifts(
+		models.GiftWhere.ID.EQ(null.Int64From(id)),
+		models.GiftWhere.BirthdayID.EQ(birthday.ID.Int64),
+	).One(c, env.DB)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, structs.Error{Error: "Gift not found"})
+		return nil, false
+	}
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch gift", false) {
+		return nil, false
+	}
+	return gift, true
+}
+
+// @Summary List the gift ideas of a birthday
+// @Description This endpoint lists the gift ideas of a birthday of the authenticated user, optionally filtered by status. The request must include a valid JWT token.
+// @Produce  json
+// @Param   id  path     int  true  "Birthday ID"
+// @Param   status  query     string  false  "Only list the gifts with this status (idea, bought or given)"
+// @Success 200 {array} structs.GiftFull
+// @Failure 400 {object} structs.Error "Invalid birthday ID"
+// @Failure 404 {object} structs.Error "Birthday not found"
+// @Failure 500 {object} structs.Error "Failed to fetch gifts"
+// @Security Bearer
+// @Router /v2/birthdays/{id}/gift-ideas [get]
+// @Tags gifts
+// @x-order 30
+func ListGifts(c *gin.Context) {
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	birthday, ok := findBirthday(c, userData.ID)
+	if !ok {
+		return
+	}
+
+	// Get the gifts of the birthday, oldest first
+	mods := []qm.QueryMod{
+		models.GiftWhere.BirthdayID.EQ(birthday.ID.Int64),
+		qm.OrderBy("id"),
+	}
+	if status := c.Query("status"); status != "" {
+		mods = append(mods, models.GiftWhere.Status.EQ(status))
+	}
+	gifts, err := models.Gifts(mods...).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch gifts", false) {
+		return
+	}
+
+	response := []structs.GiftFull{}
+	for _, g := range gifts {
+		response = append(response, giftFull(g))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Add a gift idea to a birthday
+// @Description This endpoint adds a gift idea to a birthday of the authenticated user and returns it along with its URL in the Location header. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   id  path     int  true  "Birthday ID"
+// @Param   gift  body     structs.GiftAdd  true  "Add gift"
+// @Success 201 {object} structs.GiftFull
+// @Header  201 {string} Location "URL of the gift"
+// @Failure 400 {object} structs.Error "Invalid request, birthday ID or gift"
+// @Failure 403 {object} structs.Error "Not allowed to change this birthday"
+// @Failure 404 {object} structs.Error "Birthday not found"
+// @Failure 500 {object} structs.Error "Failed to insert gift"
+// @Security Bearer
+// @Router /v2/birthdays/{id}/gift-ideas [post]
+// @Tags gifts
+// @x-order 31
+func AddGift(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.GiftAdd
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	birthday, ok := findBirthday(c, userData.ID)
+	if !ok || !checkEditable(c, userData.ID, birthday) {
+		return
+	}
+
+	// Validate the gift
+	values, err := validateGift(giftValues{
+		Name:      req.Name,
+		Status:    req.Status,
+		Price:     req.Price,
+		Link:      req.Link,
+		YearGiven: req.YearGiven,
+		Notes:     req.Notes,
+	})
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid gift", true) {
+		return
+	}
+
+	// Insert the gift
+	gift := &models.Gift{BirthdayID: birthday.ID.Int64}
+	setGiftValues(gift, values)
+	err = gift.Insert(c, env.DB, boil.Infer())
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to insert gift", false) {
+		return
+	}
+
+	c.Header("Location", giftLocation(gift))
+	c.JSON(http.StatusCreated, giftFull(gift))
+}
+
+// @Summary Update a gift idea
+// @Description This endpoint changes the provided fields of a gift idea of a birthday of the authenticated user and returns it. Marking a gift as given records the current year unless the year is provided. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   id  path     int  true  "Birthday ID"
+// @Param   gift_id  path     int  true  "Gift ID"
+// @Param   gift  body     structs.GiftPatch  true  "Changed fields"
+// @Success 200 {object} structs.GiftFull
+// @Failure 400 {object} structs.Error "Invalid request, birthday ID, gift ID or gift"
+// @Failure 403 {object} structs.Error "Not allowed to change this birthday"
+// @Failure 404 {object} structs.Error "Birthday not found"
+// @Failure 404 {object} structs.Error "Gift not found"
+// @Failure 500 {object} structs.Error "Failed to update gift"
+// @Security Bearer
+// @Router /v2/birthdays/{id}/gift-ideas/{gift_id} [patch]
+// @Tags gifts
+// @x-order 32
+func UpdateGift(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.GiftPatch
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	birthday, ok := findBirthday(c, userData.ID)
+	if !ok || !checkEditable(c, userData.ID, birthday) {
+		return
+	}
+	gift, ok := findGift(c, birthday)
+	if !ok {
+		return
+	}
+
+	// Keep the fields that weren't provided
+	current := giftFull(gift)
+	values := giftValues{
+		Name:      current.Name,
+		Status:    current.Status,
+		Price:     current.Price,
+		Link:      current.Link,
+		YearGiven: current.YearGiven,
+		Notes:     current.Notes,
+	}
+	if req.Name != nil {
+		values.Name = *req.Name
+	}
+	if req.Status != nil {
+		values.Status = *req.Status
+	}
+	if req.Price != nil {
+		values.Price = req.Price
+	}
+	if req.Link != nil {
+		values.Link = *req.Link
+	}
+	if req.YearGiven != nil {
+		values.YearGiven = req.YearGiven
+	}
+	if req.Notes != nil {
+		values.Notes = *req.Notes
+	}
+	values, err = validateGift(values)
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid gift", true) {
+		return
+	}
+
+	// Update the gift
+	setGiftValues(gift, values)
+	_, err = gift.Update(c, env.DB, boil.Infer())
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to update gift", false) {
+		return
+	}
+
+	c.JSON(http.StatusOK, giftFull(gift))
+}
+
+// @Summary Delete a gift idea
+// @Description This endpoint permanently deletes a gift idea of a birthday of the authenticated user. The request must include a valid JWT token.
+// @Param   id  path     int  true  "Birthday ID"
+// @Param   gift_id  path     int  true  "Gift ID"
+// @Success 204
+// @Failure 400 {object} structs.Error "Invalid birthday ID or gift ID"
+// @Failure 403 {object} structs.Error "Not allowed to change this birthday"
+// @Failure 404 {object} structs.Error "Birthday not found"
+// @Failure 404 {object} structs.Error "Gift not found"
+// @Failure 500 {object} structs.Error "Failed to delete gift"
+// @Security Bearer
+// @Router /v2/birthdays/{id}/gift-ideas/{gift_id} [delete]
+// @Tags gifts
+// @x-order 33
+func DeleteGift(c *gin.Context) {
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	birthday, ok := findBirthday(c, userData.ID)
+	if !ok || !checkEditable(c, userData.ID, birthday) {
+		return
+	}
+	gift, ok := findGift(c, birthday)
+	if !ok {
+		return
+	}
+
+	_, err = gift.Delete(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to delete gift", false) {
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary List the gifts given in the past
+// @Description This endpoint lists the gifts the authenticated user has given, most recent first, to avoid giving the same gift twice. It can be limited to one birthday. The request must include a valid JWT token.
+// @Produce  json
+// @Param   birthday_id  query     int  false  "Only list the gifts given for this birthday"
+// @Success 200 {array} structs.GiftHistoryEntry
+// @Failure 400 {object} structs.Error "Invalid birthday ID"
+// @Failure 500 {object} structs.Error "Failed to fetch gifts"
+// @Security Bearer
+// @Router /v2/gift-history [get]
+// @Tags gifts
+// @x-order 34
+func GiftHistory(c *gin.Context) {
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Get the birthdays of the user with the gifts given for them
+	mods := []qm.QueryMod{
+		lists.ReadableBirthdays(userData.ID),
+		qm.Load(models.BirthdayRels.Gifts, models.GiftWhere.Status.EQ(giftGiven)),
+	}
+	if str := c.Query("birthday_id"); str != "" {
+		id, err := strconv.ParseInt(str, 10, 64)
+		if err != nil || id <= 0 {
+			c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid birthday ID"})
+			return
+		}
+		mods = append(mods, models.BirthdayWhere.ID.EQ(null.Int64From(id)))
+	}
+	birthdays, err := models.Birthdays(mods...).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch gifts", false) {
+		return
+	}
+
+	history := []structs.GiftHistoryEntry{}
+	for _, b := range birthdays {
+		if b.R == nil {
+			continue
+		}
+		for _, g := range b.R.Gifts {
+			history = append(history, structs.GiftHistoryEntry{Gift: giftFull(g), BirthdayName: b.Name})
+		}
+	}
+
+	// Sort by the year given, most recent first, then by person
+	sort.SliceStable(history, func(i, j int) bool {
+		if *history[i].Gift.YearGiven != *history[j].Gift.YearGiven {
+			return *history[i].Gift.YearGiven > *history[j].Gift.YearGiven
+		}
+		return history[i].BirthdayName < history[j].BirthdayName
+	})
+
+	c.JSON(http.StatusOK, history)
+}
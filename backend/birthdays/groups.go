@@ -0,0 +1,491 @@
+package birthdays
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"hbd/auth"
+	"hbd/encryption"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/lists"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Limits of the reminder settings of a group
+const (
+	maxLeadDays       = 10
+	maxLeadDaysBefore = 365
+	maxTemplateLength = 500
+)
+
+// Telegram chats are either numeric IDs, negative for groups and channels, or public @usernames
+var destinationRegex = regexp.MustCompile(`^(-?[0-9]{1,20}|@[A-Za-z][A-Za-z0-9_]{4,31})$`)
+
+// groupSettings holds the validated reminder settings of a group
+type groupSettings struct {
+	LeadDays    []int
+	Destination string
+	Template    string
+}
+
+// validateGroup checks the name and reminder settings of a group.
+// Lead days are sorted and deduplicated, and default to the day of the birthday.
+func validateGroup(name string, settings groupSettings) (groupSettings, error) {
+	validationErrors := helper.CheckArrayStringLength(
+		[]string{"Name", "Destination", "Template"},
+		[]string{name, settings.Destination, settings.Template},
+		[]int{100, 33, maxTemplateLength},
+		[]int{1, 1, 1},
+		[]int{0, 0, 0},
+		[]bool{false, true, true},
+	)
+	if settings.Destination != "" && !destinationRegex.MatchString(settings.Destination) {
+		validationErrors = append(validationErrors, errors.New("Field 'Destination' is not a valid telegram chat ID or username"))
+	}
+	if settings.Template != "" && !strings.Contains(settings.Template, "{name}") {
+		validationErrors = append(validationErrors, errors.New("Field 'Template' must contain '{name}'"))
+	}
+
+	// Normalize the lead days
+	if len(settings.LeadDays) > maxLeadDays {
+		validationErrors = append(validationErrors, errors.New("Field 'LeadDays' has too many elements"))
+	}
+	leadDays := []int{}
+	seen := map[int]bool{}
+	for _, days := range settings.LeadDays {
+		if days < 0 || days > maxLeadDaysBefore {
+			validationErrors = append(validationErrors, errors.New("Field 'LeadDays' must be between 0 and "+strconv.Itoa(maxLeadDaysBefore)))
+			continue
+		}
+		if !seen[days] {
+			seen[days] = true
+			leadDays = append(leadDays, days)
+		}
+	}
+	if len(leadDays) == 0 {
+		leadDays = []int{0}
+	}
+	sort.Ints(leadDays)
+	settings.LeadDays = leadDays
+
+	if helper.CheckErrors(validationErrors) != nil {
+		return groupSettings{}, errors.New(helper.ConcatenateErrors(validationErrors))
+	}
+	return settings, nil
+}
+
+// setGroupSettings sets the reminder settings of the group, the destination is stored encrypted
+func setGroupSettings(g *models.BirthdayGroup, settings groupSettings) error {
+	leadDays := make([]string, len(settings.LeadDays))
+	for i, days := range settings.LeadDays {
+		leadDays[i] = strconv.Itoa(days)
+	}
+	g.LeadDays = strings.Join(leadDays, ",")
+
+	g.Destination = null.String{}
+	if settings.Destination != "" {
+		encryptedDestination, err := encryption.Encrypt(env.MK, settings.Destination)
+		if err != nil {
+			return err
+		}
+		g.Destination = null.StringFrom(hex.EncodeToString(encryptedDestination))
+	}
+
+	g.Template = null.NewString(settings.Template, settings.Template != "")
+	return nil
+}
+
+// getGroupSettings reads the reminder settings of the group, decrypting the destination
+func getGroupSettings(g *models.BirthdayGroup) (groupSettings, error) {
+	settings := groupSettings{Template: g.Template.String}
+	for _, days := range strings.Split(g.LeadDays, ",") {
+		leadDays, err := strconv.Atoi(days)
+		if err != nil {
+			return groupSettings{}, err
+		}
+		settings.LeadDays = append(settings.LeadDays, leadDays)
+	}
+
+	if g.Destination.Valid {
+		destination, err := encryption.Decrypt(env.MK, g.Destination.String)
+		if err != nil {
+			return groupSettings{}, err
+		}
+		settings.Destination = destination
+	}
+	return settings, nil
+}
+
+// groupMembers fetches the birthdays the user can see with the given IDs, failing if any of them doesn't exist
+func groupMembers(ctx context.Context, userID int64, ids []int64) (models.BirthdaySlice, error) {
+	if len(ids) == 0 {
+		return models.BirthdaySlice{}, nil
+	}
+
+	members, err := models.Birthdays(
+		lists.ReadableBirthdays(userID),
+		models.BirthdayWhere.ID.IN(ids),
+	).All(ctx, env.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	unique := map[int64]bool{}
+	for _, id := range ids {
+		unique[id] = true
+	}
+	if len(members) != len(unique) {
+		return nil, errors.New("some of the birthdays don't exist")
+	}
+	return members, nil
+}
+
+// groupFull converts a BirthdayGroup model into its response representation, the members are taken from the loaded relationship
+func groupFull(g *models.BirthdayGroup) (structs.GroupFull, error) {
+	settings, err := getGroupSettings(g)
+	if err != nil {
+		return structs.GroupFull{}, err
+	}
+
+	birthdayIDs := []int64{}
+	if g.R != nil {
+		for _, b := range g.R.Birthdays {
+			birthdayIDs = append(birthdayIDs, b.ID.Int64)
+		}
+	}
+	sort.Slice(birthdayIDs, func(i, j int) bool { return birthdayIDs[i] < birthdayIDs[j] })
+
+	return structs.GroupFull{
+		ID:          g.ID.Int64,
+		Name:        g.Name,
+		LeadDays:    settings.LeadDays,
+		Destination: settings.Destination,
+		Template:    settings.Template,
+		BirthdayIDs: birthdayIDs,
+	}, nil
+}
+
+// @Summary Add a group
+// @Description This endpoint adds a group of birthdays with its own reminder settings for the authenticated user. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   group  body     structs.GroupAdd  true  "Add group"
+// @Success 200 {object} structs.GroupFull
+// @Failure 400 {object} structs.Error "Invalid request, group settings or birthdays"
+// @Failure 500 {object} structs.Error "Failed to insert group"
+// @Security Bearer
+// @Router /add-group [post]
+// @Tags groups
+// @x-order 15
+func AddGroup(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.GroupAdd
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Validate the group settings
+	settings, err := validateGroup(req.Name, groupSettings{LeadDays: req.LeadDays, Destination: req.Destination, Template: req.Template})
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid group settings", true) {
+		return
+	}
+
+	// Check that the name isn't used by another group
+	exists, err := models.BirthdayGroups(
+		models.BirthdayGroupWhere.UserID.EQ(userData.ID),
+		models.BirthdayGroupWhere.Name.EQ(req.Name),
+	).Exists(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to check existing groups", false) {
+		return
+	}
+	if exists {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "A group with this name already exists"})
+		return
+	}
+
+	// Get the birthdays in the group
+	members, err := groupMembers(c, userData.ID, req.BirthdayIDs)
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid birthdays", true) {
+		return
+	}
+
+	// Create a BirthdayGroup model with the parsed data
+	group := &models.BirthdayGroup{UserID: userData.ID, Name: req.Name}
+	if err = setGroupSettings(group, settings); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to encrypt destination", false)
+		return
+	}
+
+	// Start a new transaction so the group is inserted along with its birthdays
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Insert the group and its birthdays within the transaction
+	if err = group.Insert(c, tx, boil.Infer()); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to insert group", false)
+		return
+	}
+	if err = group.SetBirthdays(c, tx, false, members...); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to insert group", false)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	response, err := groupFull(group)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to decrypt destination", false) {
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary List groups
+// @Description This endpoint lists the groups of the authenticated user with their reminder settings and birthdays. The request must include a valid JWT token.
+// @Produce  json
+// @Success 200 {array} structs.GroupFull
+// @Failure 500 {object} structs.Error "Failed to fetch groups"
+// @Security Bearer
+// @Router /groups [get]
+// @Tags groups
+// @x-order 16
+func ListGroups(c *gin.Context) {
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Get the groups of the user with their birthdays
+	groups, err := models.BirthdayGroups(
+		models.BirthdayGroupWhere.UserID.EQ(userData.ID),
+		qm.Load(models.BirthdayGroupRels.Birthdays),
+		qm.OrderBy("id"),
+	).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch groups", false) {
+		return
+	}
+
+	list := []structs.GroupFull{}
+	for _, group := range groups {
+		response, err := groupFull(group)
+		if helper.HE(c, err, http.StatusInternalServerError, "Failed to decrypt destination", false) {
+			return
+		}
+		list = append(list, response)
+	}
+
+	c.JSON(http.StatusOK, list)
+}
+
+// @Summary Modify a group
+// @Description This endpoint modifies the name, reminder settings or birthdays of a group of the authenticated user. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   group  body     structs.GroupModify  true  "Modify group"
+// @Success 200 {object} structs.GroupFull
+// @Failure 400 {object} structs.Error "Invalid request, group settings or birthdays"
+// @Failure 500 {object} structs.Error "Group doesn't exist"
+// @Failure 500 {object} structs.Error "Failed to update group"
+// @Security Bearer
+// @Router /modify-group [put]
+// @Tags groups
+// @x-order 17
+func ModifyGroup(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.GroupModify
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Get the group with its birthdays
+	group, err := models.BirthdayGroups(
+		models.BirthdayGroupWhere.UserID.EQ(userData.ID),
+		models.BirthdayGroupWhere.ID.EQ(null.Int64From(req.ID)),
+		qm.Load(models.BirthdayGroupRels.Birthdays),
+	).One(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Group doesn't exist", false) {
+		return
+	}
+
+	// Keep the reminder settings if they weren't provided
+	settings, err := getGroupSettings(group)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to decrypt destination", false) {
+		return
+	}
+	if req.LeadDays != nil {
+		settings.LeadDays = *req.LeadDays
+	}
+	if req.Destination != nil {
+		settings.Destination = *req.Destination
+	}
+	if req.Template != nil {
+		settings.Template = *req.Template
+	}
+	settings, err = validateGroup(req.Name, settings)
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid group settings", true) {
+		return
+	}
+
+	// Check that the name isn't used by another group
+	exists, err := models.BirthdayGroups(
+		models.BirthdayGroupWhere.UserID.EQ(userData.ID),
+		models.BirthdayGroupWhere.Name.EQ(req.Name),
+		models.BirthdayGroupWhere.ID.NEQ(group.ID),
+	).Exists(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to check existing groups", false) {
+		return
+	}
+	if exists {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "A group with this name already exists"})
+		return
+	}
+
+	// Get the new birthdays of the group, they're kept if they weren't provided
+	var members models.BirthdaySlice
+	if req.BirthdayIDs != nil {
+		members, err = groupMembers(c, userData.ID, *req.BirthdayIDs)
+		if helper.HE(c, err, http.StatusBadRequest, "Invalid birthdays", true) {
+			return
+		}
+	}
+
+	// Update the group
+	group.Name = req.Name
+	if err = setGroupSettings(group, settings); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to encrypt destination", false)
+		return
+	}
+
+	// Start a new transaction
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Perform the update and replace the birthdays within the transaction
+	if _, err = group.Update(c, tx, boil.Infer()); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to update group", false)
+		return
+	}
+	if req.BirthdayIDs != nil {
+		if err = group.SetBirthdays(c, tx, false, members...); err != nil {
+			tx.Rollback() // Rollback the transaction on error
+			helper.HE(c, err, http.StatusInternalServerError, "Failed to update group", false)
+			return
+		}
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	response, err := groupFull(group)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to decrypt destination", false) {
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Delete a group
+// @Description This endpoint deletes a group of the authenticated user. The birthdays in the group are kept. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   group  body     structs.GroupID  true  "Delete group"
+// @Success 200 {object} structs.Success
+// @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 500 {object} structs.Error "Group doesn't exist"
+// @Failure 500 {object} structs.Error "Failed to delete group"
+// @Security Bearer
+// @Router /delete-group [delete]
+// @Tags groups
+// @x-order 18
+func DeleteGroup(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.GroupID
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Get the group
+	group, err := models.BirthdayGroups(
+		models.BirthdayGroupWhere.UserID.EQ(userData.ID),
+		models.BirthdayGroupWhere.ID.EQ(null.Int64From(req.ID)),
+	).One(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Group doesn't exist", false) {
+		return
+	}
+
+	// Start a new transaction
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Remove the birthdays from the group, without deleting them, and delete the group within the transaction
+	if err = group.SetBirthdays(c, tx, false); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to delete group", false)
+		return
+	}
+	if _, err = group.Delete(c, tx); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to delete group", false)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.Success{Success: true})
+}
@@ -0,0 +1,310 @@
+package birthdays
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"hbd/auth"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/lists"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Actions recorded in the history of a birthday
+const (
+	historyInsert  = "insert"
+	historyUpdate  = "update"
+	historyDelete  = "delete"
+	historyRestore = "restore"
+	historyRevert  = "revert"
+)
+
+// snapshot returns the values of a birthday to store in its history, the tags are taken from the loaded relationship
+func snapshot(b *models.Birthday) *structs.BirthdayFull {
+	values := helper.BirthdayFull(b)
+	return &values
+}
+
+// recordHistory stores a change of a birthday made by the user, the old values are nil for inserts and the new values are nil for deletions
+func recordHistory(ctx context.Context, exec boil.ContextExecutor, userID int64, birthdayID int64, action string, oldValues, newValues *structs.BirthdayFull) error {
+	entry := &models.BirthdayHistory{
+		BirthdayID: birthdayID,
+		UserID:     null.NewInt64(userID, userID != 0),
+		Action:     action,
+	}
+
+	for _, values := range []struct {
+		values *structs.BirthdayFull
+		target *null.String
+	}{{oldValues, &entry.OldValues}, {newValues, &entry.NewValues}} {
+		if values.values == nil {
+			continue
+		}
+		encoded, err := json.Marshal(values.values)
+		if err != nil {
+			return err
+		}
+		*values.target = null.StringFrom(string(encoded))
+	}
+
+	return entry.Insert(ctx, exec, boil.Infer())
+}
+
+// decodeValues reads the values stored in a history entry
+func decodeValues(values null.String) (*structs.BirthdayFull, error) {
+	if !values.Valid {
+		return nil, nil
+	}
+	var decoded structs.BirthdayFull
+	if err := json.Unmarshal([]byte(values.String), &decoded); err != nil {
+		return nil, err
+	}
+	return &decoded, nil
+}
+
+// historyEntry converts a BirthdayHistory model into its response representation
+func historyEntry(entry *models.BirthdayHistory) (structs.BirthdayHistoryEntry, error) {
+	oldValues, err := decodeValues(entry.OldValues)
+	if err != nil {
+		return structs.BirthdayHistoryEntry{}, err
+	}
+	newValues, err := decodeValues(entry.NewValues)
+	if err != nil {
+		return structs.BirthdayHistoryEntry{}, err
+	}
+
+	return structs.BirthdayHistoryEntry{
+		ID:         entry.ID.Int64,
+		BirthdayID: entry.BirthdayID,
+		UserID:     entry.UserID.Int64,
+		Action:     entry.Action,
+		OldValues:  oldValues,
+		NewValues:  newValues,
+		CreatedAt:  entry.CreatedAt.Time.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// birthdayParam parses the birthday ID in the path
+func birthdayParam(c *gin.Context) (int64, error) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, errors.New("invalid birthday ID")
+	}
+	return id, nil
+}
+
+// @Summary Get the history of a birthday
+// @Description This endpoint lists the changes made to a birthday of the authenticated user, oldest first, with its values before and after each change. Birthdays in the trash keep their history. The request must include a valid JWT token.
+// @Produce  json
+// @Param   id  path     int  true  "Birthday ID"
+// @Success 200 {array} structs.BirthdayHistoryEntry
+// @Failure 400 {object} structs.Error "Invalid birthday ID"
+// @Failure 500 {object} structs.Error "Birthday doesn't exist"
+// @Failure 500 {object} structs.Error "Failed to fetch history"
+// @Security Bearer
+// @Router /birthdays/{id}/history [get]
+// @Tags birthdays
+// @x-order 23
+func BirthdayHistory(c *gin.Context) {
+	// Parse the birthday ID
+	id, err := birthdayParam(c)
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid birthday ID", true) {
+		return
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Check that the user can see the birthday, including birthdays in the trash
+	_, err = models.Birthdays(
+		qm.WithDeleted(),
+		lists.ReadableBirthdays(userData.ID),
+		models.BirthdayWhere.ID.EQ(null.Int64From(id)),
+	).One(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Birthday doesn't exist", false) {
+		return
+	}
+
+	// Get the history of the birthday
+	entries, err := models.BirthdayHistories(
+		models.BirthdayHistoryWhere.BirthdayID.EQ(id),
+		qm.OrderBy("id"),
+	).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch history", false) {
+		return
+	}
+
+	history := []structs.BirthdayHistoryEntry{}
+	for _, entry := range entries {
+		response, err := historyEntry(entry)
+		if helper.HE(c, err, http.StatusInternalServerError, "Failed to read history", false) {
+			return
+		}
+		history = append(history, response)
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+// @Summary Revert a birthday to a previous version
+// @Description This endpoint restores the values a birthday of the authenticated user had after one of its changes, or before it for deletions. A birthday in the trash is restored. The revert is recorded in the history too. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   id  path     int  true  "Birthday ID"
+// @Param   revert  body     structs.RevertBirthdayRequest  true  "Change to revert to"
+// @Success 200 {object} structs.BirthdayFull
+// @Failure 400 {object} structs.Error "Invalid request, birthday ID or values"
+// @Failure 403 {object} structs.Error "Not allowed to change this birthday"
+// @Failure 500 {object} structs.Error "Birthday doesn't exist"
+// @Failure 500 {object} structs.Error "Change doesn't exist"
+// @Failure 500 {object} structs.Error "Failed to revert birthday"
+// @Security Bearer
+// @Router /birthdays/{id}/revert [post]
+// @Tags birthdays
+// @x-order 24
+func RevertBirthday(c *gin.Context) {
+	// Parse the birthday ID
+	id, err := birthdayParam(c)
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid birthday ID", true) {
+		return
+	}
+
+	// Declare a variable to hold the request data
+	var req structs.RevertBirthdayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Get the birthday with its tags, including birthdays in the trash
+	birthday, err := models.Birthdays(
+		qm.WithDeleted(),
+		lists.ReadableBirthdays(userData.ID),
+		models.BirthdayWhere.ID.EQ(null.Int64From(id)),
+		qm.Load(models.BirthdayRels.BirthdayTags),
+	).One(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Birthday doesn't exist", false) {
+		return
+	}
+
+	// Check that the user isn't only a viewer of the list of the birthday
+	if !checkEditable(c, userData.ID, birthday) {
+		return
+	}
+
+	// Get the change to revert to
+	entry, err := models.BirthdayHistories(
+		models.BirthdayHistoryWhere.ID.EQ(null.Int64From(req.HistoryID)),
+		models.BirthdayHistoryWhere.BirthdayID.EQ(id),
+	).One(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Change doesn't exist", false) {
+		return
+	}
+
+	// Deletions are reverted to the values before them, any other change to the values after it
+	values := entry.NewValues
+	if entry.Action == historyDelete {
+		values = entry.OldValues
+	}
+	target, err := decodeValues(values)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to read history", false) {
+		return
+	}
+	if target == nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "This change can't be reverted"})
+		return
+	}
+
+	// Validate the values again, the same way as a new birthday
+	reverted, tags, err := newBirthday(birthday.UserID, structs.BirthdayNameDateAdd{
+		Name:             target.Name,
+		Date:             target.Date,
+		EventType:        target.EventType,
+		YearsLabel:       target.YearsLabel,
+		Calendar:         target.Calendar,
+		Notes:            target.Notes,
+		Tags:             target.Tags,
+		TelegramUsername: target.TelegramUsername,
+		Phone:            target.Phone,
+		Email:            target.Email,
+	})
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid values", true) {
+		return
+	}
+
+	// Birthdays in the trash have no current values
+	var oldValues *structs.BirthdayFull
+	if !birthday.DeletedAt.Valid {
+		oldValues = snapshot(birthday)
+	}
+
+	// Update the birthday
+	birthday.Name = reverted.Name
+	birthday.Date = reverted.Date
+	birthday.YearKnown = reverted.YearKnown
+	birthday.EventType = reverted.EventType
+	birthday.YearsLabel = reverted.YearsLabel
+	birthday.Calendar = reverted.Calendar
+	birthday.Notes = reverted.Notes
+	birthday.TelegramUsername = reverted.TelegramUsername
+	birthday.Phone = reverted.Phone
+	birthday.Email = reverted.Email
+	birthday.DeletedAt = null.Time{}
+
+	// Start a new transaction
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Perform the update, replace the tags and record the change within the transaction
+	if err = bumpBirthdayVersion(c, tx, birthday); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.VersionHE(c, err, "Failed to revert birthday")
+		return
+	}
+	if _, err = birthday.Update(c, tx, boil.Infer()); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to revert birthday", false)
+		return
+	}
+	if err = setTags(c, tx, birthday, tags); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to revert birthday", false)
+		return
+	}
+	if err = recordHistory(c, tx, userData.ID, birthday.ID.Int64, historyRevert, oldValues, snapshot(birthday)); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to revert birthday", false)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, helper.BirthdayFull(birthday))
+}
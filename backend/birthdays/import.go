@@ -0,0 +1,260 @@
+package birthdays
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hbd/auth"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/ics"
+	"hbd/structs"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Maximum number of events of an imported ICS file
+const maxImportEvents = 1000
+
+// Patterns used to extract the name from the event summary when the request doesn't provide any, the first one that
+// matches is used so the more specific ones go first
+var defaultNamePatterns = []string{
+	"{name}'s birthday",
+	"{name}’s birthday",
+	"Birthday of {name}",
+	"Birthday: {name}",
+	"{name} - birthday",
+	"{name} birthday",
+}
+
+// @Summary Preview an ICS import
+// @Description This endpoint parses an ICS file and returns the birthdays that would be imported without inserting them. Only yearly recurring events are recognized, and names are extracted from the event summary using the provided patterns (e.g. "{name}'s birthday"). Events of birthdays the user already has with the same name, date and event type are skipped, so the same file can be imported again. Files can have up to 1000 events. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   import  body     structs.ICSImportRequest  true  "ICS file content and name patterns"
+// @Success 200 {object} structs.ICSImportPreview
+// @Failure 400 {object} structs.Error "Invalid request, ICS file or patterns"
+// @Failure 500 {object} structs.Error "Failed to check existing birthdays"
+// @Security Bearer
+// @Router /preview-ics-import [post]
+// @Tags birthdays
+// @x-order 10
+func PreviewICSImport(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.ICSImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Parse the file and extract the birthdays
+	preview, err := parseICSImport(c, env.DB, userData.ID, req)
+	if errors.Is(err, errCheckExisting) {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to check existing birthdays", false)
+		return
+	}
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid ICS file or patterns", true) {
+		return
+	}
+
+	c.JSON(http.StatusOK, preview)
+}
+
+// @Summary Import birthdays from an ICS file
+// @Description This endpoint parses an ICS file and inserts the birthdays of its yearly recurring events for the authenticated user. The birthdays inserted are the same ones returned by /preview-ics-import for the same request. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   import  body     structs.ICSImportRequest  true  "ICS file content and name patterns"
+// @Success 200 {object} structs.ICSImportResult
+// @Failure 400 {object} structs.Error "Invalid request, ICS file or patterns"
+// @Failure 500 {object} structs.Error "Failed to check existing birthdays or insert birthdays"
+// @Security Bearer
+// @Router /import-ics [post]
+// @Tags birthdays
+// @x-order 11
+func ImportICS(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.ICSImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Start a new transaction so either all birthdays are imported or none
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Parse the file and extract the birthdays that don't exist yet
+	preview, err := parseICSImport(c, tx, userData.ID, req)
+	if errors.Is(err, errCheckExisting) {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to check existing birthdays", false)
+		return
+	}
+	if err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusBadRequest, "Invalid ICS file or patterns", true)
+		return
+	}
+
+	imported := []structs.BirthdayFull{}
+	for _, entry := range preview.Birthdays {
+		b, tags, err := newBirthday(userData.ID, entry)
+		if err != nil {
+			tx.Rollback() // Rollback the transaction on error
+			helper.HE(c, err, http.StatusBadRequest, "Invalid date format", true)
+			return
+		}
+
+		// Perform the insert within the transaction
+		if err = insertBirthday(c, tx, b, tags, userData.ID); err != nil {
+			tx.Rollback() // Rollback the transaction on error
+			helper.HE(c, err, http.StatusInternalServerError, "Failed to insert birthdays", false)
+			return
+		}
+
+		imported = append(imported, helper.BirthdayFull(b))
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.ICSImportResult{
+		Birthdays: imported,
+		Skipped:   preview.Skipped,
+	})
+}
+
+// errCheckExisting is returned by parseICSImport when the existing birthdays of the user couldn't be checked
+var errCheckExisting = errors.New("failed to check existing birthdays")
+
+// parseICSImport extracts the birthdays from the ICS file in the request for the user. Events that aren't yearly
+// recurring, whose summary doesn't match any of the patterns, whose name is invalid or that the user already has,
+// either in their birthdays or earlier in the same file, are returned as skipped.
+func parseICSImport(ctx context.Context, exec boil.ContextExecutor, userID int64, req structs.ICSImportRequest) (*structs.ICSImportPreview, error) {
+	// Check the length of the file and the patterns
+	lengthErrors := []error{helper.CheckStringLength("ICS", req.ICS, 1000000, 1, 0, false)}
+	if len(req.Patterns) > 10 {
+		lengthErrors = append(lengthErrors, errors.New("Field 'Patterns' has too many elements"))
+	}
+	for _, pattern := range req.Patterns {
+		lengthErrors = append(lengthErrors, helper.CheckStringLength("Patterns", pattern, 100, 6, 0, false))
+	}
+	if helper.CheckErrors(lengthErrors) != nil {
+		return nil, errors.New(helper.ConcatenateErrors(lengthErrors))
+	}
+
+	// Use the default patterns if none were provided
+	patterns := req.Patterns
+	if len(patterns) == 0 {
+		patterns = defaultNamePatterns
+	}
+	regexes, err := compileNamePatterns(patterns)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := ics.Parse(req.ICS)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) > maxImportEvents {
+		return nil, errors.New("ICS file has more than " + strconv.Itoa(maxImportEvents) + " events")
+	}
+
+	preview := structs.ICSImportPreview{
+		Birthdays: []structs.BirthdayNameDateAdd{},
+		Skipped:   []structs.ICSSkippedEvent{},
+	}
+	seen := map[string]bool{}
+	for _, event := range events {
+		if event.Start.IsZero() {
+			preview.Skipped = append(preview.Skipped, structs.ICSSkippedEvent{Summary: event.Summary, Reason: "missing or invalid start date"})
+			continue
+		}
+		if !event.IsYearly() {
+			preview.Skipped = append(preview.Skipped, structs.ICSSkippedEvent{Summary: event.Summary, Reason: "not a yearly recurring event"})
+			continue
+		}
+
+		name := extractName(regexes, event.Summary)
+		if name == "" {
+			preview.Skipped = append(preview.Skipped, structs.ICSSkippedEvent{Summary: event.Summary, Reason: "summary doesn't match any pattern"})
+			continue
+		}
+
+		entry := structs.BirthdayNameDateAdd{
+			Name: name,
+			Date: helper.FormatBirthdayDate(event.Start, !event.OmitYear),
+		}
+
+		// Validate the birthday the same way as when it's added
+		b, _, err := newBirthday(userID, entry)
+		if err != nil {
+			preview.Skipped = append(preview.Skipped, structs.ICSSkippedEvent{Summary: event.Summary, Reason: err.Error()})
+			continue
+		}
+
+		// Skip the birthdays that already exist, so importing a file again doesn't create duplicates
+		key := b.Name + "\x00" + entry.Date + "\x00" + b.EventType
+		exists, err := birthdayConflicts(ctx, exec, b)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errCheckExisting, err)
+		}
+		if exists || seen[key] {
+			preview.Skipped = append(preview.Skipped, structs.ICSSkippedEvent{Summary: event.Summary, Reason: "birthday already exists"})
+			continue
+		}
+		seen[key] = true
+
+		preview.Birthdays = append(preview.Birthdays, entry)
+	}
+
+	return &preview, nil
+}
+
+// compileNamePatterns turns patterns such as "{name}'s birthday" into case insensitive regular expressions
+func compileNamePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	var regexes []*regexp.Regexp
+	for _, pattern := range patterns {
+		if strings.Count(pattern, "{name}") != 1 {
+			return nil, errors.New("Field 'Patterns' must contain '{name}' exactly once")
+		}
+		expr := strings.Replace(regexp.QuoteMeta(pattern), regexp.QuoteMeta("{name}"), "(.+?)", 1)
+		regexes = append(regexes, regexp.MustCompile("(?i)^"+expr+"$"))
+	}
+	return regexes, nil
+}
+
+// extractName returns the name captured by the first pattern matching the summary, or an empty string
+func extractName(regexes []*regexp.Regexp, summary string) string {
+	for _, re := range regexes {
+		if match := re.FindStringSubmatch(summary); match != nil {
+			return strings.TrimSpace(match[1])
+		}
+	}
+	return ""
+}
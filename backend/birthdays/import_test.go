@@ -0,0 +1,126 @@
+package birthdays
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"hbd/env"
+	"hbd/structs"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestExtractName(t *testing.T) {
+	regexes, err := compileNamePatterns(defaultNamePatterns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		summary string
+		name    string
+	}{
+		{"John Doe's birthday", "John Doe"},
+		{"John Doe’s Birthday", "John Doe"},
+		{"BIRTHDAY OF Anna Müller", "Anna Müller"},
+		{"Birthday:  Carl ", "Carl"},
+		{"Jane birthday", "Jane"},
+		{"Jane - birthday", "Jane"},
+		{"Smith, Jane's birthday", "Smith, Jane"},
+		{"Birthday party", ""},
+		{"Team meeting", ""},
+	}
+	for _, tt := range tests {
+		if name := extractName(regexes, tt.summary); name != tt.name {
+			t.Errorf("extractName(%q) = %q, want %q", tt.summary, name, tt.name)
+		}
+	}
+
+	// Custom patterns are matched literally apart from {name}
+	regexes, err = compileNamePatterns([]string{"Cumpleaños de {name} (*)"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := extractName(regexes, "cumpleaños de Lucía (*)"); name != "Lucía" {
+		t.Errorf("extractName() with a custom pattern = %q, want Lucía", name)
+	}
+	if name := extractName(regexes, "Cumpleaños de Lucía (x)"); name != "" {
+		t.Errorf("extractName() matched the special characters of a pattern as a regular expression: %q", name)
+	}
+
+	for _, pattern := range [][]string{{"birthday"}, {"{name} and {name}"}} {
+		if _, err = compileNamePatterns(pattern); err == nil {
+			t.Errorf("compileNamePatterns(%q) accepted a pattern without exactly one {name}", pattern)
+		}
+	}
+}
+
+// icsOf builds a calendar with a yearly event for each summary and date
+func icsOf(events ...string) string {
+	var b strings.Builder
+	b.WriteString("BEGIN:VCALENDAR\n")
+	for i := 0; i+1 < len(events); i += 2 {
+		fmt.Fprintf(&b, "BEGIN:VEVENT\nSUMMARY:%s\nDTSTART;VALUE=DATE:%s\nRRULE:FREQ=YEARLY\nEND:VEVENT\n", events[i], events[i+1])
+	}
+	b.WriteString("END:VCALENDAR\n")
+	return b.String()
+}
+
+func TestParseICSImport(t *testing.T) {
+	ctx := context.Background()
+	userID, otherID := newTestUser(t, "import@example.com"), newTestUser(t, "import-other@example.com")
+
+	// The user already has John's birthday
+	existing, _, err := newBirthday(userID, structs.BirthdayNameDateAdd{Name: "John", Date: "1990-03-02"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = existing.Insert(ctx, env.DB, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	preview, err := parseICSImport(ctx, env.DB, userID, structs.ICSImportRequest{ICS: icsOf(
+		"John's birthday", "19900302",
+		"John's birthday", "19910302",
+		"Anna's birthday", "19851231",
+		"Anna's birthday", "19851231",
+		strings.Repeat("x", maxNameLength+1)+"'s birthday", "20000101",
+		"Carl's birthday", "00000704",
+	)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []structs.BirthdayNameDateAdd{
+		{Name: "John", Date: "1991-03-02"},
+		{Name: "Anna", Date: "1985-12-31"},
+		{Name: "Carl", Date: "--07-04"},
+	}
+	if fmt.Sprint(preview.Birthdays) != fmt.Sprint(want) {
+		t.Errorf("parseICSImport() birthdays = %v, want %v", preview.Birthdays, want)
+	}
+	reasons := []string{}
+	for _, skipped := range preview.Skipped {
+		reasons = append(reasons, skipped.Reason)
+	}
+	wantReasons := []string{"birthday already exists", "birthday already exists", "Field 'Name' is too long"}
+	if fmt.Sprint(reasons) != fmt.Sprint(wantReasons) {
+		t.Errorf("parseICSImport() skipped = %v, want %v", reasons, wantReasons)
+	}
+
+	// Another user doesn't have John's birthday
+	preview, err = parseICSImport(ctx, env.DB, otherID, structs.ICSImportRequest{ICS: icsOf("John's birthday", "19900302")})
+	if err != nil || len(preview.Birthdays) != 1 {
+		t.Errorf("parseICSImport() for another user = %v, %v, want John's birthday", preview, err)
+	}
+
+	// Files with too many events are rejected
+	events := []string{}
+	for i := 0; i <= maxImportEvents; i++ {
+		events = append(events, fmt.Sprintf("Person %d's birthday", i), "19900101")
+	}
+	if _, err = parseICSImport(ctx, env.DB, userID, structs.ICSImportRequest{ICS: icsOf(events...)}); err == nil {
+		t.Errorf("parseICSImport() accepted a file with more than %d events", maxImportEvents)
+	}
+}
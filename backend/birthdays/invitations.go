@@ -0,0 +1,449 @@
+package birthdays
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"hbd/auth"
+	"hbd/encryption"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/invitations"
+	"hbd/lists"
+	"hbd/models"
+	"hbd/organizations"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Default and maximum time before an invitation expires
+const (
+	defaultInvitationExpiry = 7 * 24 * time.Hour
+	maxInvitationExpiry     = 30 * 24 * time.Hour
+)
+
+// invitationLocation returns the public URL of an invitation
+func invitationLocation(token string) string {
+	return "/api/invitations/" + token
+}
+
+// invitationFull converts an Invitation model into its response representation, with the name of its list or organization
+func invitationFull(inv *models.Invitation, name string, now time.Time) structs.InvitationFull {
+	return structs.InvitationFull{
+		ID:             inv.ID.Int64,
+		ListID:         inv.ListID.Int64,
+		OrganizationID: inv.OrganizationID.Int64,
+		Name:           name,
+		Role:           inv.Role,
+		ByEmail:        inv.EmailHash.Valid,
+		ExpiresAt:      inv.ExpiresAt.UTC().Format(time.RFC3339),
+		Expired:        !inv.ExpiresAt.After(now),
+		Accepted:       inv.AcceptedAt.Valid,
+		CreatedAt:      inv.CreatedAt.Time.UTC().Format(time.RFC3339),
+	}
+}
+
+// invitationName returns the name of the list or organization of an invitation
+func invitationName(c *gin.Context, inv *models.Invitation) (string, error) {
+	if inv.ListID.Valid {
+		list, err := models.FindList(c, env.DB, inv.ListID)
+		if err != nil {
+			return "", err
+		}
+		return list.Name, nil
+	}
+	org, err := models.FindOrganization(c, env.DB, inv.OrganizationID)
+	if err != nil {
+		return "", err
+	}
+	return org.Name, nil
+}
+
+// manageInvitations checks that the user manages the list or organization of the invitations, as an owner of the list or an
+// admin of the organization, responding like findList and findOrganization otherwise. It returns the name of the list or organization.
+func manageInvitations(c *gin.Context, userID, listID, orgID int64) (string, bool) {
+	if listID != 0 {
+		list, _, ok := findList(c, userID, listID, true)
+		if !ok {
+			return "", false
+		}
+		return list.Name, true
+	}
+	org, _, ok := findOrganization(c, userID, orgID, true)
+	if !ok {
+		return "", false
+	}
+	return org.Name, true
+}
+
+// @Summary Invite someone to a list or organization
+// @Description This endpoint creates a one-time invitation to join a shared list or an organization with a role. Invitations sent to an email can only be accepted by the user with that email, who also sees them in their received invitations, otherwise anyone with the link can accept them once. The token is only returned once, along with the public URL of the invitation, and the invitee can register through it even when open registration is disabled. Only the owners of the list or the admins of the organization can invite. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   invitation  body     structs.InvitationAdd  true  "Add invitation"
+// @Success 200 {object} structs.InvitationFull
+// @Failure 400 {object} structs.Error "Invalid request, role, email or expiry time"
+// @Failure 403 {object} structs.Error "Only the owners of the list can manage it"
+// @Failure 403 {object} structs.Error "Only the admins of the organization can manage it"
+// @Failure 404 {object} structs.Error "List or organization not found"
+// @Failure 500 {object} structs.Error "Failed to insert invitation"
+// @Security Bearer
+// @Router /add-invitation [post]
+// @Tags invitations
+// @x-order 60
+func AddInvitation(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.InvitationAdd
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+	if (req.ListID == 0) == (req.OrganizationID == 0) {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Exactly one of the list ID and organization ID is required"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Validate the role, which defaults to the lowest one
+	if req.ListID != 0 {
+		if req.Role == "" {
+			req.Role = lists.Viewer
+		}
+		err = validateListRole(req.Role)
+	} else {
+		if req.Role == "" {
+			req.Role = organizations.Member
+		}
+		err = validateOrganizationRole(req.Role)
+	}
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid role", true) {
+		return
+	}
+
+	// Validate the email
+	var emailHash null.String
+	if req.Email != "" {
+		if !helper.IsValidEmail(req.Email) {
+			c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid email"})
+			return
+		}
+		emailHash = null.StringFrom(encryption.HashStringWithSHA256(req.Email))
+	}
+
+	// Parse the expiry time, which must be in the future and within the maximum
+	now := time.Now().UTC()
+	expiresAt := now.Add(defaultInvitationExpiry)
+	if req.ExpiresAt != "" {
+		t, err := time.Parse(time.RFC3339, req.ExpiresAt)
+		if err != nil || !t.After(now) || t.After(now.Add(maxInvitationExpiry)) {
+			c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid expiry time, it must be a future RFC 3339 time within 30 days"})
+			return
+		}
+		expiresAt = t.UTC()
+	}
+
+	name, ok := manageInvitations(c, user.ID.Int64, req.ListID, req.OrganizationID)
+	if !ok {
+		return
+	}
+
+	// Generate the token, only its hash is stored
+	token, err := encryption.GenerateToken()
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to generate token", false) {
+		return
+	}
+
+	invitation := &models.Invitation{
+		ListID:         null.NewInt64(req.ListID, req.ListID != 0),
+		OrganizationID: null.NewInt64(req.OrganizationID, req.OrganizationID != 0),
+		UserID:         user.ID.Int64,
+		Role:           req.Role,
+		EmailHash:      emailHash,
+		TokenHash:      encryption.HashStringWithSHA256(token),
+		ExpiresAt:      expiresAt,
+	}
+	if err = invitation.Insert(c, env.DB, boil.Infer()); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to insert invitation", false)
+		return
+	}
+
+	response := invitationFull(invitation, name, now)
+	response.Token = token
+	response.URL = invitationLocation(token)
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary List the invitations of a list or organization
+// @Description This endpoint lists the invitations of a shared list or an organization that weren't accepted yet, without their tokens. Only the owners of the list or the admins of the organization can see them. The request must include a valid JWT token.
+// @Produce  json
+// @Param   list_id  query     int  false  "List ID"
+// @Param   organization_id  query     int  false  "Organization ID"
+// @Success 200 {array} structs.InvitationFull
+// @Failure 400 {object} structs.Error "Invalid list or organization ID"
+// @Failure 403 {object} structs.Error "Only the owners of the list can manage it"
+// @Failure 403 {object} structs.Error "Only the admins of the organization can manage it"
+// @Failure 404 {object} structs.Error "List or organization not found"
+// @Failure 500 {object} structs.Error "Failed to fetch invitations"
+// @Security Bearer
+// @Router /invitations [get]
+// @Tags invitations
+// @x-order 61
+func ListInvitations(c *gin.Context) {
+	// Parse the list or organization ID, exactly one of them is required
+	listID, listErr := strconv.ParseInt(c.DefaultQuery("list_id", "0"), 10, 64)
+	orgID, orgErr := strconv.ParseInt(c.DefaultQuery("organization_id", "0"), 10, 64)
+	if listErr != nil || orgErr != nil || listID < 0 || orgID < 0 || (listID == 0) == (orgID == 0) {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid list or organization ID"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	name, ok := manageInvitations(c, user.ID.Int64, listID, orgID)
+	if !ok {
+		return
+	}
+
+	// Get the pending invitations, newest first
+	target := models.InvitationWhere.ListID.EQ(null.Int64From(listID))
+	if orgID != 0 {
+		target = models.InvitationWhere.OrganizationID.EQ(null.Int64From(orgID))
+	}
+	pending, err := models.Invitations(
+		target,
+		models.InvitationWhere.AcceptedAt.IsNull(),
+		qm.OrderBy("id DESC"),
+	).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch invitations", false) {
+		return
+	}
+
+	now := time.Now().UTC()
+	response := []structs.InvitationFull{}
+	for _, invitation := range pending {
+		response = append(response, invitationFull(invitation, name, now))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary List the invitations received by email
+// @Description This endpoint lists the pending invitations sent to the email of the authenticated user, which can be accepted by their ID. The request must include a valid JWT token.
+// @Produce  json
+// @Success 200 {array} structs.InvitationFull
+// @Failure 401 {object} structs.Error "Invalid email"
+// @Failure 500 {object} structs.Error "Failed to fetch invitations"
+// @Security Bearer
+// @Router /received-invitations [get]
+// @Tags invitations
+// @x-order 62
+func ReceivedInvitations(c *gin.Context) {
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Get the invitations sent to the email of the user that can still be accepted, closest to expiring first
+	now := time.Now().UTC()
+	pending, err := models.Invitations(
+		models.InvitationWhere.EmailHash.EQ(null.StringFrom(user.EmailHash)),
+		models.InvitationWhere.AcceptedAt.IsNull(),
+		models.InvitationWhere.ExpiresAt.GT(now),
+		qm.OrderBy("expires_at, id"),
+	).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch invitations", false) {
+		return
+	}
+
+	response := []structs.InvitationFull{}
+	for _, invitation := range pending {
+		name, err := invitationName(c, invitation)
+		if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch invitations", false) {
+			return
+		}
+		response = append(response, invitationFull(invitation, name, now))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Revoke an invitation
+// @Description This endpoint revokes an invitation to a shared list or an organization, it can no longer be accepted. Only the owners of the list or the admins of the organization can revoke it. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   invitation  body     structs.InvitationID  true  "Revoke invitation"
+// @Success 200 {object} structs.Success
+// @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 403 {object} structs.Error "Only the owners of the list can manage it"
+// @Failure 403 {object} structs.Error "Only the admins of the organization can manage it"
+// @Failure 404 {object} structs.Error "Invitation not found"
+// @Failure 500 {object} structs.Error "Failed to revoke invitation"
+// @Security Bearer
+// @Router /revoke-invitation [delete]
+// @Tags invitations
+// @x-order 63
+func RevokeInvitation(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.InvitationID
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Get the invitation, and check that the user manages its list or organization
+	invitation, err := models.FindInvitation(c, env.DB, null.Int64From(req.ID))
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, structs.Error{Error: "Invitation not found"})
+		return
+	}
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch invitation", false) {
+		return
+	}
+	if _, ok := manageInvitations(c, user.ID.Int64, invitation.ListID.Int64, invitation.OrganizationID.Int64); !ok {
+		return
+	}
+
+	if _, err = invitation.Delete(c, env.DB); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to revoke invitation", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.Success{Success: true})
+}
+
+// @Summary Accept an invitation
+// @Description This endpoint accepts an invitation to a shared list or an organization with its token, or with its ID if it was sent to the email of the authenticated user, who joins with the role of the invitation. Users that are already members keep their role. Each invitation can only be accepted once. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   invitation  body     structs.InvitationAccept  true  "Accept invitation"
+// @Success 200 {object} structs.InvitationFull
+// @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 403 {object} structs.Error "This invitation was sent to another email"
+// @Failure 404 {object} structs.Error "Invitation not found, it may have expired or been used already"
+// @Failure 500 {object} structs.Error "Failed to accept invitation"
+// @Security Bearer
+// @Router /accept-invitation [post]
+// @Tags invitations
+// @x-order 64
+func AcceptInvitation(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.InvitationAccept
+	if err := c.ShouldBindJSON(&req); err != nil || (req.Token == "") == (req.ID == 0) {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Find the invitation by its token, or by its ID among the ones sent to the email of the user
+	now := time.Now().UTC()
+	var invitation *models.Invitation
+	if req.Token != "" {
+		invitation, err = invitations.Find(c, env.DB, req.Token, now)
+		if err == nil {
+			err = invitations.CheckEmail(invitation, user.EmailHash)
+		}
+	} else {
+		invitation, err = models.Invitations(
+			models.InvitationWhere.ID.EQ(null.Int64From(req.ID)),
+			models.InvitationWhere.EmailHash.EQ(null.StringFrom(user.EmailHash)),
+			models.InvitationWhere.AcceptedAt.IsNull(),
+			models.InvitationWhere.ExpiresAt.GT(now),
+		).One(c, env.DB)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = invitations.ErrNotFound
+		}
+	}
+	if invitations.HE(c, err, "Failed to fetch invitation") {
+		return
+	}
+
+	name, err := invitationName(c, invitation)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch invitation", false) {
+		return
+	}
+
+	// Start a new transaction
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Accept the invitation within the transaction
+	if err = invitations.Accept(c, tx, invitation, user.ID.Int64, now); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		invitations.HE(c, err, "Failed to accept invitation")
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, invitationFull(invitation, name, now))
+}
+
+// @Summary View an invitation
+// @Description This endpoint returns what an invitation is for through its token, without an account, so the invitee can decide to register or log in to accept it. Each token has its own rate limit.
+// @Produce  json
+// @Param   token  path     string  true  "Invitation token"
+// @Success 200 {object} structs.InvitationPreview
+// @Failure 404 {object} structs.Error "Invitation not found, it may have expired or been used already"
+// @Failure 429 {object} structs.Error "Too many requests"
+// @Failure 500 {object} structs.Error "Failed to fetch invitation"
+// @Router /invitations/{token} [get]
+// @Tags invitations
+// @x-order 65
+func ViewInvitation(c *gin.Context) {
+	invitation, err := invitations.Find(c, env.DB, c.Param("token"), time.Now().UTC())
+	if invitations.HE(c, err, "Failed to fetch invitation") {
+		return
+	}
+
+	name, err := invitationName(c, invitation)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch invitation", false) {
+		return
+	}
+
+	kind := "list"
+	if invitation.OrganizationID.Valid {
+		kind = "organization"
+	}
+	c.JSON(http.StatusOK, structs.InvitationPreview{
+		Kind:      kind,
+		Name:      name,
+		Role:      invitation.Role,
+		ByEmail:   invitation.EmailHash.Valid,
+		ExpiresAt: invitation.ExpiresAt.UTC().Format(time.RFC3339),
+	})
+}
@@ -0,0 +1,629 @@
+package birthdays
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"hbd/auth"
+	"hbd/encryption"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/lists"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// checkEditable checks that the user can change the birthday, responding with 403 if they're only a viewer of its list
+func checkEditable(c *gin.Context, userID int64, b *models.Birthday) bool {
+	roles, err := lists.Roles(c, env.DB, userID)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch lists", false) {
+		return false
+	}
+	if !lists.CanEdit(lists.BirthdayRole(b, userID, roles)) {
+		c.JSON(http.StatusForbidden, structs.Error{Error: "Not allowed to change this birthday"})
+		return false
+	}
+	return true
+}
+
+// checkListEditable checks that the user can add birthdays to the list, responding with 403 otherwise.
+// A list ID of 0 stands for the user's own birthdays.
+func checkListEditable(c *gin.Context, userID, listID int64) bool {
+	if listID == 0 {
+		return true
+	}
+	roles, err := lists.Roles(c, env.DB, userID)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch lists", false) {
+		return false
+	}
+	if !lists.CanEdit(roles[listID]) {
+		c.JSON(http.StatusForbidden, structs.Error{Error: "Not allowed to add birthdays to this list"})
+		return false
+	}
+	return true
+}
+
+// validateListRole checks the role of a member of a list
+func validateListRole(role string) error {
+	switch role {
+	case lists.Owner, lists.Editor, lists.Viewer:
+		return nil
+	}
+	return errors.New("Field 'Role' must be owner, editor or viewer")
+}
+
+// findList gets a list of the user with its members, responding with 404 if the user isn't a member of it and
+// with 403 if they aren't its owner when ownership is required
+func findList(c *gin.Context, userID, listID int64, owner bool) (*models.List, string, bool) {
+	list, err := models.Lists(
+		models.ListWhere.ID.EQ(null.Int64From(listID)),
+		qm.Load(models.ListRels.ListMembers, qm.OrderBy("created_at, user_id")),
+	).One(c, env.DB)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch list", false)
+		return nil, "", false
+	}
+
+	role := ""
+	if list != nil {
+		for _, member := range list.R.ListMembers {
+			if member.UserID == userID {
+				role = member.Role
+			}
+		}
+	}
+	if role == "" {
+		c.JSON(http.StatusNotFound, structs.Error{Error: "List not found"})
+		return nil, "", false
+	}
+	if owner && role != lists.Owner {
+		c.JSON(http.StatusForbidden, structs.Error{Error: "Only the owners of the list can manage it"})
+		return nil, "", false
+	}
+	return list, role, true
+}
+
+// listFull converts a List model into its response representation for the user, the members are taken from the loaded relationship
+func listFull(l *models.List, userID int64, role string) structs.ListFull {
+	members := []structs.ListMemberFull{}
+	if l.R != nil {
+		for _, member := range l.R.ListMembers {
+			members = append(members, structs.ListMemberFull{UserID: member.UserID, Role: member.Role, You: member.UserID == userID})
+		}
+	}
+	return structs.ListFull{ID: l.ID.Int64, Name: l.Name, Role: role, Members: members}
+}
+
+// userByEmail finds the user with the given email, which is only stored hashed
+func userByEmail(c *gin.Context, email string) (*models.User, error) {
+	return models.Users(models.UserWhere.EmailHash.EQ(encryption.HashStringWithSHA256(email))).One(c, env.DB)
+}
+
+// @Summary Add a shared list
+// @Description This endpoint adds a list of birthdays that can be shared with other users, the authenticated user becomes its owner. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   list  body     structs.ListAdd  true  "Add list"
+// @Success 200 {object} structs.ListFull
+// @Failure 400 {object} structs.Error "Invalid request or list name"
+// @Failure 500 {object} structs.Error "Failed to insert list"
+// @Security Bearer
+// @Router /add-list [post]
+// @Tags lists
+// @x-order 37
+func AddList(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.ListAdd
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Validate the name
+	if helper.CheckErrors(helper.CheckArrayStringLength([]string{"Name"}, []string{req.Name}, []int{100}, []int{1}, []int{0}, []bool{false})) != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid list name"})
+		return
+	}
+
+	// Start a new transaction so the list is inserted along with its owner
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Insert the list and make the user its owner within the transaction
+	list := &models.List{Name: req.Name}
+	if err = list.Insert(c, tx, boil.Infer()); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to insert list", false)
+		return
+	}
+	member := &models.ListMember{ListID: list.ID.Int64, UserID: user.ID.Int64, Role: lists.Owner}
+	if err = list.AddListMembers(c, tx, true, member); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to insert list", false)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, listFull(list, user.ID.Int64, lists.Owner))
+}
+
+// @Summary List shared lists
+// @Description This endpoint lists the shared lists the authenticated user is a member of, with their role and the members of each list. The request must include a valid JWT token.
+// @Produce  json
+// @Success 200 {array} structs.ListFull
+// @Failure 401 {object} structs.Error "Invalid email"
+// @Failure 500 {object} structs.Error "Failed to fetch lists"
+// @Security Bearer
+// @Router /lists [get]
+// @Tags lists
+// @x-order 38
+func ListLists(c *gin.Context) {
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Get the lists of the user with their members
+	roles, err := lists.Roles(c, env.DB, user.ID.Int64)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch lists", false) {
+		return
+	}
+	ids := make([]int64, 0, len(roles))
+	for id := range roles {
+		ids = append(ids, id)
+	}
+	userLists, err := models.Lists(
+		models.ListWhere.ID.IN(ids),
+		qm.Load(models.ListRels.ListMembers, qm.OrderBy("created_at, user_id")),
+		qm.OrderBy("id"),
+	).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch lists", false) {
+		return
+	}
+
+	response := []structs.ListFull{}
+	for _, list := range userLists {
+		response = append(response, listFull(list, user.ID.Int64, roles[list.ID.Int64]))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Rename a shared list
+// @Description This endpoint changes the name of a shared list, only the owners of the list can change it. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   list  body     structs.ListModify  true  "Modify list"
+// @Success 200 {object} structs.ListFull
+// @Failure 400 {object} structs.Error "Invalid request or list name"
+// @Failure 403 {object} structs.Error "Only the owners of the list can manage it"
+// @Failure 404 {object} structs.Error "List not found"
+// @Failure 500 {object} structs.Error "Failed to update list"
+// @Security Bearer
+// @Router /modify-list [put]
+// @Tags lists
+// @x-order 39
+func ModifyList(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.ListModify
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Validate the name
+	if helper.CheckErrors(helper.CheckArrayStringLength([]string{"Name"}, []string{req.Name}, []int{100}, []int{1}, []int{0}, []bool{false})) != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid list name"})
+		return
+	}
+
+	list, role, ok := findList(c, user.ID.Int64, req.ID, true)
+	if !ok {
+		return
+	}
+
+	// Update the list
+	list.Name = req.Name
+	if _, err = list.Update(c, env.DB, boil.Whitelist(models.ListColumns.Name)); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to update list", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, listFull(list, user.ID.Int64, role))
+}
+
+// @Summary Delete a shared list
+// @Description This endpoint deletes a shared list, only the owners of the list can delete it. The birthdays of the list go back to the users that added them, and its share links and invitations stop working. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   list  body     structs.ListID  true  "Delete list"
+// @Success 200 {object} structs.Success
+// @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 403 {object} structs.Error "Only the owners of the list can manage it"
+// @Failure 404 {object} structs.Error "List not found"
+// @Failure 500 {object} structs.Error "Failed to delete list"
+// @Security Bearer
+// @Router /delete-list [delete]
+// @Tags lists
+// @x-order 40
+func DeleteList(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.ListID
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	list, _, ok := findList(c, user.ID.Int64, req.ID, true)
+	if !ok {
+		return
+	}
+
+	// Get the birthdays of the list with their tags, including the ones in the trash
+	birthdays, err := models.Birthdays(
+		qm.WithDeleted(),
+		models.BirthdayWhere.ListID.EQ(list.ID),
+		qm.Load(models.BirthdayRels.BirthdayTags),
+	).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch birthdays", false) {
+		return
+	}
+	ids := make([]string, len(birthdays))
+	for i, b := range birthdays {
+		ids[i] = strconv.FormatInt(b.ID.Int64, 10)
+	}
+
+	// Start a new transaction
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Give the birthdays back to the users that added them, recording it in their history, and delete the shares, invitations, members and the list within the transaction
+	if _, err = models.Birthdays(
+		qm.WithDeleted(),
+		models.BirthdayWhere.ListID.EQ(list.ID),
+	).UpdateAll(c, tx, models.M{models.BirthdayColumns.ListID: nil}); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to delete list", false)
+		return
+	}
+	for _, b := range birthdays {
+		oldValues := snapshot(b)
+		b.ListID = null.Int64{}
+		if err = recordHistory(c, tx, user.ID.Int64, b.ID.Int64, historyUpdate, oldValues, snapshot(b)); err != nil {
+			tx.Rollback() // Rollback the transaction on error
+			helper.HE(c, err, http.StatusInternalServerError, "Failed to delete list", false)
+			return
+		}
+	}
+	if len(ids) > 0 {
+		if err = lists.PruneGroups(c, tx, fmt.Sprintf("id IN (%s)", strings.Join(ids, ", "))); err != nil {
+			tx.Rollback() // Rollback the transaction on error
+			helper.HE(c, err, http.StatusInternalServerError, "Failed to delete list", false)
+			return
+		}
+	}
+	if _, err = models.ListShares(models.ListShareWhere.ListID.EQ(list.ID.Int64)).DeleteAll(c, tx); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to delete list", false)
+		return
+	}
+	if _, err = models.Invitations(models.InvitationWhere.ListID.EQ(list.ID)).DeleteAll(c, tx); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to delete list", false)
+		return
+	}
+	if _, err = list.R.ListMembers.DeleteAll(c, tx); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to delete list", false)
+		return
+	}
+	if _, err = list.Delete(c, tx); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to delete list", false)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.Success{Success: true})
+}
+
+// @Summary Change the role of a member of a shared list
+// @Description This endpoint changes the role of a member of a shared list by the email of their account. Editors can change the birthdays of the list, viewers can only see them and owners also manage the list. Only the owners of the list can manage its members, and a list must keep at least one owner. Users only become members by accepting an invitation, since each member gets reminders of the birthdays of the list through their own chat and schedule. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   member  body     structs.ListMemberModify  true  "Member"
+// @Success 200 {object} structs.ListFull
+// @Failure 400 {object} structs.Error "Invalid request, role or member"
+// @Failure 400 {object} structs.Error "The list must keep an owner"
+// @Failure 403 {object} structs.Error "Only the owners of the list can manage it"
+// @Failure 404 {object} structs.Error "List not found"
+// @Failure 500 {object} structs.Error "Failed to update member"
+// @Security Bearer
+// @Router /list-member [put]
+// @Tags lists
+// @x-order 41
+func SetListMember(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.ListMemberModify
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Validate the role
+	err = validateListRole(req.Role)
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid role", true) {
+		return
+	}
+
+	list, role, ok := findList(c, user.ID.Int64, req.ListID, true)
+	if !ok {
+		return
+	}
+
+	// Find the member, the same error is returned whether or not the email has an account so it can't be used to find
+	// out who is registered
+	member, err := userByEmail(c, req.Email)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch user", false)
+		return
+	}
+
+	// Find the membership of the user, and count the owners of the list
+	var existing *models.ListMember
+	owners := 0
+	for _, m := range list.R.ListMembers {
+		if member != nil && m.UserID == member.ID.Int64 {
+			existing = m
+		}
+		if m.Role == lists.Owner {
+			owners++
+		}
+	}
+	if existing == nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "No member with this email, new members join by accepting an invitation"})
+		return
+	}
+
+	// Change the role of the member
+	if existing.Role == lists.Owner && req.Role != lists.Owner && owners == 1 {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "The list must keep an owner"})
+		return
+	}
+	existing.Role = req.Role
+	_, err = existing.Update(c, env.DB, boil.Whitelist(models.ListMemberColumns.Role))
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to update member", false) {
+		return
+	}
+
+	if member.ID.Int64 == user.ID.Int64 {
+		role = req.Role
+	}
+	c.JSON(http.StatusOK, listFull(list, user.ID.Int64, role))
+}
+
+// @Summary Remove a member from a shared list
+// @Description This endpoint removes a user from a shared list by the email of their account, only the owners of the list can remove other members. Members leave the list by omitting the email. If the last owner leaves, the earliest remaining member becomes the owner, and the last member to leave keeps the birthdays of the list, which is deleted. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   member  body     structs.ListMemberRemove  true  "Member"
+// @Success 200 {object} structs.Success
+// @Failure 400 {object} structs.Error "Invalid request or user"
+// @Failure 403 {object} structs.Error "Only the owners of the list can manage it"
+// @Failure 404 {object} structs.Error "List not found"
+// @Failure 500 {object} structs.Error "Failed to remove member"
+// @Security Bearer
+// @Router /list-member [delete]
+// @Tags lists
+// @x-order 42
+func RemoveListMember(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.ListMemberRemove
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Members can always leave, removing someone else needs ownership
+	list, _, ok := findList(c, user.ID.Int64, req.ListID, req.Email != "")
+	if !ok {
+		return
+	}
+
+	// Find the member to remove
+	memberID := user.ID.Int64
+	if req.Email != "" {
+		member, err := userByEmail(c, req.Email)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch user", false)
+			return
+		}
+		isMember := false
+		if member != nil {
+			for _, m := range list.R.ListMembers {
+				isMember = isMember || m.UserID == member.ID.Int64
+			}
+		}
+		if !isMember {
+			c.JSON(http.StatusBadRequest, structs.Error{Error: "No member with this email"})
+			return
+		}
+		memberID = member.ID.Int64
+	}
+
+	// Start a new transaction
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Remove the member within the transaction
+	if err = lists.RemoveMember(c, tx, list.ID.Int64, memberID); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to remove member", false)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.Success{Success: true})
+}
+
+// @Summary Move a birthday between lists
+// @Description This endpoint moves a birthday into a shared list, or out of it to the authenticated user's own birthdays with a list ID of 0. The user must be able to change the birthday and to add birthdays to the list. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   birthday  body     structs.BirthdayMove  true  "Move birthday"
+// @Param   If-Match  header  string  false  "Only apply the change if the birthday still has this ETag"
+// @Success 200 {object} structs.BirthdayFull
+// @Header  200 {string} ETag "Version of the birthday"
+// @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 403 {object} structs.Error "Not allowed to change this birthday"
+// @Failure 403 {object} structs.Error "Not allowed to add birthdays to this list"
+// @Failure 412 {object} structs.Error "Birthday was changed by another request"
+// @Failure 500 {object} structs.Error "Birthday doesn't exist"
+// @Failure 500 {object} structs.Error "Failed to move birthday"
+// @Security Bearer
+// @Router /move-birthday [put]
+// @Tags lists
+// @x-order 43
+func MoveBirthday(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.BirthdayMove
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Get the birthday with its tags
+	birthday, err := models.Birthdays(
+		lists.ReadableBirthdays(userData.ID),
+		models.BirthdayWhere.ID.EQ(null.Int64From(req.ID)),
+		qm.Load(models.BirthdayRels.BirthdayTags),
+	).One(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Birthday doesn't exist", false) {
+		return
+	}
+
+	// Check that the user can take the birthday out of its list and put it in the new one
+	if !checkEditable(c, userData.ID, birthday) || !checkListEditable(c, userData.ID, req.ListID) {
+		return
+	}
+
+	// Check that the birthday wasn't changed since the client read it
+	if helper.PreconditionFailed(c, helper.ETag(birthday.Version)) {
+		return
+	}
+
+	// Start a new transaction
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Increment the version and move the birthday within the transaction, the user takes it when it leaves a list
+	oldValues := snapshot(birthday)
+	if err = bumpBirthdayVersion(c, tx, birthday); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.VersionHE(c, err, "Failed to move birthday")
+		return
+	}
+	birthday.ListID = null.NewInt64(req.ListID, req.ListID != 0)
+	if req.ListID == 0 {
+		birthday.UserID = userData.ID
+	}
+	if _, err = birthday.Update(c, tx, boil.Whitelist(models.BirthdayColumns.ListID, models.BirthdayColumns.UserID)); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to move birthday", false)
+		return
+	}
+	if err = recordHistory(c, tx, userData.ID, birthday.ID.Int64, historyUpdate, oldValues, snapshot(birthday)); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to move birthday", false)
+		return
+	}
+
+	// Remove the birthday from the groups of the users that can no longer see it
+	if err = lists.PruneGroups(c, tx, "id = $1", birthday.ID.Int64); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to move birthday", false)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.Header("ETag", helper.ETag(birthday.Version))
+	c.JSON(http.StatusOK, helper.BirthdayFull(birthday))
+}
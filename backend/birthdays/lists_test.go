@@ -0,0 +1,99 @@
+package birthdays
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"hbd/env"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// serve calls the handler with the JSON body as the user with the email and returns the status code
+func serve(t *testing.T, handler gin.HandlerFunc, email string, body any) int {
+	t.Helper()
+	payload, _ := json.Marshal(body)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(payload))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Set("Email", email)
+	handler(c)
+	return w.Code
+}
+
+func TestListHistory(t *testing.T) {
+	ctx := context.Background()
+	email := "list-history@example.com"
+	userID := newTestUser(t, email)
+
+	// A list owned by the user with a birthday in it
+	newList := func() *models.List {
+		list := &models.List{Name: "Family"}
+		if err := list.Insert(ctx, env.DB, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+		member := &models.ListMember{ListID: list.ID.Int64, UserID: userID, Role: "owner"}
+		if err := member.Insert(ctx, env.DB, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+		return list
+	}
+	newListBirthday := func(list *models.List) *models.Birthday {
+		b, _, err := newBirthday(userID, structs.BirthdayNameDateAdd{Name: "Ann", Date: "1990-03-02", ListID: list.ID.Int64})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = b.Insert(ctx, env.DB, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	// lastChange returns the list IDs before and after the last change of the birthday
+	lastChange := func(b *models.Birthday) (int64, int64) {
+		t.Helper()
+		entry, err := models.BirthdayHistories(
+			models.BirthdayHistoryWhere.BirthdayID.EQ(b.ID.Int64),
+			qm.OrderBy(models.BirthdayHistoryColumns.ID+" DESC"),
+		).One(ctx, env.DB)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if entry.Action != historyUpdate || entry.UserID.Int64 != userID {
+			t.Fatalf("last change is a %q by %d, want an update by %d", entry.Action, entry.UserID.Int64, userID)
+		}
+		oldValues, _ := decodeValues(entry.OldValues)
+		newValues, _ := decodeValues(entry.NewValues)
+		return oldValues.ListID, newValues.ListID
+	}
+
+	t.Run("moving a birthday out of a list is recorded", func(t *testing.T) {
+		list := newList()
+		b := newListBirthday(list)
+		if code := serve(t, MoveBirthday, email, structs.BirthdayMove{ID: b.ID.Int64}); code != http.StatusOK {
+			t.Fatalf("MoveBirthday() = %d, want %d", code, http.StatusOK)
+		}
+		if oldList, newList := lastChange(b); oldList != list.ID.Int64 || newList != 0 {
+			t.Errorf("recorded a move from list %d to %d, want from %d to 0", oldList, newList, list.ID.Int64)
+		}
+	})
+
+	t.Run("deleting a list records the birthdays leaving it", func(t *testing.T) {
+		list := newList()
+		b := newListBirthday(list)
+		if code := serve(t, DeleteList, email, structs.ListID{ID: list.ID.Int64}); code != http.StatusOK {
+			t.Fatalf("DeleteList() = %d, want %d", code, http.StatusOK)
+		}
+		if oldList, newList := lastChange(b); oldList != list.ID.Int64 || newList != 0 {
+			t.Errorf("recorded a move from list %d to %d, want from %d to 0", oldList, newList, list.ID.Int64)
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package birthdays
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"testing"
+
+	"hbd/db/dbtest"
+	"hbd/encryption"
+	"hbd/env"
+	"hbd/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	boil.SetDB(env.DB)
+	os.Exit(dbtest.Run(env.DB, m.Run))
+}
+
+// newTestUser inserts a user with the email and returns its ID, the birthdays of the tests need one
+func newTestUser(t *testing.T, email string) int64 {
+	t.Helper()
+	botAPIKey, _ := encryption.Encrypt(env.MK, "123:abc")
+	telegramUserID, _ := encryption.Encrypt(env.MK, "42")
+	user := &models.User{
+		EmailHash:             encryption.HashStringWithSHA256(email),
+		ReminderTime:          "10:00",
+		Timezone:              "UTC",
+		TelegramBotAPIKey:     hex.EncodeToString(botAPIKey),
+		TelegramBotAPIKeyHash: encryption.HashStringWithSHA256("123:abc"),
+		TelegramUserID:        hex.EncodeToString(telegramUserID),
+		TelegramUserIDHash:    encryption.HashStringWithSHA256("42"),
+	}
+	if err := user.Insert(context.Background(), env.DB, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	return user.ID.Int64
+}
@@ -0,0 +1,168 @@
+package birthdays
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"hbd/auth"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Limits of the milestone settings
+const (
+	maxMilestones        = 20
+	maxMilestoneAge      = 150
+	maxMilestoneLeadDays = 365
+)
+
+// milestoneSettings holds the milestone ages of a user and the days before them on which an extra reminder is sent
+type milestoneSettings struct {
+	Ages     []int
+	LeadDays int
+}
+
+// userMilestones returns the milestone settings of the user
+func userMilestones(u *models.User) milestoneSettings {
+	return milestoneSettings{Ages: helper.ParseMilestones(u.Milestones), LeadDays: int(u.MilestoneLeadDays)}
+}
+
+// isMilestone checks if the person turns one of the milestone ages on the occurrence, only birthdays with a known year have milestones
+func (m milestoneSettings) isMilestone(o occurrence) bool {
+	if o.Birthday.EventType != "birthday" || !o.Birthday.YearKnown {
+		return false
+	}
+	for _, age := range m.Ages {
+		if o.Years == age {
+			return true
+		}
+	}
+	return false
+}
+
+// lookahead returns the number of days to look ahead for a listing of the given days, extended so that
+// milestone birthdays show up the lead days in advance
+func (m milestoneSettings) lookahead(days int) int {
+	if len(m.Ages) > 0 && m.LeadDays+1 > days {
+		return m.LeadDays + 1
+	}
+	return days
+}
+
+// validateMilestones checks the milestone ages, which are sorted and deduplicated, and the lead days
+func validateMilestones(settings milestoneSettings) (milestoneSettings, error) {
+	var validationErrors []error
+	if len(settings.Ages) > maxMilestones {
+		validationErrors = append(validationErrors, errors.New("Field 'Milestones' has too many elements"))
+	}
+	ages := []int{}
+	seen := map[int]bool{}
+	for _, age := range settings.Ages {
+		if age < 1 || age > maxMilestoneAge {
+			validationErrors = append(validationErrors, errors.New("Field 'Milestones' must be between 1 and "+strconv.Itoa(maxMilestoneAge)))
+			continue
+		}
+		if !seen[age] {
+			seen[age] = true
+			ages = append(ages, age)
+		}
+	}
+	sort.Ints(ages)
+	settings.Ages = ages
+	if settings.LeadDays < 0 || settings.LeadDays > maxMilestoneLeadDays {
+		validationErrors = append(validationErrors, errors.New("Field 'LeadDays' must be between 0 and "+strconv.Itoa(maxMilestoneLeadDays)))
+	}
+
+	if helper.CheckErrors(validationErrors) != nil {
+		return milestoneSettings{}, errors.New(helper.ConcatenateErrors(validationErrors))
+	}
+	return settings, nil
+}
+
+// @Summary Modify the milestone settings
+// @Description This endpoint sets the ages whose birthdays are highlighted as milestones for the authenticated user, by default 18, 21 and every decade from 30, and the days before them on which an extra reminder is sent, 30 by default. The upcoming birthdays listing includes milestones within the lead days too. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   milestones  body     structs.MilestonesModify  true  "Milestone settings"
+// @Param   If-Match  header  string  false  "Only apply the change if the user settings still have this ETag"
+// @Success 200 {object} structs.MilestoneSettings
+// @Header  200 {string} ETag "Version of the user settings"
+// @Failure 400 {object} structs.Error "Invalid request or milestones"
+// @Failure 401 {object} structs.Error "Invalid email"
+// @Failure 412 {object} structs.Error "User settings were changed by another request"
+// @Failure 500 {object} structs.Error "Failed to update milestones"
+// @Security Bearer
+// @Router /modify-milestones [put]
+// @Tags birthdays
+// @x-order 36
+func ModifyMilestones(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.MilestonesModify
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Check that the user settings weren't changed since the client read them
+	if helper.PreconditionFailed(c, helper.ETag(user.Version)) {
+		return
+	}
+
+	// Keep the settings that weren't provided
+	settings := userMilestones(user)
+	if req.Milestones != nil {
+		settings.Ages = *req.Milestones
+	}
+	if req.LeadDays != nil {
+		settings.LeadDays = *req.LeadDays
+	}
+	settings, err = validateMilestones(settings)
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid milestones", true) {
+		return
+	}
+
+	// Start a new transaction
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Increment the version and update the settings within the transaction
+	if err = helper.BumpVersion(c, tx, models.TableNames.Users, user.ID.Int64, user.Version); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.VersionHE(c, err, "Failed to update milestones")
+		return
+	}
+	user.Version++
+	user.Milestones = null.StringFrom(helper.FormatMilestones(settings.Ages))
+	user.MilestoneLeadDays = int64(settings.LeadDays)
+	if _, err = user.Update(c, tx, boil.Whitelist(models.UserColumns.Milestones, models.UserColumns.MilestoneLeadDays)); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to update milestones", false)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.Header("ETag", helper.ETag(user.Version))
+	c.JSON(http.StatusOK, structs.MilestoneSettings{Milestones: settings.Ages, LeadDays: settings.LeadDays})
+}
@@ -0,0 +1,178 @@
+package birthdays
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"hbd/auth"
+	"hbd/calendars"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/lists"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// occurrence is the Gregorian date on which a birthday or event is celebrated in a given year
+type occurrence struct {
+	Birthday *models.Birthday
+	Date     time.Time
+	// Years since the original event, counted in its calendar system, only meaningful if the year is known
+	Years int
+}
+
+// validateCalendar checks the calendar system, an empty calendar defaults to the Gregorian calendar.
+// Other calendar systems need the year to convert the date.
+func validateCalendar(calendar string, yearKnown bool) (string, error) {
+	if calendar == "" {
+		calendar = calendars.Gregorian
+	}
+	if !calendars.Valid(calendar) {
+		return "", errors.New("invalid calendar, should be one of: " + strings.Join(calendars.Names, ", "))
+	}
+	if calendar != calendars.Gregorian && !yearKnown {
+		return "", errors.New("dates in the " + calendar + " calendar need a known year")
+	}
+	return calendar, nil
+}
+
+// nextOccurrence returns the first occurrence of the birthday on or after the given day
+func nextOccurrence(b *models.Birthday, day time.Time) (occurrence, error) {
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+
+	// Dates without year are always Gregorian, only the month and day are used
+	date := calendars.Date{Month: int(b.Date.Month()), Day: b.Date.Day()}
+	if b.YearKnown {
+		var err error
+		date, err = calendars.FromGregorian(b.Calendar, b.Date)
+		if err != nil {
+			return occurrence{}, err
+		}
+	}
+
+	current, err := calendars.FromGregorian(b.Calendar, day)
+	if err != nil {
+		return occurrence{}, err
+	}
+
+	// The anniversary in the previous calendar year may still be ahead for calendars whose years don't start in January
+	for year := current.Year - 1; year <= current.Year+1; year++ {
+		anniversary, err := calendars.Anniversary(b.Calendar, date, year)
+		if err != nil {
+			return occurrence{}, err
+		}
+		if !anniversary.Before(day) {
+			return occurrence{Birthday: b, Date: anniversary, Years: year - date.Year}, nil
+		}
+	}
+
+	return occurrence{}, errors.New("no occurrence found")
+}
+
+// upcomingOccurrences returns the occurrences of the birthdays in the given number of days starting from the given day,
+// sorted by date and name
+func upcomingOccurrences(birthdays models.BirthdaySlice, day time.Time, days int) []occurrence {
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	end := day.AddDate(0, 0, days)
+
+	var occurrences []occurrence
+	for _, b := range birthdays {
+		// Events can happen more than once in the period if it's longer than a year
+		for from := day; from.Before(end); {
+			o, err := nextOccurrence(b, from)
+			if err != nil || !o.Date.Before(end) {
+				break
+			}
+			occurrences = append(occurrences, o)
+			from = o.Date.AddDate(0, 0, 1)
+		}
+	}
+
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		if !occurrences[i].Date.Equal(occurrences[j].Date) {
+			return occurrences[i].Date.Before(occurrences[j].Date)
+		}
+		return occurrences[i].Birthday.Name < occurrences[j].Birthday.Name
+	})
+	return occurrences
+}
+
+// @Summary List upcoming birthdays
+// @Description This endpoint lists the birthdays and events of the authenticated user and of their shared lists in the next days, with the date on which they're celebrated this year in the Gregorian calendar. Milestone birthdays are flagged and listed up to the milestone lead days in advance. The request must include a valid JWT token.
+// @Produce  json
+// @Param   days  query     int  false  "Number of days to look ahead, including today (1-366, default 30)"
+// @Param   tag  query     []string  false  "Only list the birthdays with any of these tags"  collectionFormat(multi)
+// @Success 200 {array} structs.UpcomingBirthday
+// @Failure 400 {object} structs.Error "Invalid number of days"
+// @Failure 500 {object} structs.Error "Invalid encryption key or email"
+// @Failure 500 {object} structs.Error "Failed to fetch birthdays"
+// @Security Bearer
+// @Router /upcoming-birthdays [get]
+// @Tags birthdays
+// @x-order 12
+func UpcomingBirthdays(c *gin.Context) {
+	// Parse the number of days to look ahead
+	days := 30
+	if str := c.Query("days"); str != "" {
+		var err error
+		days, err = strconv.Atoi(str)
+		if err != nil || days < 1 || days > 366 {
+			c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid number of days, should be between 1 and 366"})
+			return
+		}
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Get the birthdays of the user with their tags
+	mods := append([]qm.QueryMod{
+		lists.ReadableBirthdays(userData.ID),
+		qm.Load(models.BirthdayRels.BirthdayTags),
+	}, tagsFilter(c.QueryArray("tag"))...)
+	birthdays, err := models.Birthdays(mods...).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch birthdays", false) {
+		return
+	}
+
+	// Milestone birthdays are listed the milestone lead days in advance, even beyond the requested days
+	milestones := milestoneSettings{Ages: userData.Milestones, LeadDays: userData.MilestoneLeadDays}
+
+	today := time.Now().UTC()
+	upcoming := []structs.UpcomingBirthday{}
+	for _, o := range upcomingOccurrences(birthdays, today, milestones.lookahead(days)) {
+		daysUntil := int(o.Date.Sub(time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)).Hours() / 24)
+		milestone := milestones.isMilestone(o)
+		if daysUntil >= days && !milestone {
+			continue
+		}
+
+		item := structs.UpcomingBirthday{
+			ID:        o.Birthday.ID.Int64,
+			Name:      o.Birthday.Name,
+			EventType: o.Birthday.EventType,
+			Calendar:  o.Birthday.Calendar,
+			Date:      o.Date.Format(helper.DateLayout),
+			DaysUntil: daysUntil,
+			YearKnown: o.Birthday.YearKnown,
+			Tags:      helper.BirthdayTags(o.Birthday),
+			Milestone: milestone,
+		}
+		if o.Birthday.YearKnown {
+			item.Years = o.Years
+		}
+		upcoming = append(upcoming, item)
+	}
+
+	c.JSON(http.StatusOK, upcoming)
+}
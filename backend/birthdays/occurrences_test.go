@@ -0,0 +1,54 @@
+package birthdays
+
+import (
+	"testing"
+	"time"
+
+	"hbd/calendars"
+	"hbd/helper"
+	"hbd/models"
+)
+
+func TestNextOccurrenceAge(t *testing.T) {
+	tests := []struct {
+		birthday string
+		day      string
+		date     string
+		years    int
+	}{
+		{"1990-03-02", "2026-01-10", "2026-03-02", 36},
+		{"1990-03-02", "2026-03-02", "2026-03-02", 36},
+		{"1990-03-02", "2026-03-03", "2027-03-02", 37},
+		// Leap day birthdays are celebrated on February 28 in common years and on February 29 in leap years
+		{"2000-02-29", "2026-02-01", "2026-02-28", 26},
+		{"2000-02-29", "2026-03-01", "2027-02-28", 27},
+		{"2000-02-29", "2027-03-01", "2028-02-29", 28},
+		{"2000-02-29", "2028-02-29", "2028-02-29", 28},
+		// Without a year only the date is meaningful
+		{"--02-29", "2026-02-01", "2026-02-28", 0},
+		{"0000-02-29", "2028-01-01", "2028-02-29", 0},
+		{"--12-25", "2026-10-19", "2026-12-25", 0},
+		// A date of the current year, which clients use for an unknown year, turns 0
+		{"2026-12-25", "2026-10-19", "2026-12-25", 0},
+	}
+	for _, tt := range tests {
+		date, yearKnown, err := helper.ParseBirthdayDate(tt.birthday)
+		if err != nil {
+			t.Fatal(err)
+		}
+		day, _ := time.Parse(helper.DateLayout, tt.day)
+		b := &models.Birthday{Name: "John", Date: date, YearKnown: yearKnown, Calendar: calendars.Gregorian}
+
+		o, err := nextOccurrence(b, day)
+		if err != nil {
+			t.Fatalf("nextOccurrence(%s, %s): %v", tt.birthday, tt.day, err)
+		}
+		years := 0
+		if yearKnown {
+			years = o.Years
+		}
+		if o.Date.Format(helper.DateLayout) != tt.date || years != tt.years {
+			t.Errorf("nextOccurrence(%s, %s) = %s turning %d, want %s turning %d", tt.birthday, tt.day, o.Date.Format(helper.DateLayout), years, tt.date, tt.years)
+		}
+	}
+}
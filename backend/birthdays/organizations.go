@@ -0,0 +1,940 @@
+package birthdays
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"hbd/auth"
+	"hbd/calendars"
+	"hbd/encryption"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/models"
+	"hbd/organizations"
+	"hbd/structs"
+	"hbd/telegram"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Maximum days before the birthdays on which an organization channel announces them
+const maxChannelLeadDays = 30
+
+// validateOrganizationRole checks the role of a member of an organization
+func validateOrganizationRole(role string) error {
+	switch role {
+	case organizations.Admin, organizations.Member:
+		return nil
+	}
+	return errors.New("Field 'Role' must be admin or member")
+}
+
+// findOrganization gets an organization of the user with its members, responding with 404 if the user isn't a member of it
+// and with 403 if they aren't one of its admins when that is required
+func findOrganization(c *gin.Context, userID, orgID int64, admin bool) (*models.Organization, string, bool) {
+	org, err := models.Organizations(
+		models.OrganizationWhere.ID.EQ(null.Int64From(orgID)),
+		qm.Load(models.OrganizationRels.OrganizationMembers, qm.OrderBy("created_at, user_id")),
+	).One(c, env.DB)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch organization", false)
+		return nil, "", false
+	}
+
+	role := ""
+	if org != nil {
+		for _, member := range org.R.OrganizationMembers {
+			if member.UserID == userID {
+				role = member.Role
+			}
+		}
+	}
+	if role == "" {
+		c.JSON(http.StatusNotFound, structs.Error{Error: "Organization not found"})
+		return nil, "", false
+	}
+	if admin && role != organizations.Admin {
+		c.JSON(http.StatusForbidden, structs.Error{Error: "Only the admins of the organization can manage it"})
+		return nil, "", false
+	}
+	return org, role, true
+}
+
+// organizationFull converts an Organization model into its response representation for the user, the members are taken
+// from the loaded relationship
+func organizationFull(o *models.Organization, userID int64, role string) structs.OrganizationFull {
+	members := []structs.OrganizationMemberFull{}
+	if o.R != nil {
+		for _, member := range o.R.OrganizationMembers {
+			members = append(members, structs.OrganizationMemberFull{
+				UserID:      member.UserID,
+				Role:        member.Role,
+				InDirectory: member.DirectoryDate.Valid,
+				You:         member.UserID == userID,
+			})
+		}
+	}
+	return structs.OrganizationFull{ID: o.ID.Int64, Name: o.Name, Role: role, Members: members}
+}
+
+// directoryBirthday converts the directory entry of a member into a birthday, so its occurrences are computed like any other
+func directoryBirthday(m *models.OrganizationMember) *models.Birthday {
+	return &models.Birthday{
+		UserID:    m.UserID,
+		Name:      m.DirectoryName.String,
+		Date:      m.DirectoryDate.Time,
+		YearKnown: m.DirectoryYearKnown,
+		EventType: "birthday",
+		Calendar:  calendars.Gregorian,
+	}
+}
+
+// directoryEntries lists the members that opted into the directory, sorted by the next date of their birthday
+func directoryEntries(members models.OrganizationMemberSlice, userID int64, now time.Time) []structs.DirectoryEntry {
+	var occurrences []occurrence
+	for _, member := range members {
+		if !member.DirectoryDate.Valid {
+			continue
+		}
+		o, err := nextOccurrence(directoryBirthday(member), now)
+		if err != nil {
+			continue
+		}
+		occurrences = append(occurrences, o)
+	}
+	sort.SliceStable(occurrences, func(i, j int) bool { return occurrences[i].Date.Before(occurrences[j].Date) })
+
+	entries := []structs.DirectoryEntry{}
+	for _, o := range occurrences {
+		entry := structs.DirectoryEntry{
+			UserID:   o.Birthday.UserID,
+			Name:     o.Birthday.Name,
+			Date:     helper.FormatBirthdayDate(o.Birthday.Date, o.Birthday.YearKnown),
+			NextDate: o.Date.Format(helper.DateLayout),
+			You:      o.Birthday.UserID == userID,
+		}
+		if o.Birthday.YearKnown {
+			entry.Years = o.Years
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// organizationChannelFull converts an OrganizationChannel model into its response representation, with the chat ID decrypted
+func organizationChannelFull(ch *models.OrganizationChannel) (structs.OrganizationChannelFull, error) {
+	chatID, err := encryption.Decrypt(env.MK, ch.TelegramChatID)
+	if err != nil {
+		return structs.OrganizationChannelFull{}, err
+	}
+	return structs.OrganizationChannelFull{
+		ID:             ch.ID.Int64,
+		OrganizationID: ch.OrganizationID,
+		Name:           ch.Name,
+		TelegramChatID: chatID,
+		ReminderTime:   ch.ReminderTime,
+		LeadDays:       int(ch.LeadDays),
+	}, nil
+}
+
+// CheckOrganizationReminders runs periodically to announce the birthdays of the organization directories in their channels.
+func CheckOrganizationReminders() {
+	now := time.Now().UTC()
+
+	// Fetch the channels with the current reminder time
+	channels, err := models.OrganizationChannels(
+		models.OrganizationChannelWhere.ReminderTime.EQ(now.Format("15:04")),
+	).All(context.Background(), env.DB)
+	if err != nil {
+		log.Println("Error querying organization channels:", err)
+		return
+	}
+
+	for _, channel := range channels {
+		botAPIKey, err := encryption.Decrypt(env.MK, channel.TelegramBotAPIKey)
+		if err != nil {
+			log.Println("Error decrypting bot API key:", err)
+			continue
+		}
+		chatID, err := encryption.Decrypt(env.MK, channel.TelegramChatID)
+		if err != nil {
+			log.Println("Error decrypting chat ID:", err)
+			continue
+		}
+		sendOrganizationReminder(channel, botAPIKey, chatID, now)
+	}
+}
+
+// sendOrganizationReminder sends the birthdays of the directory of an organization that are the lead days of the channel
+// away to its chat via Telegram
+func sendOrganizationReminder(channel *models.OrganizationChannel, botAPIKey, chatID string, now time.Time) {
+	members, err := models.OrganizationMembers(
+		models.OrganizationMemberWhere.OrganizationID.EQ(channel.OrganizationID),
+		models.OrganizationMemberWhere.DirectoryDate.IsNotNull(),
+		qm.OrderBy("directory_name"),
+	).All(context.Background(), env.DB)
+	if err != nil {
+		log.Println("Error querying organization members:", err)
+		return
+	}
+
+	leadDays := int(channel.LeadDays)
+	day := now.AddDate(0, 0, leadDays)
+	var lines []string
+	for _, member := range members {
+		o, err := nextOccurrence(directoryBirthday(member), day)
+		if err != nil {
+			log.Println("Error computing birthday occurrence:", err)
+			continue
+		}
+		if o.Date.Format(helper.DateLayout) != day.Format(helper.DateLayout) {
+			continue
+		}
+		line := "> " + o.Birthday.Name
+		if o.Birthday.YearKnown && o.Years > 0 {
+			line += " - " + formatYearsLabel(o.Birthday.EventType, "", o.Years)
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		return
+	}
+
+	reminder := fmt.Sprintf("%s\n\n%s", reminderTitle("birthday", leadDays, day), helper.JoinStrings(lines, "\n"))
+	telegram.SendTelegramMessage(botAPIKey, chatID, reminder)
+}
+
+// @Summary Add an organization
+// @Description This endpoint adds an organization, for teams that share a birthday directory and company chats, the authenticated user becomes its admin. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   organization  body     structs.OrganizationAdd  true  "Add organization"
+// @Success 200 {object} structs.OrganizationFull
+// @Failure 400 {object} structs.Error "Invalid request or organization name"
+// @Failure 500 {object} structs.Error "Failed to insert organization"
+// @Security Bearer
+// @Router /add-organization [post]
+// @Tags organizations
+// @x-order 48
+func AddOrganization(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.OrganizationAdd
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Validate the name
+	if helper.CheckErrors(helper.CheckArrayStringLength([]string{"Name"}, []string{req.Name}, []int{100}, []int{1}, []int{0}, []bool{false})) != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid organization name"})
+		return
+	}
+
+	// Start a new transaction so the organization is inserted along with its admin
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Insert the organization and make the user its admin within the transaction
+	org := &models.Organization{Name: req.Name}
+	if err = org.Insert(c, tx, boil.Infer()); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to insert organization", false)
+		return
+	}
+	member := &models.OrganizationMember{OrganizationID: org.ID.Int64, UserID: user.ID.Int64, Role: organizations.Admin}
+	if err = org.AddOrganizationMembers(c, tx, true, member); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to insert organization", false)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, organizationFull(org, user.ID.Int64, organizations.Admin))
+}
+
+// @Summary List organizations
+// @Description This endpoint lists the organizations the authenticated user is a member of, with their role and the members of each organization. The request must include a valid JWT token.
+// @Produce  json
+// @Success 200 {array} structs.OrganizationFull
+// @Failure 401 {object} structs.Error "Invalid email"
+// @Failure 500 {object} structs.Error "Failed to fetch organizations"
+// @Security Bearer
+// @Router /organizations [get]
+// @Tags organizations
+// @x-order 49
+func ListOrganizations(c *gin.Context) {
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Get the organizations of the user with their members
+	memberships, err := models.OrganizationMembers(models.OrganizationMemberWhere.UserID.EQ(user.ID.Int64)).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch organizations", false) {
+		return
+	}
+	roles := map[int64]string{}
+	ids := make([]int64, 0, len(memberships))
+	for _, membership := range memberships {
+		roles[membership.OrganizationID] = membership.Role
+		ids = append(ids, membership.OrganizationID)
+	}
+	orgs, err := models.Organizations(
+		models.OrganizationWhere.ID.IN(ids),
+		qm.Load(models.OrganizationRels.OrganizationMembers, qm.OrderBy("created_at, user_id")),
+		qm.OrderBy("id"),
+	).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch organizations", false) {
+		return
+	}
+
+	response := []structs.OrganizationFull{}
+	for _, org := range orgs {
+		response = append(response, organizationFull(org, user.ID.Int64, roles[org.ID.Int64]))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Rename an organization
+// @Description This endpoint changes the name of an organization, only the admins of the organization can change it. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   organization  body     structs.OrganizationModify  true  "Modify organization"
+// @Success 200 {object} structs.OrganizationFull
+// @Failure 400 {object} structs.Error "Invalid request or organization name"
+// @Failure 403 {object} structs.Error "Only the admins of the organization can manage it"
+// @Failure 404 {object} structs.Error "Organization not found"
+// @Failure 500 {object} structs.Error "Failed to update organization"
+// @Security Bearer
+// @Router /modify-organization [put]
+// @Tags organizations
+// @x-order 50
+func ModifyOrganization(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.OrganizationModify
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Validate the name
+	if helper.CheckErrors(helper.CheckArrayStringLength([]string{"Name"}, []string{req.Name}, []int{100}, []int{1}, []int{0}, []bool{false})) != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid organization name"})
+		return
+	}
+
+	org, role, ok := findOrganization(c, user.ID.Int64, req.ID, true)
+	if !ok {
+		return
+	}
+
+	// Update the organization
+	org.Name = req.Name
+	if _, err = org.Update(c, env.DB, boil.Whitelist(models.OrganizationColumns.Name)); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to update organization", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, organizationFull(org, user.ID.Int64, role))
+}
+
+// @Summary Delete an organization
+// @Description This endpoint deletes an organization along with its directory, channels and invitations, only the admins of the organization can delete it. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   organization  body     structs.OrganizationID  true  "Delete organization"
+// @Success 200 {object} structs.Success
+// @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 403 {object} structs.Error "Only the admins of the organization can manage it"
+// @Failure 404 {object} structs.Error "Organization not found"
+// @Failure 500 {object} structs.Error "Failed to delete organization"
+// @Security Bearer
+// @Router /delete-organization [delete]
+// @Tags organizations
+// @x-order 51
+func DeleteOrganization(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.OrganizationID
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	org, _, ok := findOrganization(c, user.ID.Int64, req.ID, true)
+	if !ok {
+		return
+	}
+
+	// Start a new transaction
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Delete the channels, invitations, members and the organization within the transaction
+	if _, err = models.OrganizationChannels(models.OrganizationChannelWhere.OrganizationID.EQ(org.ID.Int64)).DeleteAll(c, tx); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to delete organization", false)
+		return
+	}
+	if _, err = models.Invitations(models.InvitationWhere.OrganizationID.EQ(org.ID)).DeleteAll(c, tx); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to delete organization", false)
+		return
+	}
+	if _, err = org.R.OrganizationMembers.DeleteAll(c, tx); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to delete organization", false)
+		return
+	}
+	if _, err = org.Delete(c, tx); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to delete organization", false)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.Success{Success: true})
+}
+
+// @Summary Change the role of a member of an organization
+// @Description This endpoint changes the role of a member of an organization by the email of their account. Admins manage the members and channels of the organization. Only the admins of the organization can manage its members, and an organization must keep at least one admin. Users only become members by accepting an invitation. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   member  body     structs.OrganizationMemberModify  true  "Member"
+// @Success 200 {object} structs.OrganizationFull
+// @Failure 400 {object} structs.Error "Invalid request, role or member"
+// @Failure 400 {object} structs.Error "The organization must keep an admin"
+// @Failure 403 {object} structs.Error "Only the admins of the organization can manage it"
+// @Failure 404 {object} structs.Error "Organization not found"
+// @Failure 500 {object} structs.Error "Failed to update member"
+// @Security Bearer
+// @Router /organization-member [put]
+// @Tags organizations
+// @x-order 52
+func SetOrganizationMember(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.OrganizationMemberModify
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Validate the role
+	err = validateOrganizationRole(req.Role)
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid role", true) {
+		return
+	}
+
+	org, role, ok := findOrganization(c, user.ID.Int64, req.OrganizationID, true)
+	if !ok {
+		return
+	}
+
+	// Find the member, the same error is returned whether or not the email has an account so it can't be used to find
+	// out who is registered
+	member, err := userByEmail(c, req.Email)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch user", false)
+		return
+	}
+
+	// Find the membership of the user, and count the admins of the organization
+	var existing *models.OrganizationMember
+	admins := 0
+	for _, m := range org.R.OrganizationMembers {
+		if member != nil && m.UserID == member.ID.Int64 {
+			existing = m
+		}
+		if m.Role == organizations.Admin {
+			admins++
+		}
+	}
+	if existing == nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "No member with this email, new members join by accepting an invitation"})
+		return
+	}
+
+	// Change the role of the member
+	if existing.Role == organizations.Admin && req.Role != organizations.Admin && admins == 1 {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "The organization must keep an admin"})
+		return
+	}
+	existing.Role = req.Role
+	_, err = existing.Update(c, env.DB, boil.Whitelist(models.OrganizationMemberColumns.Role))
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to update member", false) {
+		return
+	}
+
+	if member.ID.Int64 == user.ID.Int64 {
+		role = req.Role
+	}
+	c.JSON(http.StatusOK, organizationFull(org, user.ID.Int64, role))
+}
+
+// @Summary Remove a member from an organization
+// @Description This endpoint removes a user from an organization by the email of their account, only the admins of the organization can remove other members. Members leave the organization by omitting the email, which also removes them from the directory. If the last admin leaves, the earliest remaining member becomes an admin, and the organization is deleted when the last member leaves. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   member  body     structs.OrganizationMemberRemove  true  "Member"
+// @Success 200 {object} structs.Success
+// @Failure 400 {object} structs.Error "Invalid request or user"
+// @Failure 403 {object} structs.Error "Only the admins of the organization can manage it"
+// @Failure 404 {object} structs.Error "Organization not found"
+// @Failure 500 {object} structs.Error "Failed to remove member"
+// @Security Bearer
+// @Router /organization-member [delete]
+// @Tags organizations
+// @x-order 53
+func RemoveOrganizationMember(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.OrganizationMemberRemove
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Members can always leave, removing someone else needs an admin
+	org, _, ok := findOrganization(c, user.ID.Int64, req.OrganizationID, req.Email != "")
+	if !ok {
+		return
+	}
+
+	// Find the member to remove
+	memberID := user.ID.Int64
+	if req.Email != "" {
+		member, err := userByEmail(c, req.Email)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch user", false)
+			return
+		}
+		isMember := false
+		if member != nil {
+			for _, m := range org.R.OrganizationMembers {
+				isMember = isMember || m.UserID == member.ID.Int64
+			}
+		}
+		if !isMember {
+			c.JSON(http.StatusBadRequest, structs.Error{Error: "No member with this email"})
+			return
+		}
+		memberID = member.ID.Int64
+	}
+
+	// Start a new transaction
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Remove the member within the transaction
+	if err = organizations.RemoveMember(c, tx, org.ID.Int64, memberID); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to remove member", false)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.Success{Success: true})
+}
+
+// @Summary Opt into the directory of an organization
+// @Description This endpoint adds the authenticated user to the birthday directory of an organization they're a member of, or changes their entry. The directory is only visible to the members of the organization and its birthdays are announced in the organization channels. A date without year (--MM-DD) keeps the year private. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   entry  body     structs.DirectoryEntryModify  true  "Directory entry"
+// @Success 200 {object} structs.DirectoryEntry
+// @Failure 400 {object} structs.Error "Invalid request, name or date"
+// @Failure 404 {object} structs.Error "Organization not found"
+// @Failure 500 {object} structs.Error "Failed to update directory entry"
+// @Security Bearer
+// @Router /organization-directory [put]
+// @Tags organizations
+// @x-order 54
+func SetDirectoryEntry(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.DirectoryEntryModify
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Validate the name and date
+	if helper.CheckErrors(helper.CheckArrayStringLength([]string{"Name"}, []string{req.Name}, []int{100}, []int{1}, []int{0}, []bool{false})) != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid name"})
+		return
+	}
+	date, yearKnown, err := helper.ParseBirthdayDate(req.Date)
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid date", true) {
+		return
+	}
+
+	org, _, ok := findOrganization(c, user.ID.Int64, req.OrganizationID, false)
+	if !ok {
+		return
+	}
+
+	// Update the entry of the user
+	var member *models.OrganizationMember
+	for _, m := range org.R.OrganizationMembers {
+		if m.UserID == user.ID.Int64 {
+			member = m
+		}
+	}
+	member.DirectoryName = null.StringFrom(req.Name)
+	member.DirectoryDate = null.TimeFrom(date)
+	member.DirectoryYearKnown = yearKnown
+	entries := directoryEntries(models.OrganizationMemberSlice{member}, user.ID.Int64, time.Now().UTC())
+	if len(entries) == 0 {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid date"})
+		return
+	}
+	if _, err = member.Update(c, env.DB, boil.Whitelist(
+		models.OrganizationMemberColumns.DirectoryName,
+		models.OrganizationMemberColumns.DirectoryDate,
+		models.OrganizationMemberColumns.DirectoryYearKnown,
+	)); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to update directory entry", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, entries[0])
+}
+
+// @Summary Opt out of the directory of an organization
+// @Description This endpoint removes the authenticated user from the birthday directory of an organization, their birthday is no longer shown nor announced. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   entry  body     structs.DirectoryEntryRemove  true  "Directory entry"
+// @Success 200 {object} structs.Success
+// @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 404 {object} structs.Error "Organization not found"
+// @Failure 500 {object} structs.Error "Failed to remove directory entry"
+// @Security Bearer
+// @Router /organization-directory [delete]
+// @Tags organizations
+// @x-order 55
+func RemoveDirectoryEntry(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.DirectoryEntryRemove
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	if _, _, ok := findOrganization(c, user.ID.Int64, req.OrganizationID, false); !ok {
+		return
+	}
+
+	// Clear the entry of the user
+	if _, err = models.OrganizationMembers(
+		models.OrganizationMemberWhere.OrganizationID.EQ(req.OrganizationID),
+		models.OrganizationMemberWhere.UserID.EQ(user.ID.Int64),
+	).UpdateAll(c, env.DB, models.M{
+		models.OrganizationMemberColumns.DirectoryName:      nil,
+		models.OrganizationMemberColumns.DirectoryDate:      nil,
+		models.OrganizationMemberColumns.DirectoryYearKnown: false,
+	}); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to remove directory entry", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.Success{Success: true})
+}
+
+// @Summary Get the directory of an organization
+// @Description This endpoint lists the birthdays of the members of an organization that opted into its directory, sorted by their next date. Only the members of the organization can see it, and the years are left out for the members that keep them private. The request must include a valid JWT token.
+// @Produce  json
+// @Param   organization_id  query     int  true  "Organization ID"
+// @Success 200 {array} structs.DirectoryEntry
+// @Failure 400 {object} structs.Error "Invalid organization ID"
+// @Failure 404 {object} structs.Error "Organization not found"
+// @Failure 500 {object} structs.Error "Failed to fetch organization"
+// @Security Bearer
+// @Router /organization-directory [get]
+// @Tags organizations
+// @x-order 56
+func OrganizationDirectory(c *gin.Context) {
+	// Parse the organization ID
+	orgID, err := strconv.ParseInt(c.Query("organization_id"), 10, 64)
+	if err != nil || orgID <= 0 {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid organization ID"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	org, _, ok := findOrganization(c, user.ID.Int64, orgID, false)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, directoryEntries(org.R.OrganizationMembers, user.ID.Int64, time.Now().UTC()))
+}
+
+// @Summary Add an organization channel
+// @Description This endpoint adds a Telegram chat, such as a company chat, that receives the birthdays of the directory of an organization through its own bot. The birthdays are announced every day at the reminder time in UTC, the lead days before them. Only the admins of the organization can add channels. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   channel  body     structs.OrganizationChannelAdd  true  "Add channel"
+// @Success 200 {object} structs.OrganizationChannelFull
+// @Failure 400 {object} structs.Error "Invalid request or channel"
+// @Failure 403 {object} structs.Error "Only the admins of the organization can manage it"
+// @Failure 404 {object} structs.Error "Organization not found"
+// @Failure 500 {object} structs.Error "Failed to insert channel"
+// @Security Bearer
+// @Router /add-organization-channel [post]
+// @Tags organizations
+// @x-order 57
+func AddOrganizationChannel(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.OrganizationChannelAdd
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Validate the channel
+	validationErrors := helper.CheckArrayStringLength(
+		[]string{"Name", "TelegramBotAPIKey", "TelegramChatID"},
+		[]string{req.Name, req.TelegramBotAPIKey, req.TelegramChatID},
+		[]int{100, 100, 100},
+		[]int{1, 1, 1},
+		[]int{0, 0, 0},
+		[]bool{false, false, false},
+	)
+	reminderTime, err := time.Parse("15:04", req.ReminderTime)
+	if err != nil {
+		validationErrors = append(validationErrors, errors.New("Field 'ReminderTime' must be a time as HH:MM"))
+	}
+	if req.LeadDays < 0 || req.LeadDays > maxChannelLeadDays {
+		validationErrors = append(validationErrors, errors.New("Field 'LeadDays' must be between 0 and "+strconv.Itoa(maxChannelLeadDays)))
+	}
+	if helper.CheckErrors(validationErrors) != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: helper.ConcatenateErrors(validationErrors)})
+		return
+	}
+
+	org, _, ok := findOrganization(c, user.ID.Int64, req.OrganizationID, true)
+	if !ok {
+		return
+	}
+
+	// Encrypt the Telegram bot API key and chat ID
+	encryptedBotAPIKey, err := encryption.Encrypt(env.MK, req.TelegramBotAPIKey)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to encrypt Telegram bot API key", false) {
+		return
+	}
+	encryptedChatID, err := encryption.Encrypt(env.MK, req.TelegramChatID)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to encrypt Telegram chat ID", false) {
+		return
+	}
+
+	channel := &models.OrganizationChannel{
+		OrganizationID:    org.ID.Int64,
+		Name:              req.Name,
+		TelegramBotAPIKey: hex.EncodeToString(encryptedBotAPIKey),
+		TelegramChatID:    hex.EncodeToString(encryptedChatID),
+		ReminderTime:      reminderTime.Format("15:04"),
+		LeadDays:          int64(req.LeadDays),
+	}
+	if err = channel.Insert(c, env.DB, boil.Infer()); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to insert channel", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.OrganizationChannelFull{
+		ID:             channel.ID.Int64,
+		OrganizationID: channel.OrganizationID,
+		Name:           channel.Name,
+		TelegramChatID: req.TelegramChatID,
+		ReminderTime:   channel.ReminderTime,
+		LeadDays:       req.LeadDays,
+	})
+}
+
+// @Summary List the channels of an organization
+// @Description This endpoint lists the Telegram chats that receive the birthdays of the directory of an organization, without their bot API keys. Only the admins of the organization can see them. The request must include a valid JWT token.
+// @Produce  json
+// @Param   organization_id  query     int  true  "Organization ID"
+// @Success 200 {array} structs.OrganizationChannelFull
+// @Failure 400 {object} structs.Error "Invalid organization ID"
+// @Failure 403 {object} structs.Error "Only the admins of the organization can manage it"
+// @Failure 404 {object} structs.Error "Organization not found"
+// @Failure 500 {object} structs.Error "Failed to fetch channels"
+// @Security Bearer
+// @Router /organization-channels [get]
+// @Tags organizations
+// @x-order 58
+func ListOrganizationChannels(c *gin.Context) {
+	// Parse the organization ID
+	orgID, err := strconv.ParseInt(c.Query("organization_id"), 10, 64)
+	if err != nil || orgID <= 0 {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid organization ID"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	if _, _, ok := findOrganization(c, user.ID.Int64, orgID, true); !ok {
+		return
+	}
+
+	// Get the channels of the organization
+	channels, err := models.OrganizationChannels(
+		models.OrganizationChannelWhere.OrganizationID.EQ(orgID),
+		qm.OrderBy("id"),
+	).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch channels", false) {
+		return
+	}
+
+	response := []structs.OrganizationChannelFull{}
+	for _, channel := range channels {
+		full, err := organizationChannelFull(channel)
+		if helper.HE(c, err, http.StatusInternalServerError, "Failed to decrypt channel", false) {
+			return
+		}
+		response = append(response, full)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Delete an organization channel
+// @Description This endpoint deletes a Telegram chat of an organization, it stops receiving the birthdays of the directory. Only the admins of the organization can delete channels. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   channel  body     structs.OrganizationChannelID  true  "Delete channel"
+// @Success 200 {object} structs.Success
+// @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 403 {object} structs.Error "Only the admins of the organization can manage it"
+// @Failure 404 {object} structs.Error "Channel not found"
+// @Failure 500 {object} structs.Error "Failed to delete channel"
+// @Security Bearer
+// @Router /delete-organization-channel [delete]
+// @Tags organizations
+// @x-order 59
+func DeleteOrganizationChannel(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.OrganizationChannelID
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Get the channel, and check that the user is an admin of its organization
+	channel, err := models.FindOrganizationChannel(c, env.DB, null.Int64From(req.ID))
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, structs.Error{Error: "Channel not found"})
+		return
+	}
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch channel", false) {
+		return
+	}
+	if _, _, ok := findOrganization(c, user.ID.Int64, channel.OrganizationID, true); !ok {
+		return
+	}
+
+	if _, err = channel.Delete(c, env.DB); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to delete channel", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.Success{Success: true})
+}
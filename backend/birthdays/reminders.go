@@ -1,14 +1,23 @@
 package birthdays
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"hbd/encryption"
 	"hbd/env"
 	"hbd/helper"
+	"hbd/lists"
+	"hbd/models"
 	"hbd/telegram"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 // CheckReminders runs periodically to check for user reminders.
@@ -59,64 +68,205 @@ func CheckReminders() {
 	}
 }
 
+// reminderPolicy holds the reminder settings that apply to a birthday, from one of its groups or the default
+type reminderPolicy struct {
+	Group       string
+	LeadDays    []int
+	Destination string
+	Template    string
+}
+
+// Birthdays that aren't in any group are reminded on the day, in the user's chat
+var defaultReminderPolicy = reminderPolicy{LeadDays: []int{0}}
+
+// reminderKey identifies the section of a reminder message: the chat it's sent to and the days before the events
+type reminderKey struct {
+	Destination string
+	LeadDays    int
+}
+
+// birthdayPolicies merges the reminder policies of the groups of a birthday.
+// Each destination and lead day pair is reminded once, with the template of the oldest group that sets it.
+func birthdayPolicies(b *models.Birthday) map[reminderKey]reminderPolicy {
+	policies := []reminderPolicy{defaultReminderPolicy}
+	if b.R != nil && len(b.R.GroupBirthdayGroups) > 0 {
+		groups := append(models.BirthdayGroupSlice{}, b.R.GroupBirthdayGroups...)
+		sort.Slice(groups, func(i, j int) bool { return groups[i].ID.Int64 < groups[j].ID.Int64 })
+
+		policies = nil
+		for _, group := range groups {
+			settings, err := getGroupSettings(group)
+			if err != nil {
+				log.Println("Error reading group settings:", err)
+				continue
+			}
+			policies = append(policies, reminderPolicy{Group: group.Name, LeadDays: settings.LeadDays, Destination: settings.Destination, Template: settings.Template})
+		}
+	}
+
+	merged := map[reminderKey]reminderPolicy{}
+	for _, policy := range policies {
+		for _, leadDays := range policy.LeadDays {
+			key := reminderKey{Destination: policy.Destination, LeadDays: leadDays}
+			if _, ok := merged[key]; !ok {
+				merged[key] = policy
+			}
+		}
+	}
+	return merged
+}
+
+// reminderLine formats the line of a birthday in a reminder, using the template of the policy if it has one.
+// Milestone birthdays are highlighted.
+func reminderLine(o occurrence, policy reminderPolicy, leadDays int, milestone bool) string {
+	b := o.Birthday
+
+	// Add the years since the event only if the year is known
+	var yearsLabel string
+	if b.YearKnown && o.Years > 0 {
+		yearsLabel = formatYearsLabel(b.EventType, b.YearsLabel.String, o.Years)
+	}
+
+	line := b.Name
+	if yearsLabel != "" {
+		line += " - " + yearsLabel
+	}
+	if policy.Template != "" {
+		line = strings.NewReplacer(
+			"{name}", b.Name,
+			"{years}", yearsLabel,
+			"{date}", o.Date.Format(helper.DateLayout),
+			"{days}", strconv.Itoa(leadDays),
+			"{group}", policy.Group,
+		).Replace(policy.Template)
+	}
+	if milestone {
+		line = "🎉 MILESTONE: " + line
+	}
+
+	// Add the quick links to contact the person below the event info
+	if links := contactLinks(b); len(links) > 0 {
+		line += "\n   " + helper.JoinStrings(links, " · ")
+	}
+	return "> " + line
+}
+
+// reminderTitle formats the heading of a section of a reminder
+func reminderTitle(eventTypeName string, leadDays int, date time.Time) string {
+	title := eventTypes[eventTypeName].Title
+	switch leadDays {
+	case 0:
+		return fmt.Sprintf("%s for today: %s", title, date.Format(helper.DateLayout))
+	case 1:
+		return fmt.Sprintf("%s for tomorrow: %s", title, date.Format(helper.DateLayout))
+	}
+	return fmt.Sprintf("%s in %d days: %s", title, leadDays, date.Format(helper.DateLayout))
+}
+
 // sendBirthdayReminder sends birthday reminders to the user via Telegram.
+// Birthdays in groups follow the reminder policies of their groups, which can send them to other chats and in advance.
 func sendBirthdayReminder(userId int, botAPIKey, telegramUserID string) {
-	// SQL query to fetch names and dates of birthdays for the given user on the current date
-	var query string
-	if env.DBType() == "postgres" {
-		query = `
-        SELECT name, date FROM birthdays 
-        WHERE user_id = $1 AND 
-		EXTRACT(MONTH FROM TO_DATE(date, 'YYYY-MM-DD'))::int = $2 AND 
-		EXTRACT(DAY FROM TO_DATE(date, 'YYYY-MM-DD'))::int = $3`
-	} else {
-		query = `
-		SELECT name, date FROM birthdays
-		WHERE user_id = ? AND 
-		cast(strftime('%m', date) as integer) = ? AND 
-		cast(strftime('%d', date) as integer) = ?`
+	// Fetch the birthdays of the user and of their shared lists with the user's groups and the open gift ideas, the dates
+	// are matched in Go since the Gregorian date of birthdays in other calendar systems changes every year
+	birthdays, err := models.Birthdays(
+		lists.ReadableBirthdays(int64(userId)),
+		qm.Load(models.BirthdayRels.GroupBirthdayGroups, models.BirthdayGroupWhere.UserID.EQ(int64(userId))),
+		qm.Load(models.BirthdayRels.Gifts, models.GiftWhere.Status.NEQ(giftGiven), qm.OrderBy("id")),
+		qm.OrderBy("id"),
+	).All(context.Background(), env.DB)
+	if err != nil {
+		log.Println("Error querying birthdays:", err)
+		return
 	}
 
-	// Get the current date in UTC
-	now := time.Now().UTC()
-	// Execute the SQL query with user ID and the current month and day as parameters
-	rows, err := env.DB.Query(query, userId, now.Month(), now.Day())
+	// Get the milestone settings of the user
+	user, err := models.FindUser(context.Background(), env.DB, null.Int64From(int64(userId)))
 	if err != nil {
-		log.Println("Error querying birthdays:", err)
+		log.Println("Error querying user:", err)
 		return
 	}
-	defer rows.Close() // Ensure the rows are closed after processing
+	milestones := userMilestones(user)
 
-	var birthdays []string
-	// Iterate over the rows returned by the query
-	for rows.Next() {
-		var name string
-		var date time.Time
-		// Scan the name and date fields from the current row
-		if err := rows.Scan(&name, &date); err != nil {
-			log.Println("Error scanning birthday:", err)
-			continue
+	// Get the current date in UTC
+	now := time.Now().UTC()
+
+	// Events grouped by the section of the reminder and their type
+	events := map[reminderKey]map[string][]string{}
+	for _, b := range birthdays {
+		// Milestone birthdays are also reminded in the user's chat the milestone lead days in advance,
+		// unless a group of the birthday already reminds them then
+		policies := birthdayPolicies(b)
+		milestoneKey := reminderKey{LeadDays: milestones.LeadDays}
+		_, milestoneOnly := policies[milestoneKey]
+		milestoneOnly = !milestoneOnly && milestones.LeadDays > 0
+		if milestoneOnly {
+			policies[milestoneKey] = defaultReminderPolicy
 		}
 
-		// Calculate age only if the year is not 0000
-		var ageStr string
-		if date.Year() != 0 && date.Year() != now.Year() {
-			age := now.Year() - date.Year()
-			// Adjust age if the birthday hasn't occurred yet this year
-			if now.Month() < date.Month() || (now.Month() == date.Month() && now.Day() < date.Day()) {
-				age--
+		for key, policy := range policies {
+			day := now.AddDate(0, 0, key.LeadDays)
+			o, err := nextOccurrence(b, day)
+			if err != nil {
+				// The occurrence depends on the lead days, so the other policies of the birthday may still be reminded
+				log.Println("Error computing birthday occurrence:", err)
+				continue
+			}
+			if o.Date.Format(helper.DateLayout) != day.Format(helper.DateLayout) {
+				continue
+			}
+			milestone := milestones.isMilestone(o)
+			if milestoneOnly && key == milestoneKey && !milestone {
+				continue
+			}
+
+			// Gift ideas are private, so they're only added to the reminders sent to the user's chat
+			line := reminderLine(o, policy, key.LeadDays, milestone)
+			if gifts := openGiftsLine(b); gifts != "" && key.Destination == "" {
+				line += "\n   " + gifts
+			}
+
+			// Add the event info to the list of its type
+			if events[key] == nil {
+				events[key] = map[string][]string{}
 			}
-			ageStr = fmt.Sprintf(" - Turns %d", age)
+			events[key][b.EventType] = append(events[key][b.EventType], line)
 		}
+	}
 
-		// Add the birthday info to the list
-		birthdays = append(birthdays, fmt.Sprintf("> %s%s", name, ageStr))
+	// Sort the sections, the user's chat goes first and the closest events first within each chat
+	keys := make([]reminderKey, 0, len(events))
+	for key := range events {
+		keys = append(keys, key)
 	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Destination != keys[j].Destination {
+			return keys[i].Destination < keys[j].Destination
+		}
+		return keys[i].LeadDays < keys[j].LeadDays
+	})
 
-	// If there are any birthdays for today, create the reminder message
-	if len(birthdays) > 0 {
-		reminder := fmt.Sprintf("🎂 Birthdays for today: %s\n\n%s", now.Format("2006-01-02"), helper.JoinStrings(birthdays, "\n"))
+	// Create a section of the reminder message for each event type, and send a message to each chat
+	sections := map[string][]string{}
+	var destinations []string
+	for _, key := range keys {
+		if len(sections[key.Destination]) == 0 {
+			destinations = append(destinations, key.Destination)
+		}
+		for _, eventTypeName := range eventTypeOrder {
+			if lines := events[key][eventTypeName]; len(lines) > 0 {
+				title := reminderTitle(eventTypeName, key.LeadDays, now.AddDate(0, 0, key.LeadDays))
+				sections[key.Destination] = append(sections[key.Destination], fmt.Sprintf("%s\n\n%s", title, helper.JoinStrings(lines, "\n")))
+			}
+		}
+	}
+
+	for _, destination := range destinations {
+		chatID := destination
+		if chatID == "" {
+			chatID = telegramUserID
+		}
+		reminder := helper.JoinStrings(sections[destination], "\n\n")
 		// Send the reminder via Telegram
-		telegram.SendTelegramMessage(botAPIKey, telegramUserID, reminder)
+		telegram.SendTelegramMessage(botAPIKey, chatID, reminder)
 	}
 }
@@ -0,0 +1,285 @@
+package birthdays
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"hbd/auth"
+	"hbd/calendars"
+	"hbd/encryption"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// shareLocation returns the public URL of a shared list
+func shareLocation(token string) string {
+	return "/api/shared-lists/" + token
+}
+
+// shareFull converts a ListShare model into its response representation
+func shareFull(s *models.ListShare, now time.Time) structs.ListShareFull {
+	share := structs.ListShareFull{
+		ID:        s.ID.Int64,
+		ListID:    s.ListID,
+		HideYears: s.HideYears,
+		Expired:   s.ExpiresAt.Valid && !s.ExpiresAt.Time.After(now),
+		CreatedAt: s.CreatedAt.Time.UTC().Format(time.RFC3339),
+	}
+	if s.ExpiresAt.Valid {
+		share.ExpiresAt = s.ExpiresAt.Time.UTC().Format(time.RFC3339)
+	}
+	return share
+}
+
+// sharedBirthday converts a birthday into its read-only representation for a share, hiding the years if requested
+func sharedBirthday(o occurrence, hideYears bool) structs.SharedListBirthday {
+	b := o.Birthday
+	shared := structs.SharedListBirthday{
+		Name:      b.Name,
+		EventType: b.EventType,
+		Calendar:  b.Calendar,
+		Date:      helper.FormatBirthdayDate(b.Date, b.YearKnown && !hideYears),
+		NextDate:  o.Date.Format(helper.DateLayout),
+	}
+	if b.YearKnown && !hideYears {
+		shared.Years = o.Years
+	}
+	if hideYears && b.Calendar != calendars.Gregorian {
+		shared.Date = ""
+	}
+	return shared
+}
+
+// @Summary Share a list
+// @Description This endpoint creates a token that gives read-only access to a shared list without an account, optionally expiring and hiding the birth years. The token is only returned once, along with the public URL of the list. Only the owners of the list can share it. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   share  body     structs.ListShareAdd  true  "Add share"
+// @Success 200 {object} structs.ListShareFull
+// @Failure 400 {object} structs.Error "Invalid request or expiry time"
+// @Failure 403 {object} structs.Error "Only the owners of the list can manage it"
+// @Failure 404 {object} structs.Error "List not found"
+// @Failure 500 {object} structs.Error "Failed to insert share"
+// @Security Bearer
+// @Router /add-list-share [post]
+// @Tags lists
+// @x-order 44
+func AddListShare(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.ListShareAdd
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Parse the expiry time, which must be in the future
+	now := time.Now().UTC()
+	var expiresAt null.Time
+	if req.ExpiresAt != "" {
+		t, err := time.Parse(time.RFC3339, req.ExpiresAt)
+		if err != nil || !t.After(now) {
+			c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid expiry time, it must be a future RFC 3339 time"})
+			return
+		}
+		expiresAt = null.TimeFrom(t.UTC())
+	}
+
+	list, _, ok := findList(c, user.ID.Int64, req.ListID, true)
+	if !ok {
+		return
+	}
+
+	// Generate the token, only its hash is stored
+	token, err := encryption.GenerateToken()
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to generate token", false) {
+		return
+	}
+
+	share := &models.ListShare{
+		ListID:    list.ID.Int64,
+		UserID:    user.ID.Int64,
+		TokenHash: encryption.HashStringWithSHA256(token),
+		HideYears: req.HideYears,
+		ExpiresAt: expiresAt,
+	}
+	if err = share.Insert(c, env.DB, boil.Infer()); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to insert share", false)
+		return
+	}
+
+	response := shareFull(share, now)
+	response.Token = token
+	response.URL = shareLocation(token)
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary List the shares of a list
+// @Description This endpoint lists the tokens that give read-only access to a shared list, without the tokens themselves. Only the owners of the list can see them. The request must include a valid JWT token.
+// @Produce  json
+// @Param   list_id  query     int  true  "List ID"
+// @Success 200 {array} structs.ListShareFull
+// @Failure 400 {object} structs.Error "Invalid list ID"
+// @Failure 403 {object} structs.Error "Only the owners of the list can manage it"
+// @Failure 404 {object} structs.Error "List not found"
+// @Failure 500 {object} structs.Error "Failed to fetch shares"
+// @Security Bearer
+// @Router /list-shares [get]
+// @Tags lists
+// @x-order 45
+func ListListShares(c *gin.Context) {
+	// Parse the list ID
+	listID, err := strconv.ParseInt(c.Query("list_id"), 10, 64)
+	if err != nil || listID <= 0 {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid list ID"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	if _, _, ok := findList(c, user.ID.Int64, listID, true); !ok {
+		return
+	}
+
+	// Get the shares of the list, newest first
+	shares, err := models.ListShares(
+		models.ListShareWhere.ListID.EQ(listID),
+		qm.OrderBy("id DESC"),
+	).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch shares", false) {
+		return
+	}
+
+	now := time.Now().UTC()
+	response := []structs.ListShareFull{}
+	for _, share := range shares {
+		response = append(response, shareFull(share, now))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Revoke a share of a list
+// @Description This endpoint revokes a token that gives read-only access to a shared list, the link stops working immediately. Only the owners of the list can revoke it. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   share  body     structs.ListShareID  true  "Revoke share"
+// @Success 200 {object} structs.Success
+// @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 403 {object} structs.Error "Only the owners of the list can manage it"
+// @Failure 404 {object} structs.Error "Share not found"
+// @Failure 500 {object} structs.Error "Failed to revoke share"
+// @Security Bearer
+// @Router /delete-list-share [delete]
+// @Tags lists
+// @x-order 46
+func DeleteListShare(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.ListShareID
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user from the context
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	// Get the share, and check that the user owns its list
+	share, err := models.FindListShare(c, env.DB, null.Int64From(req.ID))
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, structs.Error{Error: "Share not found"})
+		return
+	}
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch share", false) {
+		return
+	}
+	if _, _, ok := findList(c, user.ID.Int64, share.ListID, true); !ok {
+		return
+	}
+
+	if _, err = share.Delete(c, env.DB); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to revoke share", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, structs.Success{Success: true})
+}
+
+// @Summary View a shared list
+// @Description This endpoint returns a read-only view of a shared list through a share token, without an account. Only the names, event types and dates of the birthdays are shown, sorted by their next date, and the birth years are left out if the share hides them. Each token has its own rate limit.
+// @Produce  json
+// @Param   token  path     string  true  "Share token"
+// @Success 200 {object} structs.SharedList
+// @Failure 404 {object} structs.Error "Shared list not found"
+// @Failure 429 {object} structs.Error "Too many requests"
+// @Failure 500 {object} structs.Error "Failed to fetch shared list"
+// @Router /shared-lists/{token} [get]
+// @Tags lists
+// @x-order 47
+func ViewSharedList(c *gin.Context) {
+	// Find the share by the hash of the token, expired and revoked shares are treated as missing
+	now := time.Now().UTC()
+	share, err := models.ListShares(
+		models.ListShareWhere.TokenHash.EQ(encryption.HashStringWithSHA256(c.Param("token"))),
+	).One(c, env.DB)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && share.ExpiresAt.Valid && !share.ExpiresAt.Time.After(now)) {
+		c.JSON(http.StatusNotFound, structs.Error{Error: "Shared list not found"})
+		return
+	}
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch shared list", false) {
+		return
+	}
+
+	// Get the list and its birthdays
+	list, err := models.FindList(c, env.DB, null.Int64From(share.ListID))
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch shared list", false) {
+		return
+	}
+	birthdays, err := models.Birthdays(
+		models.BirthdayWhere.ListID.EQ(null.Int64From(share.ListID)),
+		qm.OrderBy("id"),
+	).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch shared list", false) {
+		return
+	}
+
+	var occurrences []occurrence
+	for _, b := range birthdays {
+		o, err := nextOccurrence(b, now)
+		if err != nil {
+			continue
+		}
+		occurrences = append(occurrences, o)
+	}
+	sort.SliceStable(occurrences, func(i, j int) bool { return occurrences[i].Date.Before(occurrences[j].Date) })
+
+	response := structs.SharedList{Name: list.Name, Birthdays: []structs.SharedListBirthday{}}
+	for _, o := range occurrences {
+		response.Birthdays = append(response.Birthdays, sharedBirthday(o, share.HideYears))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,274 @@
+package birthdays
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"hbd/auth"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/lists"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Number of weeks listed as the busiest
+const busiestWeeks = 5
+
+// statsDialect holds the SQL expressions that differ between the supported databases
+type statsDialect struct {
+	// Month and day of the date as MM-DD
+	MonthDay string
+	// Year of the date as an integer
+	Year string
+}
+
+// The date of a birthday on Postgres, where the dates are stored as text. The explicit cast keeps it working if the
+// column is ever migrated to a date type.
+const postgresDate = "TO_DATE(CAST(date AS TEXT), 'YYYY-MM-DD')"
+
+// statsSQL returns the SQL expressions of the configured database
+func statsSQL() statsDialect {
+	if env.DBType() == "postgres" {
+		return statsDialect{
+			MonthDay: "to_char(" + postgresDate + ", 'MM-DD')",
+			Year:     "CAST(EXTRACT(YEAR FROM " + postgresDate + ") AS INTEGER)",
+		}
+	}
+	return statsDialect{
+		MonthDay: "strftime('%m-%d', date)",
+		Year:     "CAST(strftime('%Y', date) AS INTEGER)",
+	}
+}
+
+// rebind replaces the ? placeholders of a query with numbered placeholders for Postgres
+func rebind(query string) string {
+	if env.DBType() != "postgres" {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// placeholders returns a list of n placeholders for an IN clause
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// Birthday events of the user and of their shared lists that aren't in the trash, the user ID is passed twice
+const statsFilter = lists.ReadableBirthdaysSQL + " AND deleted_at IS NULL AND event_type = 'birthday'"
+
+// weekOf returns the week of the year of a month and day, counted from January 1st in a leap year so
+// February 29th has its own day
+func weekOf(monthDay string) (int, error) {
+	date, err := time.Parse("2006-01-02", "2000-"+monthDay)
+	if err != nil {
+		return 0, err
+	}
+	return (date.YearDay()-1)/7 + 1, nil
+}
+
+// weekCount returns the first and last day of a week as month and day
+func weekCount(week, count int) structs.WeekCount {
+	start := time.Date(2000, time.January, 1+(week-1)*7, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 6)
+	if end.Year() != start.Year() {
+		end = time.Date(2000, time.December, 31, 0, 0, 0, 0, time.UTC)
+	}
+	return structs.WeekCount{Week: week, Start: start.Format("--01-02"), End: end.Format("--01-02"), Count: count}
+}
+
+// birthdayStats computes the statistics of the birthdays of the user as of the given day, with the milestone ages of the user
+func birthdayStats(ctx context.Context, userID int64, milestones []int, today time.Time) (structs.BirthdayStats, error) {
+	dialect := statsSQL()
+	year, monthDay := today.Year(), today.Format("01-02")
+	stats := structs.BirthdayStats{
+		PerMonth:           []structs.MonthCount{},
+		BusiestWeeks:       []structs.WeekCount{},
+		AgeDistribution:    []structs.AgeBucket{},
+		UpcomingMilestones: []structs.MilestoneBirthday{},
+		SharedBirthdays:    []structs.SharedBirthday{},
+	}
+
+	// Count the birthdays on each day of the year, the months, weeks and shared birthdays are taken from the counts
+	rows, err := env.DB.QueryContext(ctx, rebind(fmt.Sprintf(
+		"SELECT %[1]s AS month_day, COUNT(*) FROM birthdays WHERE %[2]s GROUP BY %[1]s",
+		dialect.MonthDay, statsFilter,
+	)), userID, userID)
+	if err != nil {
+		return stats, err
+	}
+	perMonth := make([]int, 12)
+	perWeek := map[int]int{}
+	var shared []any
+	for rows.Next() {
+		var day string
+		var count int
+		if err := rows.Scan(&day, &count); err != nil {
+			rows.Close()
+			return stats, err
+		}
+		month, err := strconv.Atoi(day[:2])
+		if err != nil || month < 1 || month > 12 {
+			continue
+		}
+		week, err := weekOf(day)
+		if err != nil {
+			continue
+		}
+		stats.Total += count
+		perMonth[month-1] += count
+		perWeek[week] += count
+		if count > 1 {
+			shared = append(shared, day)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return stats, err
+	}
+
+	for i, count := range perMonth {
+		stats.PerMonth = append(stats.PerMonth, structs.MonthCount{Month: i + 1, Count: count})
+	}
+	for week, count := range perWeek {
+		stats.BusiestWeeks = append(stats.BusiestWeeks, weekCount(week, count))
+	}
+	sort.Slice(stats.BusiestWeeks, func(i, j int) bool {
+		if stats.BusiestWeeks[i].Count != stats.BusiestWeeks[j].Count {
+			return stats.BusiestWeeks[i].Count > stats.BusiestWeeks[j].Count
+		}
+		return stats.BusiestWeeks[i].Week < stats.BusiestWeeks[j].Week
+	})
+	if len(stats.BusiestWeeks) > busiestWeeks {
+		stats.BusiestWeeks = stats.BusiestWeeks[:busiestWeeks]
+	}
+
+	// Count the ages by decade, for the birthdays with a known year
+	age := fmt.Sprintf("(? - %s - CASE WHEN %s > ? THEN 1 ELSE 0 END)", dialect.Year, dialect.MonthDay)
+	rows, err = env.DB.QueryContext(ctx, rebind(fmt.Sprintf(
+		"SELECT %[1]s / 10 AS decade, COUNT(*) FROM birthdays WHERE %[2]s AND year_known AND %[1]s >= 0 GROUP BY decade ORDER BY decade",
+		age, statsFilter,
+	)), year, monthDay, userID, userID, year, monthDay)
+	if err != nil {
+		return stats, err
+	}
+	for rows.Next() {
+		var decade, count int
+		if err := rows.Scan(&decade, &count); err != nil {
+			rows.Close()
+			return stats, err
+		}
+		stats.AgeDistribution = append(stats.AgeDistribution, structs.AgeBucket{From: decade * 10, To: decade*10 + 9, Count: count})
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return stats, err
+	}
+
+	// Find the Gregorian birthdays in the next year on which the person turns a milestone age, closest first
+	if len(milestones) > 0 {
+		args := []any{year, monthDay, userID, userID}
+		for _, milestone := range milestones {
+			args = append(args, milestone)
+		}
+		args = append(args, monthDay)
+		rows, err = env.DB.QueryContext(ctx, rebind(fmt.Sprintf(`
+			SELECT id, name, birth_year, month_day, turning FROM (
+				SELECT id, name, %[1]s AS birth_year, %[2]s AS month_day, (? - %[1]s + CASE WHEN %[2]s < ? THEN 1 ELSE 0 END) AS turning
+				FROM birthdays WHERE %[3]s AND year_known AND calendar = 'gregorian'
+			) AS upcoming
+			WHERE turning IN (%[4]s)
+			ORDER BY CASE WHEN month_day < ? THEN 1 ELSE 0 END, month_day, name`,
+			dialect.Year, dialect.MonthDay, statsFilter, placeholders(len(milestones)),
+		)), args...)
+		if err != nil {
+			return stats, err
+		}
+		for rows.Next() {
+			var m structs.MilestoneBirthday
+			var birthYear int
+			var day string
+			if err := rows.Scan(&m.ID, &m.Name, &birthYear, &day, &m.Turning); err != nil {
+				rows.Close()
+				return stats, err
+			}
+			m.Date = fmt.Sprintf("%04d-%s", birthYear, day)
+			stats.UpcomingMilestones = append(stats.UpcomingMilestones, m)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return stats, err
+		}
+	}
+
+	// List the names of the people who share a birthday
+	if len(shared) > 0 {
+		rows, err = env.DB.QueryContext(ctx, rebind(fmt.Sprintf(
+			"SELECT %[1]s AS month_day, name FROM birthdays WHERE %[2]s AND %[1]s IN (%[3]s) ORDER BY month_day, name",
+			dialect.MonthDay, statsFilter, placeholders(len(shared)),
+		)), append([]any{userID, userID}, shared...)...)
+		if err != nil {
+			return stats, err
+		}
+		for rows.Next() {
+			var day, name string
+			if err := rows.Scan(&day, &name); err != nil {
+				rows.Close()
+				return stats, err
+			}
+			date := "--" + day
+			if n := len(stats.SharedBirthdays); n == 0 || stats.SharedBirthdays[n-1].Date != date {
+				stats.SharedBirthdays = append(stats.SharedBirthdays, structs.SharedBirthday{Date: date})
+			}
+			last := &stats.SharedBirthdays[len(stats.SharedBirthdays)-1]
+			last.Names = append(last.Names, name)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return stats, err
+		}
+	}
+
+	return stats, nil
+}
+
+// @Summary Get birthday statistics
+// @Description This endpoint returns statistics of the birthdays of the authenticated user, including those of their shared lists: birthdays per month, the busiest weeks, the age distribution by decade, the upcoming birthdays on which people turn one of the user's milestone ages and the days shared by more than one birthday. Only birthday events are counted. The request must include a valid JWT token.
+// @Produce  json
+// @Success 200 {object} structs.BirthdayStats
+// @Failure 401 {object} structs.Error "Invalid email"
+// @Failure 500 {object} structs.Error "Failed to compute statistics"
+// @Security Bearer
+// @Router /birthday-stats [get]
+// @Tags birthdays
+// @x-order 35
+func BirthdayStats(c *gin.Context) {
+	// Get the user from the context, without loading the birthdays since they're aggregated in SQL
+	user, _, err := auth.GetUserByEmail(c)
+	if helper.HE(c, err, http.StatusUnauthorized, "Invalid email", false) {
+		return
+	}
+
+	stats, err := birthdayStats(c, user.ID.Int64, helper.ParseMilestones(user.Milestones), time.Now().UTC())
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to compute statistics", false) {
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
@@ -0,0 +1,103 @@
+package birthdays
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"hbd/env"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestBirthdayStats(t *testing.T) {
+	ctx := context.Background()
+	userID, otherID := newTestUser(t, "stats@example.com"), newTestUser(t, "stats-other@example.com")
+
+	// A list of another user that the user is a member of
+	list := &models.List{Name: "Family"}
+	if err := list.Insert(ctx, env.DB, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	member := &models.ListMember{ListID: list.ID.Int64, UserID: userID, Role: "viewer"}
+	if err := member.Insert(ctx, env.DB, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	insert := func(ownerID int64, req structs.BirthdayNameDateAdd) *models.Birthday {
+		b, _, err := newBirthday(ownerID, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = b.Insert(ctx, env.DB, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	insert(userID, structs.BirthdayNameDateAdd{Name: "Ann", Date: "1990-03-02"})
+	insert(userID, structs.BirthdayNameDateAdd{Name: "Bob", Date: "1990-03-02"})
+	insert(userID, structs.BirthdayNameDateAdd{Name: "Cid", Date: "--03-02"})
+	insert(userID, structs.BirthdayNameDateAdd{Name: "Dee", Date: "2000-02-29"})
+	eve := insert(userID, structs.BirthdayNameDateAdd{Name: "Eve", Date: "1976-12-31"})
+	fay := insert(otherID, structs.BirthdayNameDateAdd{Name: "Fay", Date: "1976-10-20", ListID: list.ID.Int64})
+
+	// Other events, trashed birthdays and birthdays of other users aren't counted
+	insert(userID, structs.BirthdayNameDateAdd{Name: "Wedding", Date: "2010-03-02", EventType: "wedding_anniversary"})
+	insert(otherID, structs.BirthdayNameDateAdd{Name: "Gus", Date: "1990-03-02"})
+	trashed := insert(userID, structs.BirthdayNameDateAdd{Name: "Hal", Date: "1990-03-02"})
+	trashed.DeletedAt = null.TimeFrom(time.Now())
+	if _, err := trashed.Update(ctx, env.DB, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := birthdayStats(ctx, userID, []int{50}, time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stats.Total != 6 {
+		t.Errorf("Total = %d, want 6", stats.Total)
+	}
+	perMonth := map[int]int{}
+	for _, m := range stats.PerMonth {
+		if m.Count > 0 {
+			perMonth[m.Month] = m.Count
+		}
+	}
+	if want := map[int]int{2: 1, 3: 3, 10: 1, 12: 1}; !reflect.DeepEqual(perMonth, want) {
+		t.Errorf("PerMonth = %v, want %v", perMonth, want)
+	}
+
+	// February 29th and March 2nd are in week 9, October 20th in week 42 and December 31st in week 53
+	wantWeeks := []structs.WeekCount{
+		{Week: 9, Start: "--02-26", End: "--03-03", Count: 4},
+		{Week: 42, Start: "--10-14", End: "--10-20", Count: 1},
+		{Week: 53, Start: "--12-30", End: "--12-31", Count: 1},
+	}
+	if !reflect.DeepEqual(stats.BusiestWeeks, wantWeeks) {
+		t.Errorf("BusiestWeeks = %v, want %v", stats.BusiestWeeks, wantWeeks)
+	}
+
+	// Ann and Bob are 36, Dee 26 and Eve and Fay 49, Cid has no known year
+	wantAges := []structs.AgeBucket{{From: 20, To: 29, Count: 1}, {From: 30, To: 39, Count: 2}, {From: 40, To: 49, Count: 2}}
+	if !reflect.DeepEqual(stats.AgeDistribution, wantAges) {
+		t.Errorf("AgeDistribution = %v, want %v", stats.AgeDistribution, wantAges)
+	}
+
+	wantMilestones := []structs.MilestoneBirthday{
+		{ID: fay.ID.Int64, Name: "Fay", Date: "1976-10-20", Turning: 50},
+		{ID: eve.ID.Int64, Name: "Eve", Date: "1976-12-31", Turning: 50},
+	}
+	if !reflect.DeepEqual(stats.UpcomingMilestones, wantMilestones) {
+		t.Errorf("UpcomingMilestones = %v, want %v", stats.UpcomingMilestones, wantMilestones)
+	}
+
+	wantShared := []structs.SharedBirthday{{Date: "--03-02", Names: []string{"Ann", "Bob", "Cid"}}}
+	if !reflect.DeepEqual(stats.SharedBirthdays, wantShared) {
+		t.Errorf("SharedBirthdays = %v, want %v", stats.SharedBirthdays, wantShared)
+	}
+}
@@ -0,0 +1,222 @@
+package birthdays
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"hbd/auth"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/lists"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Days that birthdays stay in the trash when HBD_TRASH_RETENTION_DAYS isn't set
+const defaultTrashRetentionDays = 30
+
+// trashRetention returns how long birthdays stay in the trash before they're purged, from HBD_TRASH_RETENTION_DAYS
+func trashRetention() time.Duration {
+	days := defaultTrashRetentionDays
+	if str := os.Getenv("HBD_TRASH_RETENTION_DAYS"); str != "" {
+		parsed, err := strconv.Atoi(str)
+		if err != nil || parsed < 0 {
+			log.Println("Invalid HBD_TRASH_RETENTION_DAYS, using the default of", defaultTrashRetentionDays, "days")
+		} else {
+			days = parsed
+		}
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// PurgeTrash runs periodically to permanently delete the birthdays that have been in the trash longer than the retention period.
+func PurgeTrash() {
+	ctx := context.Background()
+	cutoff := time.Now().UTC().Add(-trashRetention())
+
+	// Find the birthdays to purge
+	birthdays, err := models.Birthdays(
+		qm.WithDeleted(),
+		models.BirthdayWhere.DeletedAt.IsNotNull(),
+		models.BirthdayWhere.DeletedAt.LT(null.TimeFrom(cutoff)),
+	).All(ctx, env.DB)
+	if err != nil {
+		log.Println("Error querying trashed birthdays:", err)
+		return
+	}
+	if len(birthdays) == 0 {
+		return
+	}
+
+	ids := make([]int64, len(birthdays))
+	for i, b := range birthdays {
+		ids[i] = b.ID.Int64
+	}
+
+	// Start a new transaction so the birthdays are purged along with their tags, history, gifts and group memberships
+	tx, err := env.DB.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println("Error beginning transaction:", err)
+		return
+	}
+
+	// Delete the tags, history, gifts and group memberships, then the birthdays, within the transaction
+	if _, err = models.BirthdayTags(models.BirthdayTagWhere.BirthdayID.IN(ids)).DeleteAll(ctx, tx); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		log.Println("Error purging birthday tags:", err)
+		return
+	}
+	if _, err = models.BirthdayHistories(models.BirthdayHistoryWhere.BirthdayID.IN(ids)).DeleteAll(ctx, tx); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		log.Println("Error purging birthday history:", err)
+		return
+	}
+	if _, err = models.Gifts(models.GiftWhere.BirthdayID.IN(ids)).DeleteAll(ctx, tx); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		log.Println("Error purging gifts:", err)
+		return
+	}
+	for _, b := range birthdays {
+		if err = b.SetGroupBirthdayGroups(ctx, tx, false); err != nil {
+			tx.Rollback() // Rollback the transaction on error
+			log.Println("Error purging group memberships:", err)
+			return
+		}
+	}
+	if _, err = birthdays.DeleteAll(ctx, tx, true); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		log.Println("Error purging birthdays:", err)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		log.Println("Error committing transaction:", err)
+		return
+	}
+
+	log.Println("Purged", len(birthdays), "birthdays from the trash")
+}
+
+// @Summary List the trash
+// @Description This endpoint lists the deleted birthdays of the authenticated user and of the shared lists they can edit that can still be restored, with the time at which they'll be purged. The request must include a valid JWT token.
+// @Produce  json
+// @Success 200 {array} structs.TrashedBirthday
+// @Failure 500 {object} structs.Error "Failed to fetch birthdays"
+// @Security Bearer
+// @Router /trash [get]
+// @Tags birthdays
+// @x-order 21
+func ListTrash(c *gin.Context) {
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Get the birthdays in the trash with their tags, most recently deleted first
+	birthdays, err := models.Birthdays(
+		qm.WithDeleted(),
+		lists.EditableBirthdays(userData.ID),
+		models.BirthdayWhere.DeletedAt.IsNotNull(),
+		qm.Load(models.BirthdayRels.BirthdayTags),
+		qm.OrderBy("deleted_at DESC, id"),
+	).All(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch birthdays", false) {
+		return
+	}
+
+	retention := trashRetention()
+	trash := []structs.TrashedBirthday{}
+	for _, b := range birthdays {
+		trash = append(trash, structs.TrashedBirthday{
+			Birthday:  helper.BirthdayFull(b),
+			DeletedAt: b.DeletedAt.Time.UTC().Format(time.RFC3339),
+			PurgeAt:   b.DeletedAt.Time.Add(retention).UTC().Format(time.RFC3339),
+		})
+	}
+
+	c.JSON(http.StatusOK, trash)
+}
+
+// @Summary Restore a birthday
+// @Description This endpoint restores a deleted birthday of the authenticated user from the trash. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   birthday  body     structs.BirthdayID  true  "Restore birthday"
+// @Success 200 {object} structs.BirthdayFull
+// @Failure 400 {object} structs.Error "Invalid request"
+// @Failure 500 {object} structs.Error "Birthday isn't in the trash"
+// @Failure 500 {object} structs.Error "Failed to restore birthday"
+// @Security Bearer
+// @Router /restore-birthday [post]
+// @Tags birthdays
+// @x-order 22
+func RestoreBirthday(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.BirthdayID
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Get the birthday from the trash
+	birthday, err := models.Birthdays(
+		qm.WithDeleted(),
+		lists.EditableBirthdays(userData.ID),
+		models.BirthdayWhere.ID.EQ(null.Int64From(req.ID)),
+		models.BirthdayWhere.DeletedAt.IsNotNull(),
+		qm.Load(models.BirthdayRels.BirthdayTags),
+	).One(c, env.DB)
+	if helper.HE(c, err, http.StatusInternalServerError, "Birthday isn't in the trash", false) {
+		return
+	}
+
+	// Start a new transaction so the restore is recorded in the history
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Clear the deletion time
+	birthday.DeletedAt = null.Time{}
+	if err = bumpBirthdayVersion(c, tx, birthday); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.VersionHE(c, err, "Failed to restore birthday")
+		return
+	}
+	if _, err = birthday.Update(c, tx, boil.Whitelist(models.BirthdayColumns.DeletedAt)); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to restore birthday", false)
+		return
+	}
+	if err = recordHistory(c, tx, userData.ID, birthday.ID.Int64, historyRestore, nil, snapshot(birthday)); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to restore birthday", false)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.JSON(http.StatusOK, helper.BirthdayFull(birthday))
+}
@@ -0,0 +1,340 @@
+package birthdays
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"hbd/auth"
+	"hbd/env"
+	"hbd/helper"
+	"hbd/lists"
+	"hbd/models"
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// birthdayLocation returns the URL of a birthday in the v2 API
+func birthdayLocation(id int64) string {
+	return "/api/v2/birthdays/" + strconv.FormatInt(id, 10)
+}
+
+// findBirthday gets a birthday the user can see with its tags, responding with 404 if it doesn't exist
+func findBirthday(c *gin.Context, userID int64) (*models.Birthday, bool) {
+	// Parse the birthday ID
+	id, err := birthdayParam(c)
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid birthday ID", true) {
+		return nil, false
+	}
+
+	birthday, err := models.Birthdays(
+		lists.ReadableBirthdays(userID),
+		models.BirthdayWhere.ID.EQ(null.Int64From(id)),
+		qm.Load(models.BirthdayRels.BirthdayTags),
+	).One(c, env.DB)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, structs.Error{Error: "Birthday not found"})
+		return nil, false
+	}
+	if helper.HE(c, err, http.StatusInternalServerError, "Failed to fetch birthday", false) {
+		return nil, false
+	}
+	return birthday, true
+}
+
+// birthdayConflicts checks if the user, or the list of the birthday, has another birthday with the same name, date and event type
+func birthdayConflicts(ctx context.Context, exec boil.ContextExecutor, b *models.Birthday) (bool, error) {
+	mods := []qm.QueryMod{models.BirthdayWhere.UserID.EQ(b.UserID), models.BirthdayWhere.ListID.IsNull()}
+	if b.ListID.Valid {
+		mods = []qm.QueryMod{models.BirthdayWhere.ListID.EQ(b.ListID)}
+	}
+	mods = append(mods,
+		models.BirthdayWhere.Name.EQ(b.Name),
+		models.BirthdayWhere.Date.EQ(b.Date),
+		models.BirthdayWhere.EventType.EQ(b.EventType),
+	)
+	if b.ID.Valid {
+		mods = append(mods, models.BirthdayWhere.ID.NEQ(b.ID))
+	}
+	return models.Birthdays(mods...).Exists(ctx, exec)
+}
+
+// @Summary Get a birthday
+// @Description This endpoint returns a birthday of the authenticated user with its notes, tags and contact details. The request must include a valid JWT token.
+// @Produce  json
+// @Param   id  path     int  true  "Birthday ID"
+// @Success 200 {object} structs.BirthdayFull
+// @Header  200 {string} ETag "Version of the birthday"
+// @Failure 400 {object} structs.Error "Invalid birthday ID"
+// @Failure 404 {object} structs.Error "Birthday not found"
+// @Failure 500 {object} structs.Error "Failed to fetch birthday"
+// @Security Bearer
+// @Router /v2/birthdays/{id} [get]
+// @Tags birthdays v2
+// @x-order 26
+func GetBirthdayV2(c *gin.Context) {
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	birthday, ok := findBirthday(c, userData.ID)
+	if !ok {
+		return
+	}
+
+	c.Header("ETag", helper.ETag(birthday.Version))
+	c.JSON(http.StatusOK, helper.BirthdayFull(birthday))
+}
+
+// @Summary Create a birthday
+// @Description This endpoint adds a new birthday for the authenticated user and returns it along with its URL in the Location header. The user can't have two birthdays with the same name, date and event type. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   birthday  body     structs.BirthdayNameDateAdd  true  "Add birthday"
+// @Success 201 {object} structs.BirthdayFull
+// @Header  201 {string} Location "URL of the birthday"
+// @Header  201 {string} ETag "Version of the birthday"
+// @Failure 400 {object} structs.Error "Invalid request, name, date format, event type, calendar or details"
+// @Failure 403 {object} structs.Error "Not allowed to add birthdays to this list"
+// @Failure 409 {object} structs.Error "Birthday already exists"
+// @Failure 500 {object} structs.Error "Failed to insert birthday"
+// @Security Bearer
+// @Router /v2/birthdays [post]
+// @Tags birthdays v2
+// @x-order 27
+func CreateBirthdayV2(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.BirthdayNameDateAdd
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	// Create a Birthday model with the parsed data
+	b, tags, err := newBirthday(userData.ID, req)
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid name, date format, event type, calendar or details", true) {
+		return
+	}
+
+	// Check that the user can add birthdays to the list
+	if !checkListEditable(c, userData.ID, req.ListID) {
+		return
+	}
+
+	// Start a new transaction so the conflict check and the insert see the same birthdays
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Check that the birthday doesn't exist yet
+	conflict, err := birthdayConflicts(c, tx, b)
+	if err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to insert birthday", false)
+		return
+	}
+	if conflict {
+		tx.Rollback() // Rollback the transaction on conflict
+		c.JSON(http.StatusConflict, structs.Error{Error: "Birthday already exists"})
+		return
+	}
+
+	// Insert the birthday into the database
+	if err = insertBirthday(c, tx, b, tags, userData.ID); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to insert birthday", false)
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.Header("Location", birthdayLocation(b.ID.Int64))
+	c.Header("ETag", helper.ETag(b.Version))
+	c.JSON(http.StatusCreated, helper.BirthdayFull(b))
+}
+
+// @Summary Update a birthday
+// @Description This endpoint changes the provided fields of a birthday of the authenticated user and returns it. The user can't have two birthdays with the same name, date and event type. The request must include a valid JWT token.
+// @Accept  json
+// @Produce  json
+// @Param   id  path     int  true  "Birthday ID"
+// @Param   birthday  body     structs.BirthdayPatch  true  "Changed fields"
+// @Param   If-Match  header  string  false  "Only apply the change if the birthday still has this ETag"
+// @Success 200 {object} structs.BirthdayFull
+// @Header  200 {string} ETag "Version of the birthday"
+// @Failure 400 {object} structs.Error "Invalid request, birthday ID, name, date format, event type, calendar or details"
+// @Failure 403 {object} structs.Error "Not allowed to change this birthday"
+// @Failure 404 {object} structs.Error "Birthday not found"
+// @Failure 409 {object} structs.Error "Birthday already exists"
+// @Failure 412 {object} structs.Error "Birthday was changed by another request"
+// @Failure 500 {object} structs.Error "Failed to update birthday"
+// @Security Bearer
+// @Router /v2/birthdays/{id} [patch]
+// @Tags birthdays v2
+// @x-order 28
+func UpdateBirthdayV2(c *gin.Context) {
+	// Declare a variable to hold the request data
+	var req structs.BirthdayPatch
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	birthday, ok := findBirthday(c, userData.ID)
+	if !ok || !checkEditable(c, userData.ID, birthday) {
+		return
+	}
+
+	// Check that the birthday wasn't changed since the client read it
+	if helper.PreconditionFailed(c, helper.ETag(birthday.Version)) {
+		return
+	}
+
+	// Keep the name and date if they weren't provided, the other fields are kept by modifyBirthday
+	oldValues := snapshot(birthday)
+	modify := structs.BirthdayNameDateModify{
+		ID:               birthday.ID.Int64,
+		Name:             oldValues.Name,
+		Date:             oldValues.Date,
+		EventType:        req.EventType,
+		YearsLabel:       req.YearsLabel,
+		Calendar:         req.Calendar,
+		Notes:            req.Notes,
+		Tags:             req.Tags,
+		TelegramUsername: req.TelegramUsername,
+		Phone:            req.Phone,
+		Email:            req.Email,
+	}
+	if req.Name != nil {
+		modify.Name = *req.Name
+	}
+	if req.Date != nil {
+		modify.Date = *req.Date
+	}
+	if modify.Name == "" {
+		c.JSON(http.StatusBadRequest, structs.Error{Error: "Invalid request"})
+		return
+	}
+
+	// Apply the changes to the birthday
+	tags, err := modifyBirthday(birthday, modify)
+	if helper.HE(c, err, http.StatusBadRequest, "Invalid name, date format, event type, calendar or details", true) {
+		return
+	}
+
+	// Start a new transaction so the conflict check and the update see the same birthdays
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Check that the changes don't make it a copy of another birthday
+	conflict, err := birthdayConflicts(c, tx, birthday)
+	if err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to update birthday", false)
+		return
+	}
+	if conflict {
+		tx.Rollback() // Rollback the transaction on conflict
+		c.JSON(http.StatusConflict, structs.Error{Error: "Birthday already exists"})
+		return
+	}
+
+	// Perform the update within the transaction
+	if err = updateBirthday(c, tx, birthday, tags, oldValues, userData.ID); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.VersionHE(c, err, "Failed to update birthday")
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.Header("ETag", helper.ETag(birthday.Version))
+	c.JSON(http.StatusOK, helper.BirthdayFull(birthday))
+}
+
+// @Summary Delete a birthday
+// @Description This endpoint moves a birthday of the authenticated user to the trash, from where it can be restored until it's purged after the retention period. The request must include a valid JWT token.
+// @Param   id  path     int  true  "Birthday ID"
+// @Param   If-Match  header  string  false  "Only apply the change if the birthday still has this ETag"
+// @Success 204
+// @Failure 400 {object} structs.Error "Invalid birthday ID"
+// @Failure 403 {object} structs.Error "Not allowed to change this birthday"
+// @Failure 404 {object} structs.Error "Birthday not found"
+// @Failure 412 {object} structs.Error "Birthday was changed by another request"
+// @Failure 500 {object} structs.Error "Failed to delete birthday"
+// @Security Bearer
+// @Router /v2/birthdays/{id} [delete]
+// @Tags birthdays v2
+// @x-order 29
+func DeleteBirthdayV2(c *gin.Context) {
+	// Get the user data from the context
+	userData, err := auth.GetUserData(c)
+	if helper.HE(c, err, http.StatusInternalServerError, "Invalid encryption key or email", true) {
+		return
+	}
+
+	birthday, ok := findBirthday(c, userData.ID)
+	if !ok || !checkEditable(c, userData.ID, birthday) {
+		return
+	}
+
+	// Check that the birthday wasn't changed since the client read it
+	if helper.PreconditionFailed(c, helper.ETag(birthday.Version)) {
+		return
+	}
+
+	// Start a new transaction so the deletion is recorded in the history
+	tx, err := env.DB.Begin()
+	if err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to begin transaction", false)
+		return
+	}
+
+	// Move the birthday to the trash within the transaction
+	if err = trashBirthday(c, tx, birthday, userData.ID); err != nil {
+		tx.Rollback() // Rollback the transaction on error
+		helper.VersionHE(c, err, "Failed to delete birthday")
+		return
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(); err != nil {
+		helper.HE(c, err, http.StatusInternalServerError, "Failed to commit transaction", false)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
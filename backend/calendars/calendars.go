@@ -0,0 +1,160 @@
+package calendars
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Supported calendar systems
+const (
+	Gregorian = "gregorian"
+	Hebrew    = "hebrew"
+	Islamic   = "islamic"
+	Chinese   = "chinese"
+)
+
+// Names lists the supported calendar systems
+var Names = []string{Gregorian, Hebrew, Islamic, Chinese}
+
+// Date is a date in one of the supported calendar systems.
+//
+// Months are numbered as follows:
+//   - hebrew: 1 is Nisan and 7 is Tishrei, the first month of the year, 12 is Adar (Adar I in leap years) and 13 is Adar II
+//   - islamic: 1 is Muharram and 12 is Dhu al-Hijjah
+//   - chinese: 1 to 12, with Leap set for the leap month, which follows the regular month with the same number.
+//     The year is the Gregorian year in which the Chinese year starts.
+type Date struct {
+	Year  int
+	Month int
+	Day   int
+	Leap  bool
+}
+
+// Valid checks if the calendar system is supported
+func Valid(calendar string) bool {
+	for _, name := range Names {
+		if name == calendar {
+			return true
+		}
+	}
+	return false
+}
+
+// FromGregorian converts a Gregorian date to the given calendar system
+func FromGregorian(calendar string, date time.Time) (Date, error) {
+	fixed := fixedFromTime(date)
+	switch calendar {
+	case Gregorian:
+		return Date{Year: date.Year(), Month: int(date.Month()), Day: date.Day()}, nil
+	case Hebrew:
+		return hebrewFromFixed(fixed), nil
+	case Islamic:
+		return islamicFromFixed(fixed), nil
+	case Chinese:
+		return chineseFromFixed(fixed), nil
+	}
+	return Date{}, errors.New("invalid calendar")
+}
+
+// ToGregorian converts a date in the given calendar system to a Gregorian date
+func ToGregorian(calendar string, date Date) (time.Time, error) {
+	switch calendar {
+	case Gregorian:
+		return time.Date(date.Year, time.Month(date.Month), date.Day, 0, 0, 0, 0, time.UTC), nil
+	case Hebrew:
+		return timeFromFixed(fixedFromHebrew(date.Year, date.Month, date.Day)), nil
+	case Islamic:
+		return timeFromFixed(fixedFromIslamic(date.Year, date.Month, date.Day)), nil
+	case Chinese:
+		return timeFromFixed(fixedFromChinese(date.Year, date.Month, date.Leap, date.Day)), nil
+	}
+	return time.Time{}, errors.New("invalid calendar")
+}
+
+// Anniversary returns the Gregorian date on which the anniversary of the date falls in the given year of the calendar system.
+//
+// Dates that don't exist every year are moved as follows:
+//   - gregorian: February 29 is celebrated on February 28 in common years
+//   - hebrew: the last month of the year (Adar or Adar II) maps to the last month of the target year,
+//     and the 30th of a month with 29 days falls on the 1st of the next month
+//   - islamic: the 30th of a month with 29 days falls on the 1st of the next month
+//   - chinese: leap months are celebrated in the regular month of the same number when the target year has no such leap month,
+//     and the 30th of a month with 29 days is celebrated on the 29th
+func Anniversary(calendar string, date Date, year int) (time.Time, error) {
+	switch calendar {
+	case Gregorian:
+		day := date.Day
+		if date.Month == 2 && day == 29 && !isGregorianLeapYear(year) {
+			day = 28
+		}
+		return time.Date(year, time.Month(date.Month), day, 0, 0, 0, 0, time.UTC), nil
+	case Hebrew:
+		month := date.Month
+		if month == hebrewLastMonthOfYear(date.Year) {
+			month = hebrewLastMonthOfYear(year)
+		}
+		return timeFromFixed(fixedFromHebrew(year, month, 1) + date.Day - 1), nil
+	case Islamic:
+		return timeFromFixed(fixedFromIslamic(year, date.Month, 1) + date.Day - 1), nil
+	case Chinese:
+		start := fixedFromChinese(year, date.Month, date.Leap, 1)
+		if found := chineseFromFixed(start); found.Month != date.Month || found.Leap != date.Leap {
+			// The target year has no such leap month, the regular month is used instead
+			start = fixedFromChinese(year, date.Month, false, 1)
+		}
+		end := chineseNewMoonOnOrAfter(start + 1)
+		return timeFromFixed(min(start+date.Day-1, end-1)), nil
+	}
+	return time.Time{}, errors.New("invalid calendar")
+}
+
+// Format formats a date of the calendar system in a human readable way
+func Format(calendar string, date Date) string {
+	switch calendar {
+	case Hebrew:
+		name := hebrewMonthNames[date.Month-1]
+		if date.Month == 12 && hebrewLeapYear(date.Year) {
+			name = "Adar I"
+		}
+		return fmt.Sprintf("%d %s %d", date.Day, name, date.Year)
+	case Islamic:
+		return fmt.Sprintf("%d %s %d", date.Day, islamicMonthNames[date.Month-1], date.Year)
+	case Chinese:
+		leap := ""
+		if date.Leap {
+			leap = "leap "
+		}
+		return fmt.Sprintf("Day %d of %smonth %d, %d", date.Day, leap, date.Month, date.Year)
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", date.Year, date.Month, date.Day)
+}
+
+// Fixed dates count the days since January 1st of year 1 of the proleptic Gregorian calendar, which is day 1
+
+// Fixed date of the Unix epoch (1970-01-01)
+const unixEpoch = 719163
+
+func fixedFromTime(date time.Time) int {
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	return int(date.Unix()/86400) + unixEpoch
+}
+
+func timeFromFixed(fixed int) time.Time {
+	return time.Unix(int64(fixed-unixEpoch)*86400, 0).UTC()
+}
+
+func isGregorianLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// floorDiv divides rounding towards negative infinity
+func floorDiv(a, b int) int {
+	return int(math.Floor(float64(a) / float64(b)))
+}
+
+// mod returns the remainder with the sign of the divisor
+func mod(a, b int) int {
+	return a - b*floorDiv(a, b)
+}
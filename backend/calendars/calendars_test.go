@@ -0,0 +1,138 @@
+package calendars
+
+import (
+	"testing"
+	"time"
+)
+
+func gregorian(str string) time.Time {
+	date, err := time.Parse("2006-01-02", str)
+	if err != nil {
+		panic(err)
+	}
+	return date
+}
+
+// Conversions checked against published tables:
+//   - hebrew: dates of Rosh Hashanah, Hanukkah, Purim and Passover from the Jewish calendar tables (hebcal.com)
+//   - islamic: dates from the Umm al-Qura calendar on which the tabular calendar agrees with it, and the epoch
+//   - chinese: Chinese New Year, leap months and festivals from the Hong Kong Observatory's Gregorian-lunar calendar conversion tables
+var conversionVectors = []struct {
+	calendar  string
+	gregorian string
+	date      Date
+}{
+	{Hebrew, "2019-09-30", Date{Year: 5780, Month: 7, Day: 1}},
+	{Hebrew, "2022-09-26", Date{Year: 5783, Month: 7, Day: 1}},
+	{Hebrew, "2023-09-16", Date{Year: 5784, Month: 7, Day: 1}},
+	{Hebrew, "2024-10-03", Date{Year: 5785, Month: 7, Day: 1}},
+	{Hebrew, "2025-09-23", Date{Year: 5786, Month: 7, Day: 1}},
+	{Hebrew, "2024-10-12", Date{Year: 5785, Month: 7, Day: 10}},
+	{Hebrew, "2023-12-08", Date{Year: 5784, Month: 9, Day: 25}},
+	{Hebrew, "2024-12-26", Date{Year: 5785, Month: 9, Day: 25}},
+	{Hebrew, "2024-03-24", Date{Year: 5784, Month: 13, Day: 14}},
+	{Hebrew, "2025-03-14", Date{Year: 5785, Month: 12, Day: 14}},
+	{Hebrew, "2023-04-06", Date{Year: 5783, Month: 1, Day: 15}},
+	{Hebrew, "2024-04-23", Date{Year: 5784, Month: 1, Day: 15}},
+	{Hebrew, "2025-04-13", Date{Year: 5785, Month: 1, Day: 15}},
+
+	{Islamic, "0622-07-19", Date{Year: 1, Month: 1, Day: 1}},
+	{Islamic, "1979-11-21", Date{Year: 1400, Month: 1, Day: 1}},
+	{Islamic, "2022-07-30", Date{Year: 1444, Month: 1, Day: 1}},
+	{Islamic, "2023-03-23", Date{Year: 1444, Month: 9, Day: 1}},
+	{Islamic, "2023-07-19", Date{Year: 1445, Month: 1, Day: 1}},
+	{Islamic, "2024-03-11", Date{Year: 1445, Month: 9, Day: 1}},
+	{Islamic, "2025-03-01", Date{Year: 1446, Month: 9, Day: 1}},
+
+	{Chinese, "1900-01-31", Date{Year: 1900, Month: 1, Day: 1}},
+	{Chinese, "1912-02-18", Date{Year: 1912, Month: 1, Day: 1}},
+	{Chinese, "1949-01-29", Date{Year: 1949, Month: 1, Day: 1}},
+	{Chinese, "1984-02-02", Date{Year: 1984, Month: 1, Day: 1}},
+	{Chinese, "1984-11-23", Date{Year: 1984, Month: 10, Day: 1, Leap: true}},
+	{Chinese, "1990-01-27", Date{Year: 1990, Month: 1, Day: 1}},
+	{Chinese, "2000-02-05", Date{Year: 2000, Month: 1, Day: 1}},
+	{Chinese, "2001-05-23", Date{Year: 2001, Month: 4, Day: 1, Leap: true}},
+	{Chinese, "2014-10-24", Date{Year: 2014, Month: 9, Day: 1, Leap: true}},
+	{Chinese, "2017-07-23", Date{Year: 2017, Month: 6, Day: 1, Leap: true}},
+	{Chinese, "2020-01-25", Date{Year: 2020, Month: 1, Day: 1}},
+	{Chinese, "2020-05-23", Date{Year: 2020, Month: 4, Day: 1, Leap: true}},
+	{Chinese, "2020-10-01", Date{Year: 2020, Month: 8, Day: 15}},
+	{Chinese, "2023-01-22", Date{Year: 2023, Month: 1, Day: 1}},
+	{Chinese, "2023-03-22", Date{Year: 2023, Month: 2, Day: 1, Leap: true}},
+	{Chinese, "2023-06-22", Date{Year: 2023, Month: 5, Day: 5}},
+	{Chinese, "2024-02-10", Date{Year: 2024, Month: 1, Day: 1}},
+	{Chinese, "2024-06-10", Date{Year: 2024, Month: 5, Day: 5}},
+	{Chinese, "2024-09-17", Date{Year: 2024, Month: 8, Day: 15}},
+	{Chinese, "2025-01-29", Date{Year: 2025, Month: 1, Day: 1}},
+	{Chinese, "2025-07-25", Date{Year: 2025, Month: 6, Day: 1, Leap: true}},
+	{Chinese, "2025-10-06", Date{Year: 2025, Month: 8, Day: 15}},
+	{Chinese, "2026-02-17", Date{Year: 2026, Month: 1, Day: 1}},
+	{Chinese, "2033-12-22", Date{Year: 2033, Month: 11, Day: 1, Leap: true}},
+}
+
+func TestFromGregorian(t *testing.T) {
+	for _, v := range conversionVectors {
+		got, err := FromGregorian(v.calendar, gregorian(v.gregorian))
+		if err != nil {
+			t.Fatalf("%s %s: unexpected error: %v", v.calendar, v.gregorian, err)
+		}
+		if got != v.date {
+			t.Errorf("%s %s: got %+v, want %+v", v.calendar, v.gregorian, got, v.date)
+		}
+	}
+}
+
+func TestToGregorian(t *testing.T) {
+	for _, v := range conversionVectors {
+		got, err := ToGregorian(v.calendar, v.date)
+		if err != nil {
+			t.Fatalf("%s %+v: unexpected error: %v", v.calendar, v.date, err)
+		}
+		if want := gregorian(v.gregorian); !got.Equal(want) {
+			t.Errorf("%s %+v: got %s, want %s", v.calendar, v.date, got.Format("2006-01-02"), v.gregorian)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, calendar := range []string{Hebrew, Islamic, Chinese} {
+		for day := gregorian("2023-01-01"); day.Year() < 2025; day = day.AddDate(0, 0, 3) {
+			date, _ := FromGregorian(calendar, day)
+			back, _ := ToGregorian(calendar, date)
+			if !back.Equal(day) {
+				t.Errorf("%s %s: converted to %+v and back to %s", calendar, day.Format("2006-01-02"), date, back.Format("2006-01-02"))
+			}
+		}
+	}
+}
+
+func TestAnniversary(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar string
+		date     Date
+		year     int
+		want     string
+	}{
+		{"leap day in common year", Gregorian, Date{Year: 2000, Month: 2, Day: 29}, 2023, "2023-02-28"},
+		{"leap day in leap year", Gregorian, Date{Year: 2000, Month: 2, Day: 29}, 2024, "2024-02-29"},
+		{"adar of common year in leap year", Hebrew, Date{Year: 5785, Month: 12, Day: 14}, 5784, "2024-03-24"},
+		{"adar ii of leap year in common year", Hebrew, Date{Year: 5784, Month: 13, Day: 14}, 5785, "2025-03-14"},
+		{"30 kislev in short kislev", Hebrew, Date{Year: 5783, Month: 9, Day: 30}, 5784, "2023-12-13"},
+		{"ramadan", Islamic, Date{Year: 1400, Month: 9, Day: 1}, 1445, "2024-03-11"},
+		{"mid-autumn", Chinese, Date{Year: 1990, Month: 8, Day: 15}, 2024, "2024-09-17"},
+		{"leap month without leap", Chinese, Date{Year: 2020, Month: 4, Day: 1, Leap: true}, 2024, "2024-05-08"},
+		{"leap month with leap", Chinese, Date{Year: 2001, Month: 4, Day: 1, Leap: true}, 2020, "2020-05-23"},
+		{"day 30 in short month", Chinese, Date{Year: 2024, Month: 1, Day: 30}, 2023, "2023-02-19"},
+	}
+
+	for _, tt := range tests {
+		got, err := Anniversary(tt.calendar, tt.date, tt.year)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if want := gregorian(tt.want); !got.Equal(want) {
+			t.Errorf("%s: got %s, want %s", tt.name, got.Format("2006-01-02"), tt.want)
+		}
+	}
+}
@@ -0,0 +1,365 @@
+package calendars
+
+import (
+	"math"
+	"time"
+)
+
+// Chinese lunisolar calendar, following the astronomical rules of the 1645 reform as described in
+// "Calendrical Calculations" by Reingold and Dershowitz. Months start on the day of the new moon in Beijing,
+// and the leap month is the first month of a leap year without a major solar term.
+// New moons are computed with the algorithm of Jean Meeus' "Astronomical Algorithms" (chapter 49).
+//
+// Moments are fractional fixed dates in universal time.
+
+const (
+	meanSynodicMonth  = 29.530588861
+	meanTropicalYear  = 365.242189
+	julianDayToFixed  = 1721424.5
+	j2000             = 730120.5 // Noon of January 1, 2000
+	firstNewMoonOf2k  = 2451550.09766 - julianDayToFixed
+	degreesToRadians  = math.Pi / 180
+	secondsPerDay     = 86400.0
+	chinaZoneBefore29 = 1397.0 / 180.0 / 24.0 // Beijing local mean time (116°25' E) before 1929
+	chinaZone         = 8.0 / 24.0
+)
+
+// chinaOffset returns the offset of Beijing time from universal time, in days
+func chinaOffset(fixed float64) float64 {
+	if timeFromFixed(int(math.Floor(fixed))).Year() < 1929 {
+		return chinaZoneBefore29
+	}
+	return chinaZone
+}
+
+// midnightInChina returns the moment of the start of the day in Beijing
+func midnightInChina(fixed int) float64 {
+	return float64(fixed) - chinaOffset(float64(fixed))
+}
+
+// chinaDate returns the day in Beijing of the moment
+func chinaDate(moment float64) int {
+	return int(math.Floor(moment + chinaOffset(moment)))
+}
+
+// ephemerisCorrection returns the difference between dynamical and universal time (ΔT) in days,
+// using the polynomial expressions by Espenak and Meeus
+func ephemerisCorrection(moment float64) float64 {
+	date := timeFromFixed(int(math.Floor(moment)))
+	y := float64(date.Year()) + (float64(date.YearDay())-0.5)/365.25
+	var seconds float64
+	switch {
+	case y < 1860:
+		u := (y - 1820) / 100
+		seconds = -20 + 32*u*u
+	case y < 1900:
+		t := y - 1860
+		seconds = 7.62 + 0.5737*t - 0.251754*t*t + 0.01680668*t*t*t - 0.0004473624*math.Pow(t, 4) + math.Pow(t, 5)/233174
+	case y < 1920:
+		t := y - 1900
+		seconds = -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*math.Pow(t, 4)
+	case y < 1941:
+		t := y - 1920
+		seconds = 21.20 + 0.84493*t - 0.076100*t*t + 0.0020936*t*t*t
+	case y < 1961:
+		t := y - 1950
+		seconds = 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case y < 1986:
+		t := y - 1975
+		seconds = 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case y < 2005:
+		t := y - 2000
+		seconds = 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*math.Pow(t, 4) + 0.00002373599*math.Pow(t, 5)
+	case y < 2050:
+		t := y - 2000
+		seconds = 62.92 + 0.32217*t + 0.005589*t*t
+	case y < 2150:
+		u := (y - 1820) / 100
+		seconds = -20 + 32*u*u - 0.5628*(2150-y)
+	default:
+		u := (y - 1820) / 100
+		seconds = -20 + 32*u*u
+	}
+	return seconds / secondsPerDay
+}
+
+// julianCenturies returns the dynamical time elapsed since J2000, in centuries
+func julianCenturies(moment float64) float64 {
+	return (moment + ephemerisCorrection(moment) - j2000) / 36525
+}
+
+func sinDegrees(degrees float64) float64 {
+	return math.Sin(degrees * degreesToRadians)
+}
+
+func cosDegrees(degrees float64) float64 {
+	return math.Cos(degrees * degreesToRadians)
+}
+
+func modDegrees(degrees float64) float64 {
+	return degrees - 360*math.Floor(degrees/360)
+}
+
+// Periodic terms of the solar longitude
+var solarLongitudeTerms = [][3]float64{
+	{403406, 270.54861, 0.9287892},
+	{195207, 340.19128, 35999.1376958},
+	{119433, 63.91854, 35999.4089666},
+	{112392, 331.26220, 35998.7287385},
+	{3891, 317.843, 71998.20261},
+	{2819, 86.631, 71998.4403},
+	{1721, 240.052, 36000.35726},
+	{660, 310.26, 71997.4812},
+	{350, 247.23, 32964.4678},
+	{334, 260.87, -19.4410},
+	{314, 297.82, 445267.1117},
+	{268, 343.14, 45036.8840},
+	{242, 166.79, 3.1008},
+	{234, 81.53, 22518.4434},
+	{158, 3.50, -19.9739},
+	{132, 132.75, 65928.9345},
+	{129, 182.95, 9038.0293},
+	{114, 162.03, 3034.7684},
+	{99, 29.8, 33718.148},
+	{93, 266.4, 3034.448},
+	{86, 249.2, -2280.773},
+	{78, 157.6, 29929.992},
+	{72, 257.8, 31556.493},
+	{68, 185.1, 149.588},
+	{64, 69.9, 9037.750},
+	{46, 8.0, 107997.405},
+	{38, 197.1, -4444.176},
+	{37, 250.4, 151.771},
+	{32, 65.3, 67555.316},
+	{29, 162.7, 31556.080},
+	{28, 341.5, -4561.540},
+	{27, 291.6, 107996.706},
+	{27, 98.5, 1221.655},
+	{25, 146.7, 62894.167},
+	{24, 110.0, 31437.369},
+	{21, 5.2, 14578.298},
+	{21, 342.6, -31931.757},
+	{20, 230.9, 34777.243},
+	{18, 256.1, 1221.999},
+	{17, 45.3, 62894.511},
+	{14, 242.9, -4442.039},
+	{13, 115.2, 107997.909},
+	{13, 151.8, 119.066},
+	{13, 285.3, 16859.071},
+	{12, 53.3, -4.578},
+	{10, 126.6, 26895.292},
+	{10, 205.7, -39.127},
+	{10, 85.9, 12297.536},
+	{10, 146.1, 90073.778},
+}
+
+// solarLongitude returns the apparent longitude of the sun at the moment, in degrees
+func solarLongitude(moment float64) float64 {
+	c := julianCenturies(moment)
+	var sum float64
+	for _, term := range solarLongitudeTerms {
+		sum += term[0] * sinDegrees(term[1]+term[2]*c)
+	}
+	longitude := 282.7771834 + 36000.76953744*c + 0.000005729577951308232*sum
+
+	aberration := 0.0000974*cosDegrees(177.63+35999.01848*c) - 0.005575
+	nutation := -0.004778*sinDegrees(124.90-1934.134*c+0.002063*c*c) - 0.0003667*sinDegrees(201.11+72001.5377*c+0.00057*c*c)
+
+	return modDegrees(longitude + aberration + nutation)
+}
+
+// solarLongitudeAfter returns the first moment after the given one when the sun reaches the longitude
+func solarLongitudeAfter(longitude float64, moment float64) float64 {
+	rate := meanTropicalYear / 360
+	tau := moment + rate*modDegrees(longitude-solarLongitude(moment))
+	low, high := math.Max(moment, tau-5), tau+5
+	for high-low > 1e-6 {
+		mid := (low + high) / 2
+		if modDegrees(solarLongitude(mid)-longitude) < 180 {
+			high = mid
+		} else {
+			low = mid
+		}
+	}
+	return (low + high) / 2
+}
+
+// nthNewMoon returns the moment of the n-th new moon after (or before, if negative) the one of January 6, 2000
+func nthNewMoon(k int) float64 {
+	n := float64(k)
+	t := n / 1236.85
+	jde := firstNewMoonOf2k + meanSynodicMonth*n + 0.00015437*t*t - 0.000000150*t*t*t + 0.00000000073*t*t*t*t
+
+	e := 1 - 0.002516*t - 0.0000074*t*t
+	m := 2.5534 + 29.10535670*n - 0.0000014*t*t - 0.00000011*t*t*t
+	mPrime := 201.5643 + 385.81693528*n + 0.0107582*t*t + 0.00001238*t*t*t - 0.000000058*t*t*t*t
+	f := 160.7108 + 390.67050284*n - 0.0016118*t*t - 0.00000227*t*t*t + 0.000000011*t*t*t*t
+	omega := 124.7746 - 1.56375588*n + 0.0020672*t*t + 0.00000215*t*t*t
+
+	correction := -0.40720*sinDegrees(mPrime) +
+		0.17241*e*sinDegrees(m) +
+		0.01608*sinDegrees(2*mPrime) +
+		0.01039*sinDegrees(2*f) +
+		0.00739*e*sinDegrees(mPrime-m) -
+		0.00514*e*sinDegrees(mPrime+m) +
+		0.00208*e*e*sinDegrees(2*m) -
+		0.00111*sinDegrees(mPrime-2*f) -
+		0.00057*sinDegrees(mPrime+2*f) +
+		0.00056*e*sinDegrees(2*mPrime+m) -
+		0.00042*sinDegrees(3*mPrime) +
+		0.00042*e*sinDegrees(m+2*f) +
+		0.00038*e*sinDegrees(m-2*f) -
+		0.00024*e*sinDegrees(2*mPrime-m) -
+		0.00017*sinDegrees(omega) -
+		0.00007*sinDegrees(mPrime+2*m) +
+		0.00004*sinDegrees(2*mPrime-2*f) +
+		0.00004*sinDegrees(3*m) +
+		0.00003*sinDegrees(mPrime+m-2*f) +
+		0.00003*sinDegrees(2*mPrime+2*f) -
+		0.00003*sinDegrees(mPrime+m+2*f) +
+		0.00003*sinDegrees(mPrime-m+2*f) -
+		0.00002*sinDegrees(mPrime-m-2*f) -
+		0.00002*sinDegrees(3*mPrime+m) +
+		0.00002*sinDegrees(4*mPrime)
+
+	additional := 0.000325*sinDegrees(299.77+0.107408*n-0.009173*t*t) +
+		0.000165*sinDegrees(251.88+0.016321*n) +
+		0.000164*sinDegrees(251.83+26.651886*n) +
+		0.000126*sinDegrees(349.42+36.412478*n) +
+		0.000110*sinDegrees(84.66+18.206239*n) +
+		0.000062*sinDegrees(141.74+53.303771*n) +
+		0.000060*sinDegrees(207.14+2.453732*n) +
+		0.000056*sinDegrees(154.84+7.306860*n) +
+		0.000047*sinDegrees(34.52+27.261239*n) +
+		0.000042*sinDegrees(207.19+0.121824*n) +
+		0.000040*sinDegrees(291.34+1.844379*n) +
+		0.000037*sinDegrees(161.72+24.198154*n) +
+		0.000035*sinDegrees(239.56+25.513099*n) +
+		0.000023*sinDegrees(331.55+3.592518*n)
+
+	dynamical := jde + correction + additional
+	return dynamical - ephemerisCorrection(dynamical)
+}
+
+// newMoonAtOrAfter returns the moment of the first new moon at or after the given moment
+func newMoonAtOrAfter(moment float64) float64 {
+	k := int(math.Round((moment - firstNewMoonOf2k) / meanSynodicMonth))
+	for nthNewMoon(k) < moment {
+		k++
+	}
+	for nthNewMoon(k-1) >= moment {
+		k--
+	}
+	return nthNewMoon(k)
+}
+
+// newMoonBefore returns the moment of the last new moon before the given moment
+func newMoonBefore(moment float64) float64 {
+	k := int(math.Round((moment - firstNewMoonOf2k) / meanSynodicMonth))
+	for nthNewMoon(k) >= moment {
+		k--
+	}
+	for nthNewMoon(k+1) < moment {
+		k++
+	}
+	return nthNewMoon(k)
+}
+
+// chineseNewMoonOnOrAfter returns the first day in Beijing on or after the given one with a new moon
+func chineseNewMoonOnOrAfter(fixed int) int {
+	return chinaDate(newMoonAtOrAfter(midnightInChina(fixed)))
+}
+
+// chineseNewMoonBefore returns the last day in Beijing before the given one with a new moon
+func chineseNewMoonBefore(fixed int) int {
+	return chinaDate(newMoonBefore(midnightInChina(fixed)))
+}
+
+// chineseWinterSolsticeOnOrBefore returns the day in Beijing of the last winter solstice on or before the given day
+func chineseWinterSolsticeOnOrBefore(fixed int) int {
+	solstice := solarLongitudeAfter(270, midnightInChina(fixed+1)-370)
+	for {
+		next := solarLongitudeAfter(270, solstice+1)
+		if chinaDate(next) > fixed {
+			return chinaDate(solstice)
+		}
+		solstice = next
+	}
+}
+
+// currentMajorSolarTerm returns the last major solar term (zhongqi) at the start of the day, numbered 1 to 12
+func currentMajorSolarTerm(fixed int) int {
+	longitude := solarLongitude(midnightInChina(fixed))
+	return mod(2+int(math.Floor(longitude/30))-1, 12) + 1
+}
+
+// chineseNoMajorSolarTerm checks if the lunar month starting on the day has no major solar term
+func chineseNoMajorSolarTerm(fixed int) bool {
+	return currentMajorSolarTerm(fixed) == currentMajorSolarTerm(chineseNewMoonOnOrAfter(fixed+1))
+}
+
+// chinesePriorLeapMonth checks if there's a leap month between the months starting on mPrime and m
+func chinesePriorLeapMonth(mPrime, m int) bool {
+	for m >= mPrime {
+		if chineseNoMajorSolarTerm(m) {
+			return true
+		}
+		m = chineseNewMoonBefore(m)
+	}
+	return false
+}
+
+// chineseNewYearInSui returns the first day of the Chinese year that starts in the solar year (sui) containing the day
+func chineseNewYearInSui(fixed int) int {
+	s1 := chineseWinterSolsticeOnOrBefore(fixed)
+	s2 := chineseWinterSolsticeOnOrBefore(s1 + 370)
+	m12 := chineseNewMoonOnOrAfter(s1 + 1)
+	m13 := chineseNewMoonOnOrAfter(m12 + 1)
+	nextM11 := chineseNewMoonBefore(s2 + 1)
+	if math.Round(float64(nextM11-m12)/meanSynodicMonth) == 12 && (chineseNoMajorSolarTerm(m12) || chineseNoMajorSolarTerm(m13)) {
+		return chineseNewMoonOnOrAfter(m13 + 1)
+	}
+	return m13
+}
+
+// chineseNewYearOnOrBefore returns the first day of the Chinese year containing the day
+func chineseNewYearOnOrBefore(fixed int) int {
+	newYear := chineseNewYearInSui(fixed)
+	if fixed >= newYear {
+		return newYear
+	}
+	return chineseNewYearInSui(fixed - 180)
+}
+
+func chineseFromFixed(fixed int) Date {
+	s1 := chineseWinterSolsticeOnOrBefore(fixed)
+	s2 := chineseWinterSolsticeOnOrBefore(s1 + 370)
+	m12 := chineseNewMoonOnOrAfter(s1 + 1)
+	nextM11 := chineseNewMoonBefore(s2 + 1)
+	m := chineseNewMoonBefore(fixed + 1)
+	leapYear := math.Round(float64(nextM11-m12)/meanSynodicMonth) == 12
+
+	month := int(math.Round(float64(m-m12) / meanSynodicMonth))
+	if leapYear && chinesePriorLeapMonth(m12, m) {
+		month--
+	}
+	month = mod(month-1, 12) + 1
+	leapMonth := leapYear && chineseNoMajorSolarTerm(m) && !chinesePriorLeapMonth(m12, chineseNewMoonBefore(m))
+
+	year := timeFromFixed(chineseNewYearOnOrBefore(fixed)).Year()
+
+	return Date{Year: year, Month: month, Day: fixed - m + 1, Leap: leapMonth}
+}
+
+// fixedFromChinese returns the day of the Chinese date
+func fixedFromChinese(year, month int, leap bool, day int) int {
+	// The Chinese year always starts before July 1st of the Gregorian year
+	newYear := chineseNewYearOnOrBefore(fixedFromTime(time.Date(year, time.July, 1, 0, 0, 0, 0, time.UTC)))
+	p := chineseNewMoonOnOrAfter(newYear + (month-1)*29)
+	d := chineseFromFixed(p)
+	priorNewMoon := p
+	if month != d.Month || leap != d.Leap {
+		priorNewMoon = chineseNewMoonOnOrAfter(p + 1)
+	}
+	return priorNewMoon + day - 1
+}
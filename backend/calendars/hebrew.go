@@ -0,0 +1,104 @@
+package calendars
+
+// Arithmetic Hebrew calendar, following "Calendrical Calculations" by Reingold and Dershowitz
+
+var hebrewMonthNames = []string{"Nisan", "Iyyar", "Sivan", "Tammuz", "Av", "Elul", "Tishrei", "Heshvan", "Kislev", "Tevet", "Shevat", "Adar", "Adar II"}
+
+// Fixed date of 1 Tishrei of year 1 (October 7, 3761 BCE in the Julian calendar)
+const hebrewEpoch = -1373427
+
+func hebrewLeapYear(year int) bool {
+	return mod(7*year+1, 19) < 7
+}
+
+func hebrewLastMonthOfYear(year int) int {
+	if hebrewLeapYear(year) {
+		return 13
+	}
+	return 12
+}
+
+// hebrewCalendarElapsedDays returns the days elapsed from the epoch to the molad of Tishrei of the year, with the first postponement rule
+func hebrewCalendarElapsedDays(year int) int {
+	monthsElapsed := floorDiv(235*year-234, 19)
+	partsElapsed := 12084 + 13753*monthsElapsed
+	days := 29*monthsElapsed + floorDiv(partsElapsed, 25920)
+	if mod(3*(days+1), 7) < 3 {
+		return days + 1
+	}
+	return days
+}
+
+// hebrewYearLengthCorrection applies the postponement rules that keep years within their allowed lengths
+func hebrewYearLengthCorrection(year int) int {
+	ny0 := hebrewCalendarElapsedDays(year - 1)
+	ny1 := hebrewCalendarElapsedDays(year)
+	ny2 := hebrewCalendarElapsedDays(year + 1)
+	switch {
+	case ny2-ny1 == 356:
+		return 2
+	case ny1-ny0 == 382:
+		return 1
+	}
+	return 0
+}
+
+func hebrewNewYear(year int) int {
+	return hebrewEpoch + hebrewCalendarElapsedDays(year) + hebrewYearLengthCorrection(year)
+}
+
+func hebrewDaysInYear(year int) int {
+	return hebrewNewYear(year+1) - hebrewNewYear(year)
+}
+
+func hebrewLastDayOfMonth(year, month int) int {
+	days := hebrewDaysInYear(year)
+	switch {
+	case month == 2 || month == 4 || month == 6 || month == 10 || month == 13:
+		return 29
+	case month == 12 && !hebrewLeapYear(year):
+		return 29
+	case month == 8 && days%10 != 5: // Heshvan is long only in complete years (355 or 385 days)
+		return 29
+	case month == 9 && days%10 == 3: // Kislev is short only in deficient years (353 or 383 days)
+		return 29
+	}
+	return 30
+}
+
+func fixedFromHebrew(year, month, day int) int {
+	fixed := hebrewNewYear(year) + day - 1
+	if month < 7 {
+		for m := 7; m <= hebrewLastMonthOfYear(year); m++ {
+			fixed += hebrewLastDayOfMonth(year, m)
+		}
+		for m := 1; m < month; m++ {
+			fixed += hebrewLastDayOfMonth(year, m)
+		}
+	} else {
+		for m := 7; m < month; m++ {
+			fixed += hebrewLastDayOfMonth(year, m)
+		}
+	}
+	return fixed
+}
+
+func hebrewFromFixed(fixed int) Date {
+	// Approximate the year with the average year length and correct it
+	approx := int(float64(fixed-hebrewEpoch)/(35975351.0/98496.0)) + 1
+	year := approx - 1
+	for hebrewNewYear(year+1) <= fixed {
+		year++
+	}
+
+	// Months before Nisan belong to the start of the year
+	month := 1
+	if fixed < fixedFromHebrew(year, 1, 1) {
+		month = 7
+	}
+	for fixed > fixedFromHebrew(year, month, hebrewLastDayOfMonth(year, month)) {
+		month++
+	}
+
+	return Date{Year: year, Month: month, Day: fixed - fixedFromHebrew(year, month, 1) + 1}
+}
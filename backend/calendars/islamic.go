@@ -0,0 +1,21 @@
+package calendars
+
+// Arithmetic (tabular) Islamic calendar, following "Calendrical Calculations" by Reingold and Dershowitz.
+// Observational calendars, such as Umm al-Qura, may differ from it by a day or two.
+
+var islamicMonthNames = []string{"Muharram", "Safar", "Rabi' al-Awwal", "Rabi' al-Thani", "Jumada al-Awwal", "Jumada al-Thani", "Rajab", "Sha'ban", "Ramadan", "Shawwal", "Dhu al-Qi'dah", "Dhu al-Hijjah"}
+
+// Fixed date of 1 Muharram of year 1 (July 16, 622 in the Julian calendar)
+const islamicEpoch = 227015
+
+func fixedFromIslamic(year, month, day int) int {
+	return day + 29*(month-1) + floorDiv(6*month-1, 11) + (year-1)*354 + floorDiv(3+11*year, 30) + islamicEpoch - 1
+}
+
+func islamicFromFixed(fixed int) Date {
+	year := floorDiv(30*(fixed-islamicEpoch)+10646, 10631)
+	priorDays := fixed - fixedFromIslamic(year, 1, 1)
+	month := floorDiv(11*priorDays+330, 325)
+	day := fixed - fixedFromIslamic(year, month, 1) + 1
+	return Date{Year: year, Month: month, Day: day}
+}
@@ -0,0 +1,55 @@
+// Package dbtest sets up a SQLite database for the tests of the packages that use env.DB.
+//
+// env opens the database when it's initialized, so the variables it reads are set in the init function of this
+// package. Packages are initialized in import path order when they don't depend on each other, hbd/db/dbtest sorts
+// before hbd/env and only imports packages that env imports too, so importing it from a test file is enough for
+// env.DB to be the test database. Keep it that way when changing the imports.
+package dbtest
+
+import (
+	"database/sql"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+)
+
+// Directory of the database file, removed by Run when the tests finish
+var dir string
+
+func init() {
+	var err error
+	if dir, err = os.MkdirTemp("", "hbd-test-"); err != nil {
+		log.Fatal(err)
+	}
+	os.Setenv("ENVIRONMENT", "test")
+	os.Setenv("DB_TYPE", "sqlite")
+	os.Setenv("DATABASE_URL", filepath.Join(dir, "hbd.db"))
+	os.Setenv("MASTER_KEY", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+}
+
+// Run applies the SQLite migrations to the database, runs the tests and removes the database. It's meant to be
+// called from TestMain with env.DB: os.Exit(dbtest.Run(env.DB, m.Run))
+func Run(db *sql.DB, run func() int) int {
+	defer os.RemoveAll(dir)
+
+	// The migrations are found relative to this file since tests run in the directory of their package
+	_, file, _, _ := runtime.Caller(0)
+	migrations, err := filepath.Glob(filepath.Join(filepath.Dir(file), "..", "..", "migrations", "sqlite", "*.up.sql"))
+	if err != nil || len(migrations) == 0 {
+		log.Fatal("Could not find the migrations")
+	}
+	sort.Strings(migrations)
+	for _, migration := range migrations {
+		content, err := os.ReadFile(migration)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if _, err = db.Exec(string(content)); err != nil {
+			log.Fatalf("Could not apply %s: %v", filepath.Base(migration), err)
+		}
+	}
+
+	return run()
+}
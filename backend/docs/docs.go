@@ -15,14 +15,35 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/add-birthday": {
+        "/.well-known/jwks.json": {
+            "get": {
+                "description": "This endpoint publishes the public keys of the Ed25519 keys that sign the access tokens as a JSON Web Key Set, so other services can verify the tokens. The kid header of a token is the ID of the key that signed it. HS256 keys are secret and never published.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Get the token verification keys",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.JWKS"
+                        }
+                    }
+                },
+                "x-order": 71
+            }
+        },
+        "/accept-invitation": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "This endpoint adds a new birthday for the authenticated user. The request must include a valid JWT token.",
+                "description": "This endpoint accepts an invitation to a shared list or an organization with its token, or with its ID if it was sent to the email of the authenticated user, who joins with the role of the invitation. Users that are already members keep their role. Each invitation can only be accepted once. The request must include a valid JWT token.",
                 "consumes": [
                     "application/json"
                 ],
@@ -30,17 +51,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "birthdays"
+                    "invitations"
                 ],
-                "summary": "Add a new birthday",
+                "summary": "Accept an invitation",
                 "parameters": [
                     {
-                        "description": "Add birthday",
-                        "name": "birthday",
+                        "description": "Accept invitation",
+                        "name": "invitation",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/structs.BirthdayNameDateAdd"
+                            "$ref": "#/definitions/structs.InvitationAccept"
                         }
                     }
                 ],
@@ -48,33 +69,45 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/structs.BirthdayFull"
+                            "$ref": "#/definitions/structs.InvitationFull"
                         }
                     },
                     "400": {
-                        "description": "Invalid request or date format",
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "This invitation was sent to another email",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Invitation not found, it may have expired or been used already",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     },
                     "500": {
-                        "description": "Failed to insert birthday",
+                        "description": "Failed to accept invitation",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     }
                 },
-                "x-order": 7
+                "x-order": 64
             }
         },
-        "/check-birthdays": {
+        "/add-api-token": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "This endpoint checks for user reminders through a POST request. The request must include a valid JWT token.",
+                "description": "This endpoint creates a personal API token for the CLI and automations, which is used as a bearer token like the JWT tokens but can only access the routes of its scopes: birthdays:read, birthdays:write (which includes birthdays:read) or account:admin (which includes every scope). The token is only returned in this response, and it never expires unless it's given an expiry time. A request made with an API token can only grant the scopes it has. The request must include a valid JWT token.",
                 "consumes": [
                     "application/json"
                 ],
@@ -82,17 +115,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "reminders"
+                    "auth"
                 ],
-                "summary": "Check user reminders",
+                "summary": "Create a personal API token",
                 "parameters": [
                     {
-                        "description": "Check reminders",
-                        "name": "user",
+                        "description": "API token",
+                        "name": "token",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/structs.LoginRequest"
+                            "$ref": "#/definitions/structs.APITokenAdd"
                         }
                     }
                 ],
@@ -100,7 +133,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/structs.Success"
+                            "$ref": "#/definitions/structs.APITokenFull"
                         }
                     },
                     "400": {
@@ -109,24 +142,30 @@ const docTemplate = `{
                             "$ref": "#/definitions/structs.Error"
                         }
                     },
+                    "401": {
+                        "description": "Invalid email",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
                     "500": {
-                        "description": "Error querying users",
+                        "description": "Failed to insert API token",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     }
                 },
-                "x-order": 6
+                "x-order": 72
             }
         },
-        "/delete-birthday": {
-            "delete": {
+        "/add-birthday": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "This endpoint deletes a birthday for the authenticated user. The request must include a valid JWT token.",
+                "description": "This endpoint adds a new birthday for the authenticated user, or to one of their shared lists they can edit. The request must include a valid JWT token.",
                 "consumes": [
                     "application/json"
                 ],
@@ -136,15 +175,15 @@ const docTemplate = `{
                 "tags": [
                     "birthdays"
                 ],
-                "summary": "Delete a birthday",
+                "summary": "Add a new birthday",
                 "parameters": [
                     {
-                        "description": "Delete birthday",
+                        "description": "Add birthday",
                         "name": "birthday",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/structs.BirthdayNameDateModify"
+                            "$ref": "#/definitions/structs.BirthdayNameDateAdd"
                         }
                     }
                 ],
@@ -152,33 +191,45 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/structs.Success"
+                            "$ref": "#/definitions/structs.BirthdayFull"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the birthday"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid request or date format",
+                        "description": "Invalid request, name, date format, event type, calendar or details",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Not allowed to add birthdays to this list",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     },
                     "500": {
-                        "description": "Failed to delete birthday",
+                        "description": "Failed to insert birthday",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     }
                 },
-                "x-order": 8
+                "x-order": 7
             }
         },
-        "/delete-user": {
-            "delete": {
+        "/add-group": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "This endpoint deletes a user based on their email obtained from the JWT token. The request must include a valid JWT token.",
+                "description": "This endpoint adds a group of birthdays with its own reminder settings for the authenticated user. The request must include a valid JWT token.",
                 "consumes": [
                     "application/json"
                 ],
@@ -186,88 +237,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "groups"
+                ],
+                "summary": "Add a group",
+                "parameters": [
+                    {
+                        "description": "Add group",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.GroupAdd"
+                        }
+                    }
                 ],
-                "summary": "Delete a user",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/structs.Success"
+                            "$ref": "#/definitions/structs.GroupFull"
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
-                        "schema": {
-                            "$ref": "#/definitions/structs.Error"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                        "description": "Invalid request, group settings or birthdays",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     },
                     "500": {
-                        "description": "Failed to delete user",
+                        "description": "Failed to insert group",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     }
                 },
-                "x-order": 5
+                "x-order": 15
             }
         },
-        "/generate-password": {
-            "get": {
-                "description": "This endpoint generates a new password for the user.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "auth"
-                ],
-                "summary": "Generate a new password",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/structs.Password"
-                        }
-                    },
-                    "500": {
-                        "description": "Failed to generate password",
-                        "schema": {
-                            "$ref": "#/definitions/structs.Error"
-                        }
+        "/add-invitation": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
                     }
-                },
-                "x-order": 1
-            }
-        },
-        "/health": {
-            "get": {
-                "description": "This endpoint checks the readiness of the service and returns a status.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "health"
                 ],
-                "summary": "Check service readiness",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/structs.Ready"
-                        }
-                    }
-                }
-            }
-        },
-        "/login": {
-            "post": {
-                "description": "This endpoint logs in a user by validating their email and password. Upon successful authentication, it generates a JWT token and returns the user's details along with the filtered list of birthdays.",
+                "description": "This endpoint creates a one-time invitation to join a shared list or an organization with a role. Invitations sent to an email can only be accepted by the user with that email, who also sees them in their received invitations, otherwise anyone with the link can accept them once. The token is only returned once, along with the public URL of the invitation, and the invitee can register through it even when open registration is disabled. Only the owners of the list or the admins of the organization can invite. The request must include a valid JWT token.",
                 "consumes": [
                     "application/json"
                 ],
@@ -275,17 +289,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "invitations"
                 ],
-                "summary": "Login a user",
+                "summary": "Invite someone to a list or organization",
                 "parameters": [
                     {
-                        "description": "Login user",
-                        "name": "user",
+                        "description": "Add invitation",
+                        "name": "invitation",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/structs.LoginRequest"
+                            "$ref": "#/definitions/structs.InvitationAdd"
                         }
                     }
                 ],
@@ -293,70 +307,97 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/structs.LoginSuccess"
+                            "$ref": "#/definitions/structs.InvitationFull"
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
+                        "description": "Invalid request, role, email or expiry time",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     },
-                    "401": {
-                        "description": "Invalid email or password",
+                    "403": {
+                        "description": "Only the admins of the organization can manage it",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "List or organization not found",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Failed to insert invitation",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     }
                 },
-                "x-order": 3
+                "x-order": 60
             }
         },
-        "/me": {
-            "get": {
+        "/add-list": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "This endpoint returns the authenticated user's data including Telegram bot API key, user ID, reminder time, and birthdays. The request must include a valid JWT token.",
+                "description": "This endpoint adds a list of birthdays that can be shared with other users, the authenticated user becomes its owner. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "lists"
+                ],
+                "summary": "Add a shared list",
+                "parameters": [
+                    {
+                        "description": "Add list",
+                        "name": "list",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.ListAdd"
+                        }
+                    }
                 ],
-                "summary": "Get user data",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/structs.UserData"
+                            "$ref": "#/definitions/structs.ListFull"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or list name",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Failed to insert list",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     }
-                }
+                },
+                "x-order": 37
             }
         },
-        "/modify-birthday": {
-            "put": {
+        "/add-list-share": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "This endpoint modifies a birthday for the authenticated user. The request must include a valid JWT token.",
+                "description": "This endpoint creates a token that gives read-only access to a shared list without an account, optionally expiring and hiding the birth years. The token is only returned once, along with the public URL of the list. Only the owners of the list can share it. The request must include a valid JWT token.",
                 "consumes": [
                     "application/json"
                 ],
@@ -364,17 +405,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "birthdays"
+                    "lists"
                 ],
-                "summary": "Modify a birthday",
+                "summary": "Share a list",
                 "parameters": [
                     {
-                        "description": "Modify birthday",
-                        "name": "birthday",
+                        "description": "Add share",
+                        "name": "share",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/structs.BirthdayNameDateModify"
+                            "$ref": "#/definitions/structs.ListShareAdd"
                         }
                     }
                 ],
@@ -382,33 +423,45 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/structs.Success"
+                            "$ref": "#/definitions/structs.ListShareFull"
                         }
                     },
                     "400": {
-                        "description": "Invalid request or date format",
+                        "description": "Invalid request or expiry time",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the owners of the list can manage it",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "List not found",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     },
                     "500": {
-                        "description": "Failed to update birthday",
+                        "description": "Failed to insert share",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     }
                 },
-                "x-order": 9
+                "x-order": 44
             }
         },
-        "/modify-user": {
-            "put": {
+        "/add-organization": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "This endpoint modifies a user's details such as Telegram bot API key, reminder time, and more. The request must include a valid JWT token. When modifying the email or password, a new JWT token is generated and returned. Otherwise, the user's data is returned without a new token.",
+                "description": "This endpoint adds an organization, for teams that share a birthday directory and company chats, the authenticated user becomes its admin. The request must include a valid JWT token.",
                 "consumes": [
                     "application/json"
                 ],
@@ -416,52 +469,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "organizations"
                 ],
-                "summary": "Modify a user's details",
+                "summary": "Add an organization",
                 "parameters": [
                     {
-                        "description": "Modify user",
-                        "name": "user",
+                        "description": "Add organization",
+                        "name": "organization",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/structs.ModifyUserRequest"
+                            "$ref": "#/definitions/structs.OrganizationAdd"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User data without a new token if no email or password changes",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/structs.UserData"
+                            "$ref": "#/definitions/structs.OrganizationFull"
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
-                        "schema": {
-                            "$ref": "#/definitions/structs.Error"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                        "description": "Invalid request or organization name",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     },
                     "500": {
-                        "description": "Failed to update user or process request",
+                        "description": "Failed to insert organization",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     }
                 },
-                "x-order": 4
+                "x-order": 48
             }
         },
-        "/register": {
+        "/add-organization-channel": {
             "post": {
-                "description": "This endpoint registers a new user with their email, Telegram bot API key, and other details.",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint adds a Telegram chat, such as a company chat, that receives the birthdays of the directory of an organization through its own bot. The birthdays are announced every day at the reminder time in UTC, the lead days before them. Only the admins of the organization can add channels. The request must include a valid JWT token.",
                 "consumes": [
                     "application/json"
                 ],
@@ -469,17 +521,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "organizations"
                 ],
-                "summary": "Register a new user",
+                "summary": "Add an organization channel",
                 "parameters": [
                     {
-                        "description": "Register user",
-                        "name": "user",
+                        "description": "Add channel",
+                        "name": "channel",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/structs.RegisterRequest"
+                            "$ref": "#/definitions/structs.OrganizationChannelAdd"
                         }
                     }
                 ],
@@ -487,122 +539,5910 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/structs.LoginSuccess"
+                            "$ref": "#/definitions/structs.OrganizationChannelFull"
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
+                        "description": "Invalid request or channel",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     },
-                    "409": {
-                        "description": "Email or Telegram bot API key already registered",
+                    "403": {
+                        "description": "Only the admins of the organization can manage it",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization not found",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     },
                     "500": {
-                        "description": "Failed to create user",
+                        "description": "Failed to insert channel",
                         "schema": {
                             "$ref": "#/definitions/structs.Error"
                         }
                     }
                 },
-                "x-order": 2
+                "x-order": 57
             }
-        }
-    },
-    "definitions": {
-        "structs.BirthdayFull": {
-            "type": "object",
-            "properties": {
-                "date": {
+        },
+        "/api-tokens": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists the personal API tokens of the authenticated user with their scopes and when they were last used, newest first. The tokens themselves are never returned. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "List personal API tokens",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.APITokenFull"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid email",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch API tokens",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 73
+            }
+        },
+        "/batch-birthdays": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint creates, updates and deletes birthdays of the authenticated user and of the shared lists they can edit in a single request, with up to 100 operations. The operations are applied in order within a transaction, if any of them fails validation none are applied and the errors are returned for each operation. Deleted birthdays are moved to the trash. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Apply a batch of changes to birthdays",
+                "parameters": [
+                    {
+                        "description": "Operations",
+                        "name": "batch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.BatchBirthdaysRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.BatchBirthdaysResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to apply operations",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 25
+            }
+        },
+        "/birthday-stats": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint returns statistics of the birthdays of the authenticated user, including those of their shared lists: birthdays per month, the busiest weeks, the age distribution by decade, the upcoming birthdays on which people turn one of the user's milestone ages and the days shared by more than one birthday. Only birthday events are counted. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Get birthday statistics",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.BirthdayStats"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid email",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to compute statistics",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 35
+            }
+        },
+        "/birthdays": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists the birthdays of the authenticated user and of their shared lists with their notes, tags and contact details, optionally filtered by tag. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "List birthdays",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only list the birthdays with any of these tags",
+                        "name": "tag",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.BirthdayFull"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch birthdays",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 14
+            }
+        },
+        "/birthdays/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists the changes made to a birthday of the authenticated user, oldest first, with its values before and after each change. Birthdays in the trash keep their history. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Get the history of a birthday",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.BirthdayHistoryEntry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid birthday ID",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch history",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 23
+            }
+        },
+        "/birthdays/{id}/revert": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint restores the values a birthday of the authenticated user had after one of its changes, or before it for deletions. A birthday in the trash is restored. The revert is recorded in the history too. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Revert a birthday to a previous version",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Change to revert to",
+                        "name": "revert",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.RevertBirthdayRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.BirthdayFull"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request, birthday ID or values",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Not allowed to change this birthday",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to revert birthday",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 24
+            }
+        },
+        "/check-birthdays": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint checks for user reminders through a POST request. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reminders"
+                ],
+                "summary": "Check user reminders",
+                "parameters": [
+                    {
+                        "description": "Check reminders",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error querying users",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 6
+            }
+        },
+        "/delete-api-token": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint deletes a personal API token of the authenticated user, it stops working right away. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Revoke a personal API token",
+                "parameters": [
+                    {
+                        "description": "API token",
+                        "name": "token",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.APITokenID"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "API token not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete API token",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 75
+            }
+        },
+        "/delete-birthday": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint moves a birthday of the authenticated user to the trash, from where it can be restored until it's purged after the retention period. Birthdays of shared lists can be deleted by the owners and editors of the list. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Delete a birthday",
+                "parameters": [
+                    {
+                        "description": "Delete birthday",
+                        "name": "birthday",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.BirthdayNameDateModify"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only apply the change if the birthday still has this ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or date format",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Not allowed to change this birthday",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "412": {
+                        "description": "Birthday was changed by another request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete birthday",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 8
+            }
+        },
+        "/delete-group": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint deletes a group of the authenticated user. The birthdays in the group are kept. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Delete a group",
+                "parameters": [
+                    {
+                        "description": "Delete group",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.GroupID"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete group",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 18
+            }
+        },
+        "/delete-list": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint deletes a shared list, only the owners of the list can delete it. The birthdays of the list go back to the users that added them, and its share links and invitations stop working. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lists"
+                ],
+                "summary": "Delete a shared list",
+                "parameters": [
+                    {
+                        "description": "Delete list",
+                        "name": "list",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.ListID"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the owners of the list can manage it",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "List not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete list",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 40
+            }
+        },
+        "/delete-list-share": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint revokes a token that gives read-only access to a shared list, the link stops working immediately. Only the owners of the list can revoke it. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lists"
+                ],
+                "summary": "Revoke a share of a list",
+                "parameters": [
+                    {
+                        "description": "Revoke share",
+                        "name": "share",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.ListShareID"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the owners of the list can manage it",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Share not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to revoke share",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 46
+            }
+        },
+        "/delete-organization": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint deletes an organization along with its directory, channels and invitations, only the admins of the organization can delete it. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Delete an organization",
+                "parameters": [
+                    {
+                        "description": "Delete organization",
+                        "name": "organization",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.OrganizationID"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the admins of the organization can manage it",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete organization",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 51
+            }
+        },
+        "/delete-organization-channel": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint deletes a Telegram chat of an organization, it stops receiving the birthdays of the directory. Only the admins of the organization can delete channels. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Delete an organization channel",
+                "parameters": [
+                    {
+                        "description": "Delete channel",
+                        "name": "channel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.OrganizationChannelID"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the admins of the organization can manage it",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Channel not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete channel",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 59
+            }
+        },
+        "/delete-session": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint ends one of the sessions of the authenticated user, for example on a lost device. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "End a session",
+                "parameters": [
+                    {
+                        "description": "Session",
+                        "name": "session",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.SessionID"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Session not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete session",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 70
+            }
+        },
+        "/delete-user": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint deletes a user based on their email obtained from the JWT token. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Delete a user",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete user",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 5
+            }
+        },
+        "/disable-totp": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint disables two-factor authentication for the authenticated user, deleting the TOTP secret and the recovery codes. It needs the password and a TOTP or recovery code. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Disable two-factor authentication",
+                "parameters": [
+                    {
+                        "description": "Password and code",
+                        "name": "reauth",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.TOTPReauth"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "two-factor authentication isn't enabled",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "invalid password or two-factor authentication code",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "429": {
+                        "description": "too many failed two-factor authentication attempts, try again later",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "failed to disable two-factor authentication",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 79
+            }
+        },
+        "/duplicate-birthdays": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists pairs of birthdays of the authenticated user that are probably the same person: their dates and calendars match exactly and their names are similar. Birthdays of shared lists are compared with the other birthdays of the same list. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "List probable duplicate birthdays",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Minimum name similarity, between 0 and 1 (default 0.75)",
+                        "name": "threshold",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.BirthdayDuplicate"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid threshold",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch birthdays",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 19
+            }
+        },
+        "/enable-totp": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint enables two-factor authentication once a code of the secret from /setup-totp is verified, and returns the recovery codes, which are only shown once. From then on logging in needs a TOTP code or a recovery code, and the other sessions and the API tokens of the user are ended. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Enable two-factor authentication",
+                "parameters": [
+                    {
+                        "description": "TOTP code",
+                        "name": "code",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.TOTPCode"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.RecoveryCodes"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "invalid two-factor authentication code",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "two-factor authentication is already enabled",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "429": {
+                        "description": "too many failed two-factor authentication attempts, try again later",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "failed to enable two-factor authentication",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 77
+            }
+        },
+        "/export-ics": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint exports the birthdays and events of the authenticated user and of their shared lists as an ICS file. Gregorian dates are exported as yearly recurring events, dates in other calendar systems are exported as one event for each Gregorian occurrence in the next years, since their Gregorian date changes every year. The request must include a valid JWT token.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Export birthdays as ICS",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of years of occurrences to export for dates in non-Gregorian calendars (1-50, default 10)",
+                        "name": "years",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ICS file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid number of years",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch birthdays",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 13
+            }
+        },
+        "/generate-password": {
+            "get": {
+                "description": "This endpoint generates a new password for the user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Generate a new password",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Password"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to generate password",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 1
+            }
+        },
+        "/groups": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists the groups of the authenticated user with their reminder settings and birthdays. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "List groups",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.GroupFull"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch groups",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 16
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "This endpoint checks the readiness of the service and returns a status.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Check service readiness",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Ready"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-ics": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint parses an ICS file and inserts the birthdays of its yearly recurring events for the authenticated user. The birthdays inserted are the same ones returned by /preview-ics-import for the same request. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Import birthdays from an ICS file",
+                "parameters": [
+                    {
+                        "description": "ICS file content and name patterns",
+                        "name": "import",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.ICSImportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.ICSImportResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request, ICS file or patterns",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to check existing birthdays or insert birthdays",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 11
+            }
+        },
+        "/invitations": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists the invitations of a shared list or an organization that weren't accepted yet, without their tokens. Only the owners of the list or the admins of the organization can see them. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invitations"
+                ],
+                "summary": "List the invitations of a list or organization",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "List ID",
+                        "name": "list_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "organization_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.InvitationFull"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid list or organization ID",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the admins of the organization can manage it",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "List or organization not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch invitations",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 61
+            }
+        },
+        "/invitations/{token}": {
+            "get": {
+                "description": "This endpoint returns what an invitation is for through its token, without an account, so the invitee can decide to register or log in to accept it. Each token has its own rate limit.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invitations"
+                ],
+                "summary": "View an invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invitation token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.InvitationPreview"
+                        }
+                    },
+                    "404": {
+                        "description": "Invitation not found, it may have expired or been used already",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch invitation",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 65
+            }
+        },
+        "/list-member": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint changes the role of a member of a shared list by the email of their account. Editors can change the birthdays of the list, viewers can only see them and owners also manage the list. Only the owners of the list can manage its members, and a list must keep at least one owner. Users only become members by accepting an invitation, since each member gets reminders of the birthdays of the list through their own chat and schedule. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lists"
+                ],
+                "summary": "Change the role of a member of a shared list",
+                "parameters": [
+                    {
+                        "description": "Member",
+                        "name": "member",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.ListMemberModify"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.ListFull"
+                        }
+                    },
+                    "400": {
+                        "description": "The list must keep an owner",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the owners of the list can manage it",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "List not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update member",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 41
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint removes a user from a shared list by the email of their account, only the owners of the list can remove other members. Members leave the list by omitting the email. If the last owner leaves, the earliest remaining member becomes the owner, and the last member to leave keeps the birthdays of the list, which is deleted. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lists"
+                ],
+                "summary": "Remove a member from a shared list",
+                "parameters": [
+                    {
+                        "description": "Member",
+                        "name": "member",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.ListMemberRemove"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or user",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the owners of the list can manage it",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "List not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to remove member",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 42
+            }
+        },
+        "/list-shares": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists the tokens that give read-only access to a shared list, without the tokens themselves. Only the owners of the list can see them. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lists"
+                ],
+                "summary": "List the shares of a list",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "List ID",
+                        "name": "list_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.ListShareFull"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid list ID",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the owners of the list can manage it",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "List not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch shares",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 45
+            }
+        },
+        "/lists": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists the shared lists the authenticated user is a member of, with their role and the members of each list. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lists"
+                ],
+                "summary": "List shared lists",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.ListFull"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid email",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch lists",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 38
+            }
+        },
+        "/login": {
+            "post": {
+                "description": "This endpoint logs in a user by validating their email and password. Upon successful authentication, it generates a JWT token and returns the user's details along with the filtered list of birthdays.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Login a user",
+                "parameters": [
+                    {
+                        "description": "Login user",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.LoginSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid email, password or code, or a two-factor authentication code is required",
+                        "schema": {
+                            "$ref": "#/definitions/structs.TOTPChallenge"
+                        }
+                    },
+                    "429": {
+                        "description": "too many failed two-factor authentication attempts, try again later",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 3
+            }
+        },
+        "/logout": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint ends the session of the access token of the request, its access and refresh tokens stop working right away. When the request is made with a personal API token, that token is revoked instead. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Log out",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to log out",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 67
+            }
+        },
+        "/logout-everywhere": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint ends all the sessions of the authenticated user, including the current one, on every device. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Log out everywhere",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid email",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to log out",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 68
+            }
+        },
+        "/me": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint returns the authenticated user's data including Telegram bot API key, user ID, reminder time, and birthdays. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Get user data",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.UserData"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the user settings"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/merge-birthdays": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint merges a birthday of the authenticated user into another one. The notes are appended, the tags, groups and gift ideas are joined, and missing contact details are filled in. The merged birthday is then moved to the trash, and the deletion is recorded in its history. Both birthdays must be in the same list. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Merge two birthdays",
+                "parameters": [
+                    {
+                        "description": "Birthdays to merge",
+                        "name": "birthdays",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.MergeBirthdaysRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.BirthdayFull"
+                        }
+                    },
+                    "400": {
+                        "description": "The birthdays must be in the same list",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to merge birthdays",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 20
+            }
+        },
+        "/modify-api-token": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint renames a personal API token of the authenticated user and replaces its scopes. A request made with an API token can only grant the scopes it has. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Modify a personal API token",
+                "parameters": [
+                    {
+                        "description": "API token",
+                        "name": "token",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.APITokenModify"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.APITokenFull"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "API token not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update API token",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 74
+            }
+        },
+        "/modify-birthday": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint modifies a birthday for the authenticated user. Birthdays of shared lists can be modified by the owners and editors of the list. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Modify a birthday",
+                "parameters": [
+                    {
+                        "description": "Modify birthday",
+                        "name": "birthday",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.BirthdayNameDateModify"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only apply the change if the birthday still has this ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the birthday"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request, name, date format, event type, calendar or details",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Not allowed to change this birthday",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "412": {
+                        "description": "Birthday was changed by another request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update birthday",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 9
+            }
+        },
+        "/modify-group": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint modifies the name, reminder settings or birthdays of a group of the authenticated user. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Modify a group",
+                "parameters": [
+                    {
+                        "description": "Modify group",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.GroupModify"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.GroupFull"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request, group settings or birthdays",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update group",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 17
+            }
+        },
+        "/modify-list": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint changes the name of a shared list, only the owners of the list can change it. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lists"
+                ],
+                "summary": "Rename a shared list",
+                "parameters": [
+                    {
+                        "description": "Modify list",
+                        "name": "list",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.ListModify"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.ListFull"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or list name",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the owners of the list can manage it",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "List not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update list",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 39
+            }
+        },
+        "/modify-milestones": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint sets the ages whose birthdays are highlighted as milestones for the authenticated user, by default 18, 21 and every decade from 30, and the days before them on which an extra reminder is sent, 30 by default. The upcoming birthdays listing includes milestones within the lead days too. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Modify the milestone settings",
+                "parameters": [
+                    {
+                        "description": "Milestone settings",
+                        "name": "milestones",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.MilestonesModify"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only apply the change if the user settings still have this ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.MilestoneSettings"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the user settings"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or milestones",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid email",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "412": {
+                        "description": "User settings were changed by another request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update milestones",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 36
+            }
+        },
+        "/modify-organization": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint changes the name of an organization, only the admins of the organization can change it. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Rename an organization",
+                "parameters": [
+                    {
+                        "description": "Modify organization",
+                        "name": "organization",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.OrganizationModify"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.OrganizationFull"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or organization name",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the admins of the organization can manage it",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update organization",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 50
+            }
+        },
+        "/modify-user": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint modifies a user's details such as Telegram bot API key, reminder time, and more. The request must include a valid JWT token. When modifying the email or password, all the sessions of the user are ended and a new session is started, returning its tokens. Modifying the password also revokes the personal API tokens. Otherwise, the user's data is returned without a new token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Modify a user's details",
+                "parameters": [
+                    {
+                        "description": "Modify user",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.ModifyUserRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only apply the change if the user settings still have this ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User data without a new token if no email or password changes",
+                        "schema": {
+                            "$ref": "#/definitions/structs.UserData"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "412": {
+                        "description": "User settings were changed by another request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update user or process request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 4
+            }
+        },
+        "/move-birthday": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint moves a birthday into a shared list, or out of it to the authenticated user's own birthdays with a list ID of 0. The user must be able to change the birthday and to add birthdays to the list. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lists"
+                ],
+                "summary": "Move a birthday between lists",
+                "parameters": [
+                    {
+                        "description": "Move birthday",
+                        "name": "birthday",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.BirthdayMove"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only apply the change if the birthday still has this ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.BirthdayFull"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the birthday"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Not allowed to add birthdays to this list",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "412": {
+                        "description": "Birthday was changed by another request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to move birthday",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 43
+            }
+        },
+        "/oidc/callback": {
+            "get": {
+                "description": "This endpoint is where the OpenID Connect provider redirects back to. It redeems the authorization code, verifies the ID token and starts a session for the user, found by the identity linked before or by the email verified by the provider. Accounts with two-factor authentication can't log in this way, even if they were linked before. Users without an account get one unless HBD_OIDC_AUTO_PROVISION is false, if registration is open or the login was started with an invitation, and set up Telegram afterwards. When HBD_OIDC_FRONTEND_URL is set, the browser is redirected to it with the tokens in the fragment of the URL, otherwise the tokens are returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Finish a single sign-on login",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization code",
+                        "name": "code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "State of the login",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.SessionTokens"
+                        }
+                    },
+                    "302": {
+                        "description": "Redirect to the frontend with the tokens"
+                    },
+                    "400": {
+                        "description": "Invalid or expired login, start it again",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Login with the identity provider failed",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "the identity provider didn't verify the email of this account",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Single sign-on isn't enabled, or the invitation wasn't found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "failed to log in",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 81
+            }
+        },
+        "/oidc/login": {
+            "get": {
+                "description": "This endpoint starts a login with the OpenID Connect provider, redirecting the browser to it with the authorization code flow and PKCE. The provider redirects back to /oidc/callback. The token of an invitation lets users without an account get one when open registration is disabled, and they join its shared list or organization. It's only available when single sign-on is configured.",
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Log in with single sign-on",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token of an invitation",
+                        "name": "invite_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Redirect to the identity provider"
+                    },
+                    "404": {
+                        "description": "Single sign-on isn't enabled",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "502": {
+                        "description": "The identity provider is unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 80
+            }
+        },
+        "/organization-channels": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists the Telegram chats that receive the birthdays of the directory of an organization, without their bot API keys. Only the admins of the organization can see them. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "List the channels of an organization",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "organization_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.OrganizationChannelFull"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid organization ID",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the admins of the organization can manage it",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch channels",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 58
+            }
+        },
+        "/organization-directory": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists the birthdays of the members of an organization that opted into its directory, sorted by their next date. Only the members of the organization can see it, and the years are left out for the members that keep them private. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Get the directory of an organization",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "organization_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.DirectoryEntry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid organization ID",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch organization",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 56
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint adds the authenticated user to the birthday directory of an organization they're a member of, or changes their entry. The directory is only visible to the members of the organization and its birthdays are announced in the organization channels. A date without year (--MM-DD) keeps the year private. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Opt into the directory of an organization",
+                "parameters": [
+                    {
+                        "description": "Directory entry",
+                        "name": "entry",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.DirectoryEntryModify"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.DirectoryEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request, name or date",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update directory entry",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 54
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint removes the authenticated user from the birthday directory of an organization, their birthday is no longer shown nor announced. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Opt out of the directory of an organization",
+                "parameters": [
+                    {
+                        "description": "Directory entry",
+                        "name": "entry",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.DirectoryEntryRemove"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to remove directory entry",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 55
+            }
+        },
+        "/organization-member": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint changes the role of a member of an organization by the email of their account. Admins manage the members and channels of the organization. Only the admins of the organization can manage its members, and an organization must keep at least one admin. Users only become members by accepting an invitation. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Change the role of a member of an organization",
+                "parameters": [
+                    {
+                        "description": "Member",
+                        "name": "member",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.OrganizationMemberModify"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.OrganizationFull"
+                        }
+                    },
+                    "400": {
+                        "description": "The organization must keep an admin",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the admins of the organization can manage it",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update member",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 52
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint removes a user from an organization by the email of their account, only the admins of the organization can remove other members. Members leave the organization by omitting the email, which also removes them from the directory. If the last admin leaves, the earliest remaining member becomes an admin, and the organization is deleted when the last member leaves. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Remove a member from an organization",
+                "parameters": [
+                    {
+                        "description": "Member",
+                        "name": "member",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.OrganizationMemberRemove"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or user",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the admins of the organization can manage it",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to remove member",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 53
+            }
+        },
+        "/organizations": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists the organizations the authenticated user is a member of, with their role and the members of each organization. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "List organizations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.OrganizationFull"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid email",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch organizations",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 49
+            }
+        },
+        "/preview-ics-import": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint parses an ICS file and returns the birthdays that would be imported without inserting them. Only yearly recurring events are recognized, and names are extracted from the event summary using the provided patterns (e.g. \"{name}'s birthday\"). Events of birthdays the user already has with the same name, date and event type are skipped, so the same file can be imported again. Files can have up to 1000 events. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Preview an ICS import",
+                "parameters": [
+                    {
+                        "description": "ICS file content and name patterns",
+                        "name": "import",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.ICSImportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.ICSImportPreview"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request, ICS file or patterns",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to check existing birthdays",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 10
+            }
+        },
+        "/received-invitations": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists the pending invitations sent to the email of the authenticated user, which can be accepted by their ID. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invitations"
+                ],
+                "summary": "List the invitations received by email",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.InvitationFull"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid email",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch invitations",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 62
+            }
+        },
+        "/refresh": {
+            "post": {
+                "description": "This endpoint issues a new access token for a session with its refresh token. Refresh tokens rotate: each one can only be used once and the response has the next one. Using a refresh token that was already used revokes the session, since it may have been stolen.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Refresh the access token",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "refresh",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.RefreshRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.SessionTokens"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid or expired refresh token",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to refresh session",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 66
+            }
+        },
+        "/regenerate-recovery-codes": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint replaces the recovery codes of the authenticated user with new ones, which are only shown once. It needs the password and a TOTP or recovery code. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Regenerate the recovery codes",
+                "parameters": [
+                    {
+                        "description": "Password and code",
+                        "name": "reauth",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.TOTPReauth"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.RecoveryCodes"
+                        }
+                    },
+                    "400": {
+                        "description": "two-factor authentication isn't enabled",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "invalid password or two-factor authentication code",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "429": {
+                        "description": "too many failed two-factor authentication attempts, try again later",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "failed to regenerate recovery codes",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 78
+            }
+        },
+        "/register": {
+            "post": {
+                "description": "This endpoint registers a new user with their email, Telegram bot API key, and other details. With the token of an invitation the user also joins its shared list or organization, which is required when open registration is disabled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "Register user",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.LoginSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "This invitation was sent to another email",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Invitation not found, it may have expired or been used already",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Email or Telegram bot API key already registered",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create user",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 2
+            }
+        },
+        "/restore-birthday": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint restores a deleted birthday of the authenticated user from the trash. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Restore a birthday",
+                "parameters": [
+                    {
+                        "description": "Restore birthday",
+                        "name": "birthday",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.BirthdayID"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.BirthdayFull"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to restore birthday",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 22
+            }
+        },
+        "/revoke-invitation": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint revokes an invitation to a shared list or an organization, it can no longer be accepted. Only the owners of the list or the admins of the organization can revoke it. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invitations"
+                ],
+                "summary": "Revoke an invitation",
+                "parameters": [
+                    {
+                        "description": "Revoke invitation",
+                        "name": "invitation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.InvitationID"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the admins of the organization can manage it",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Invitation not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to revoke invitation",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 63
+            }
+        },
+        "/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists the active sessions of the authenticated user with the device and address they were last used from, most recently used first. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "List active sessions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.SessionFull"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid email",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch sessions",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 69
+            }
+        },
+        "/setup-totp": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint generates a new TOTP secret for the authenticated user, returned along with its otpauth:// URI to show as a QR code in an authenticator app. Two-factor authentication isn't enabled until a code of the secret is verified with /enable-totp. Calling it again replaces the secret. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Start the setup of two-factor authentication",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.TOTPSetup"
+                        }
+                    },
+                    "401": {
+                        "description": "invalid email",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "two-factor authentication is already enabled",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "failed to set up two-factor authentication",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 76
+            }
+        },
+        "/shared-lists/{token}": {
+            "get": {
+                "description": "This endpoint returns a read-only view of a shared list through a share token, without an account. Only the names, event types and dates of the birthdays are shown, sorted by their next date, and the birth years are left out if the share hides them. Each token has its own rate limit.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lists"
+                ],
+                "summary": "View a shared list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Share token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.SharedList"
+                        }
+                    },
+                    "404": {
+                        "description": "Shared list not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch shared list",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 47
+            }
+        },
+        "/trash": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists the deleted birthdays of the authenticated user and of the shared lists they can edit that can still be restored, with the time at which they'll be purged. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "List the trash",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.TrashedBirthday"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch birthdays",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 21
+            }
+        },
+        "/upcoming-birthdays": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists the birthdays and events of the authenticated user and of their shared lists in the next days, with the date on which they're celebrated this year in the Gregorian calendar. Milestone birthdays are flagged and listed up to the milestone lead days in advance. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "List upcoming birthdays",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of days to look ahead, including today (1-366, default 30)",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only list the birthdays with any of these tags",
+                        "name": "tag",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.UpcomingBirthday"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid number of days",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch birthdays",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 12
+            }
+        },
+        "/v2/birthdays": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists the birthdays of the authenticated user and of their shared lists with their notes, tags and contact details, optionally filtered by tag. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "List birthdays",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only list the birthdays with any of these tags",
+                        "name": "tag",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.BirthdayFull"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch birthdays",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 14
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint adds a new birthday for the authenticated user and returns it along with its URL in the Location header. The user can't have two birthdays with the same name, date and event type. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays v2"
+                ],
+                "summary": "Create a birthday",
+                "parameters": [
+                    {
+                        "description": "Add birthday",
+                        "name": "birthday",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.BirthdayNameDateAdd"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/structs.BirthdayFull"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the birthday"
+                            },
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the birthday"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request, name, date format, event type, calendar or details",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Not allowed to add birthdays to this list",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Birthday already exists",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to insert birthday",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 27
+            }
+        },
+        "/v2/birthdays/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint returns a birthday of the authenticated user with its notes, tags and contact details. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays v2"
+                ],
+                "summary": "Get a birthday",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.BirthdayFull"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the birthday"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid birthday ID",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Birthday not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch birthday",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 26
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint moves a birthday of the authenticated user to the trash, from where it can be restored until it's purged after the retention period. The request must include a valid JWT token.",
+                "tags": [
+                    "birthdays v2"
+                ],
+                "summary": "Delete a birthday",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only apply the change if the birthday still has this ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid birthday ID",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Not allowed to change this birthday",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Birthday not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "412": {
+                        "description": "Birthday was changed by another request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete birthday",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 29
+            },
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint changes the provided fields of a birthday of the authenticated user and returns it. The user can't have two birthdays with the same name, date and event type. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays v2"
+                ],
+                "summary": "Update a birthday",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Changed fields",
+                        "name": "birthday",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.BirthdayPatch"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only apply the change if the birthday still has this ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.BirthdayFull"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the birthday"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request, birthday ID, name, date format, event type, calendar or details",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Not allowed to change this birthday",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Birthday not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Birthday already exists",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "412": {
+                        "description": "Birthday was changed by another request",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update birthday",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 28
+            }
+        },
+        "/v2/birthdays/{id}/gift-ideas": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists the gift ideas of a birthday of the authenticated user, optionally filtered by status. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gifts"
+                ],
+                "summary": "List the gift ideas of a birthday",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only list the gifts with this status (idea, bought or given)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.GiftFull"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid birthday ID",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Birthday not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch gifts",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 30
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint adds a gift idea to a birthday of the authenticated user and returns it along with its URL in the Location header. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gifts"
+                ],
+                "summary": "Add a gift idea to a birthday",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Add gift",
+                        "name": "gift",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.GiftAdd"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/structs.GiftFull"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the gift"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request, birthday ID or gift",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Not allowed to change this birthday",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Birthday not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to insert gift",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 31
+            }
+        },
+        "/v2/birthdays/{id}/gift-ideas/{gift_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint permanently deletes a gift idea of a birthday of the authenticated user. The request must include a valid JWT token.",
+                "tags": [
+                    "gifts"
+                ],
+                "summary": "Delete a gift idea",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Gift ID",
+                        "name": "gift_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid birthday ID or gift ID",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Not allowed to change this birthday",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Gift not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete gift",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 33
+            },
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint changes the provided fields of a gift idea of a birthday of the authenticated user and returns it. Marking a gift as given records the current year unless the year is provided. The request must include a valid JWT token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gifts"
+                ],
+                "summary": "Update a gift idea",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Gift ID",
+                        "name": "gift_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Changed fields",
+                        "name": "gift",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/structs.GiftPatch"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/structs.GiftFull"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request, birthday ID, gift ID or gift",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Not allowed to change this birthday",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Gift not found",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update gift",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 32
+            }
+        },
+        "/v2/gift-history": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "This endpoint lists the gifts the authenticated user has given, most recent first, to avoid giving the same gift twice. It can be limited to one birthday. The request must include a valid JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gifts"
+                ],
+                "summary": "List the gifts given in the past",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Only list the gifts given for this birthday",
+                        "name": "birthday_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/structs.GiftHistoryEntry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid birthday ID",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch gifts",
+                        "schema": {
+                            "$ref": "#/definitions/structs.Error"
+                        }
+                    }
+                },
+                "x-order": 34
+            }
+        }
+    },
+    "definitions": {
+        "structs.APITokenAdd": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-12-31T23:59:59Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "hbd-cli"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "birthdays:read",
+                        "birthdays:write"
+                    ]
+                }
+            }
+        },
+        "structs.APITokenFull": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T12:00:00Z"
+                },
+                "expired": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-12-31T23:59:59Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "2025-01-02T08:30:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "hbd-cli"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "birthdays:read",
+                        "birthdays:write"
+                    ]
+                },
+                "token": {
+                    "type": "string",
+                    "example": "hbd_pat_q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"
+                }
+            }
+        },
+        "structs.APITokenID": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "structs.APITokenModify": {
+            "type": "object",
+            "required": [
+                "id",
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "hbd-cli"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "birthdays:read"
+                    ]
+                }
+            }
+        },
+        "structs.AgeBucket": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "from": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "to": {
+                    "type": "integer",
+                    "example": 39
+                }
+            }
+        },
+        "structs.BatchBirthdaysRequest": {
+            "type": "object",
+            "required": [
+                "operations"
+            ],
+            "properties": {
+                "operations": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/structs.BatchOperation"
+                    }
+                }
+            }
+        },
+        "structs.BatchBirthdaysResult": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/structs.BatchOperationResult"
+                    }
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "structs.BatchOperation": {
+            "type": "object",
+            "properties": {
+                "create": {
+                    "$ref": "#/definitions/structs.BirthdayNameDateAdd"
+                },
+                "delete": {
+                    "$ref": "#/definitions/structs.BirthdayID"
+                },
+                "update": {
+                    "$ref": "#/definitions/structs.BirthdayNameDateModify"
+                }
+            }
+        },
+        "structs.BatchOperationResult": {
+            "type": "object",
+            "properties": {
+                "birthday": {
+                    "$ref": "#/definitions/structs.BirthdayFull"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "invalid date format"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "op": {
+                    "type": "string",
+                    "example": "create"
+                }
+            }
+        },
+        "structs.BirthdayDuplicate": {
+            "type": "object",
+            "properties": {
+                "birthdays": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/structs.BirthdayFull"
+                    }
+                },
+                "similarity": {
+                    "type": "number",
+                    "example": 0.9
+                }
+            }
+        },
+        "structs.BirthdayFull": {
+            "type": "object",
+            "properties": {
+                "calendar": {
+                    "type": "string",
+                    "example": "gregorian"
+                },
+                "calendar_date": {
+                    "type": "string",
+                    "example": "15 Nisan 5750"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2021-01-01"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "john@example.com"
+                },
+                "event_type": {
+                    "type": "string",
+                    "example": "birthday"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "list_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Likes chess and dark chocolate"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+1 555 0100"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "family",
+                        "football club"
+                    ]
+                },
+                "telegram_username": {
+                    "type": "string",
+                    "example": "johndoe"
+                },
+                "year_known": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "years_label": {
+                    "type": "string",
+                    "example": "Turns {years}"
+                }
+            }
+        },
+        "structs.BirthdayHistoryEntry": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "update"
+                },
+                "birthday_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-03-02T15:04:05Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "new_values": {
+                    "$ref": "#/definitions/structs.BirthdayFull"
+                },
+                "old_values": {
+                    "$ref": "#/definitions/structs.BirthdayFull"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "structs.BirthdayID": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "structs.BirthdayMove": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "list_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "structs.BirthdayNameDateAdd": {
+            "type": "object",
+            "required": [
+                "date",
+                "name"
+            ],
+            "properties": {
+                "calendar": {
+                    "type": "string",
+                    "example": "gregorian"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2021-01-01"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "john@example.com"
+                },
+                "event_type": {
+                    "type": "string",
+                    "example": "birthday"
+                },
+                "list_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Likes chess and dark chocolate"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+1 555 0100"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "family",
+                        "football club"
+                    ]
+                },
+                "telegram_username": {
+                    "type": "string",
+                    "example": "johndoe"
+                },
+                "years_label": {
+                    "type": "string",
+                    "example": "Turns {years}"
+                }
+            }
+        },
+        "structs.BirthdayNameDateModify": {
+            "type": "object",
+            "required": [
+                "date",
+                "id",
+                "name"
+            ],
+            "properties": {
+                "calendar": {
+                    "type": "string",
+                    "example": "gregorian"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2021-01-01"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "john@example.com"
+                },
+                "event_type": {
+                    "type": "string",
+                    "example": "birthday"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Likes chess and dark chocolate"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+1 555 0100"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "family",
+                        "football club"
+                    ]
+                },
+                "telegram_username": {
+                    "type": "string",
+                    "example": "johndoe"
+                },
+                "years_label": {
+                    "type": "string",
+                    "example": "Turns {years}"
+                }
+            }
+        },
+        "structs.BirthdayPatch": {
+            "type": "object",
+            "properties": {
+                "calendar": {
+                    "type": "string",
+                    "example": "gregorian"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2021-01-01"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "john@example.com"
+                },
+                "event_type": {
+                    "type": "string",
+                    "example": "birthday"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Likes chess and dark chocolate"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+1 555 0100"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "family",
+                        "football club"
+                    ]
+                },
+                "telegram_username": {
+                    "type": "string",
+                    "example": "johndoe"
+                },
+                "years_label": {
+                    "type": "string",
+                    "example": "Turns {years}"
+                }
+            }
+        },
+        "structs.BirthdayStats": {
+            "type": "object",
+            "properties": {
+                "age_distribution": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/structs.AgeBucket"
+                    }
+                },
+                "busiest_weeks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/structs.WeekCount"
+                    }
+                },
+                "per_month": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/structs.MonthCount"
+                    }
+                },
+                "shared_birthdays": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/structs.SharedBirthday"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "upcoming_milestones": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/structs.MilestoneBirthday"
+                    }
+                }
+            }
+        },
+        "structs.DirectoryEntry": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "--03-02"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "next_date": {
+                    "type": "string",
+                    "example": "2025-03-02"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "years": {
+                    "type": "integer",
+                    "example": 35
+                },
+                "you": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "structs.DirectoryEntryModify": {
+            "type": "object",
+            "required": [
+                "date",
+                "name",
+                "organization_id"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "--03-02"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "organization_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "structs.DirectoryEntryRemove": {
+            "type": "object",
+            "required": [
+                "organization_id"
+            ],
+            "properties": {
+                "organization_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "structs.Error": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "structs.GiftAdd": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "link": {
+                    "type": "string",
+                    "example": "https://example.com/chess-set"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chess set"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "The wooden one"
+                },
+                "price": {
+                    "type": "number",
+                    "example": 49.9
+                },
+                "status": {
+                    "type": "string",
+                    "example": "idea"
+                },
+                "year_given": {
+                    "type": "integer",
+                    "example": 2024
+                }
+            }
+        },
+        "structs.GiftFull": {
+            "type": "object",
+            "properties": {
+                "birthday_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-03-02T15:04:05Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "link": {
+                    "type": "string",
+                    "example": "https://example.com/chess-set"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chess set"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "The wooden one"
+                },
+                "price": {
+                    "type": "number",
+                    "example": 49.9
+                },
+                "status": {
+                    "type": "string",
+                    "example": "idea"
+                },
+                "year_given": {
+                    "type": "integer",
+                    "example": 2024
+                }
+            }
+        },
+        "structs.GiftHistoryEntry": {
+            "type": "object",
+            "properties": {
+                "birthday_name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "gift": {
+                    "$ref": "#/definitions/structs.GiftFull"
+                }
+            }
+        },
+        "structs.GiftPatch": {
+            "type": "object",
+            "properties": {
+                "link": {
+                    "type": "string",
+                    "example": "https://example.com/chess-set"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chess set"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "The wooden one"
+                },
+                "price": {
+                    "type": "number",
+                    "example": 49.9
+                },
+                "status": {
+                    "type": "string",
+                    "example": "bought"
+                },
+                "year_given": {
+                    "type": "integer",
+                    "example": 2024
+                }
+            }
+        },
+        "structs.GroupAdd": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "birthday_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1,
+                        2
+                    ]
+                },
+                "destination": {
+                    "type": "string",
+                    "example": "-1001234567890"
+                },
+                "lead_days": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        0,
+                        7
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Close family"
+                },
+                "template": {
+                    "type": "string",
+                    "example": "{name} - {years}"
+                }
+            }
+        },
+        "structs.GroupFull": {
+            "type": "object",
+            "properties": {
+                "birthday_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1,
+                        2
+                    ]
+                },
+                "destination": {
+                    "type": "string",
+                    "example": "-1001234567890"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "lead_days": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        0,
+                        7
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Close family"
+                },
+                "template": {
+                    "type": "string",
+                    "example": "{name} - {years}"
+                }
+            }
+        },
+        "structs.GroupID": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "structs.GroupModify": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "birthday_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1,
+                        2
+                    ]
+                },
+                "destination": {
+                    "type": "string",
+                    "example": "-1001234567890"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "lead_days": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        0,
+                        7
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Close family"
+                },
+                "template": {
+                    "type": "string",
+                    "example": "{name} - {years}"
+                }
+            }
+        },
+        "structs.ICSImportPreview": {
+            "type": "object",
+            "properties": {
+                "birthdays": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/structs.BirthdayNameDateAdd"
+                    }
+                },
+                "skipped": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/structs.ICSSkippedEvent"
+                    }
+                }
+            }
+        },
+        "structs.ICSImportRequest": {
+            "type": "object",
+            "required": [
+                "ics"
+            ],
+            "properties": {
+                "ics": {
+                    "type": "string",
+                    "example": "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:19900302\nRRULE:FREQ=YEARLY\nSUMMARY:John Doe's birthday\nEND:VEVENT\nEND:VCALENDAR"
+                },
+                "patterns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "{name}'s birthday",
+                        "Birthday of {name}"
+                    ]
+                }
+            }
+        },
+        "structs.ICSImportResult": {
+            "type": "object",
+            "properties": {
+                "birthdays": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/structs.BirthdayFull"
+                    }
+                },
+                "skipped": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/structs.ICSSkippedEvent"
+                    }
+                }
+            }
+        },
+        "structs.ICSSkippedEvent": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "not a yearly recurring event"
+                },
+                "summary": {
+                    "type": "string",
+                    "example": "Team meeting"
+                }
+            }
+        },
+        "structs.InvitationAccept": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "token": {
+                    "type": "string",
+                    "example": "q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"
+                }
+            }
+        },
+        "structs.InvitationAdd": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "partner@example.com"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-12-31T23:59:59Z"
+                },
+                "list_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "organization_id": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "role": {
+                    "type": "string",
+                    "example": "editor"
+                }
+            }
+        },
+        "structs.InvitationFull": {
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "by_email": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T12:00:00Z"
+                },
+                "expired": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-12-31T23:59:59Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "list_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Family"
+                },
+                "organization_id": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "role": {
+                    "type": "string",
+                    "example": "editor"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "/api/invitations/q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"
+                }
+            }
+        },
+        "structs.InvitationID": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "structs.InvitationPreview": {
+            "type": "object",
+            "properties": {
+                "by_email": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-12-31T23:59:59Z"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "list"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Family"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "editor"
+                }
+            }
+        },
+        "structs.JWK": {
+            "type": "object",
+            "properties": {
+                "alg": {
+                    "type": "string",
+                    "example": "EdDSA"
+                },
+                "crv": {
+                    "type": "string",
+                    "example": "Ed25519"
+                },
+                "kid": {
+                    "type": "string",
+                    "example": "2026-10"
+                },
+                "kty": {
+                    "type": "string",
+                    "example": "OKP"
+                },
+                "use": {
+                    "type": "string",
+                    "example": "sig"
+                },
+                "x": {
+                    "type": "string",
+                    "example": "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"
+                }
+            }
+        },
+        "structs.JWKS": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/structs.JWK"
+                    }
+                }
+            }
+        },
+        "structs.ListAdd": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Family"
+                }
+            }
+        },
+        "structs.ListFull": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/structs.ListMemberFull"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Family"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "owner"
+                }
+            }
+        },
+        "structs.ListID": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "structs.ListMemberFull": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "example": "editor"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "you": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "structs.ListMemberModify": {
+            "type": "object",
+            "required": [
+                "email",
+                "list_id",
+                "role"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "partner@example.com"
+                },
+                "list_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "role": {
+                    "type": "string",
+                    "example": "editor"
+                }
+            }
+        },
+        "structs.ListMemberRemove": {
+            "type": "object",
+            "required": [
+                "list_id"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "partner@example.com"
+                },
+                "list_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "structs.ListModify": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Family"
+                }
+            }
+        },
+        "structs.ListShareAdd": {
+            "type": "object",
+            "required": [
+                "list_id"
+            ],
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-12-31T23:59:59Z"
+                },
+                "hide_years": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "list_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "structs.ListShareFull": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T12:00:00Z"
+                },
+                "expired": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-12-31T23:59:59Z"
+                },
+                "hide_years": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "list_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "token": {
+                    "type": "string",
+                    "example": "q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "/api/shared-lists/q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"
+                }
+            }
+        },
+        "structs.ListShareID": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "structs.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "example@lotiguere.com"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "9cc76406913372c2b3a3474e8ebb8dc917bdb9c4a7c5e98c639ed20f5bcf4da1"
+                },
+                "totp_code": {
+                    "type": "string",
+                    "example": "123456"
+                }
+            }
+        },
+        "structs.LoginSuccess": {
+            "type": "object",
+            "properties": {
+                "birthdays": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/structs.BirthdayFull"
+                    }
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-01-01T12:15:00Z"
+                },
+                "refresh_token": {
+                    "type": "string",
+                    "example": "q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"
+                },
+                "reminder_time": {
+                    "type": "string",
+                    "example": "15:04"
+                },
+                "telegram_bot_api_key": {
+                    "type": "string",
+                    "example": "270485614:AAHfiqksKZ8WmR2zSjiQ7jd8Eud81ggE3e-3"
+                },
+                "telegram_user_id": {
+                    "type": "string",
+                    "example": "123456789"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "America/New_York"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "structs.MergeBirthdaysRequest": {
+            "type": "object",
+            "required": [
+                "keep_id",
+                "merge_id"
+            ],
+            "properties": {
+                "keep_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "merge_id": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "structs.MilestoneBirthday": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "1994-03-02"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "turning": {
+                    "type": "integer",
+                    "example": 30
+                }
+            }
+        },
+        "structs.MilestoneSettings": {
+            "type": "object",
+            "properties": {
+                "lead_days": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "milestones": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        18,
+                        21,
+                        30,
+                        40,
+                        50
+                    ]
+                }
+            }
+        },
+        "structs.MilestonesModify": {
+            "type": "object",
+            "properties": {
+                "lead_days": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "milestones": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        18,
+                        21,
+                        30,
+                        40,
+                        50
+                    ]
+                }
+            }
+        },
+        "structs.ModifyUserRequest": {
+            "type": "object",
+            "required": [
+                "new_reminder_time",
+                "new_telegram_bot_api_key",
+                "new_telegram_user_id",
+                "new_timezone"
+            ],
+            "properties": {
+                "new_email": {
+                    "type": "string",
+                    "example": "example2@lotiguere.com"
+                },
+                "new_password": {
+                    "type": "string",
+                    "example": "9cc76406913372c2b3a3474e8ebb8dc917bdb9c4a7c5e98c639ed20f5bcf4da1"
+                },
+                "new_reminder_time": {
+                    "type": "string",
+                    "example": "15:04"
+                },
+                "new_telegram_bot_api_key": {
+                    "type": "string",
+                    "example": "270485614:AAHfiqksKZ8WmR2zSjiQ7jd8Eud81ggE3e-3"
+                },
+                "new_telegram_user_id": {
+                    "type": "string",
+                    "example": "123456789"
+                },
+                "new_timezone": {
+                    "type": "string",
+                    "example": "America/New_York"
+                }
+            }
+        },
+        "structs.MonthCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "month": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "structs.OrganizationAdd": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Acme"
+                }
+            }
+        },
+        "structs.OrganizationChannelAdd": {
+            "type": "object",
+            "required": [
+                "name",
+                "organization_id",
+                "reminder_time",
+                "telegram_bot_api_key",
+                "telegram_chat_id"
+            ],
+            "properties": {
+                "lead_days": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Company chat"
+                },
+                "organization_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reminder_time": {
+                    "type": "string",
+                    "example": "09:00"
+                },
+                "telegram_bot_api_key": {
+                    "type": "string",
+                    "example": "270485614:AAHfiqksKZ8WmR2zSjiQ7jd8Eud81ggE3e-3"
+                },
+                "telegram_chat_id": {
+                    "type": "string",
+                    "example": "-1001234567890"
+                }
+            }
+        },
+        "structs.OrganizationChannelFull": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "lead_days": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Company chat"
+                },
+                "organization_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reminder_time": {
+                    "type": "string",
+                    "example": "09:00"
+                },
+                "telegram_chat_id": {
+                    "type": "string",
+                    "example": "-1001234567890"
+                }
+            }
+        },
+        "structs.OrganizationChannelID": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "structs.OrganizationFull": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/structs.OrganizationMemberFull"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Acme"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "admin"
+                }
+            }
+        },
+        "structs.OrganizationID": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "structs.OrganizationMemberFull": {
+            "type": "object",
+            "properties": {
+                "in_directory": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "role": {
+                    "type": "string",
+                    "example": "member"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "you": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "structs.OrganizationMemberModify": {
+            "type": "object",
+            "required": [
+                "email",
+                "organization_id",
+                "role"
+            ],
+            "properties": {
+                "email": {
                     "type": "string",
-                    "example": "2021-01-01"
+                    "example": "colleague@example.com"
                 },
-                "id": {
+                "organization_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "name": {
+                "role": {
                     "type": "string",
-                    "example": "John Doe"
+                    "example": "member"
                 }
             }
         },
-        "structs.BirthdayNameDateAdd": {
+        "structs.OrganizationMemberRemove": {
             "type": "object",
             "required": [
-                "date",
-                "name"
+                "organization_id"
             ],
             "properties": {
-                "date": {
+                "email": {
                     "type": "string",
-                    "example": "2021-01-01"
+                    "example": "colleague@example.com"
                 },
-                "name": {
-                    "type": "string",
-                    "example": "John Doe"
+                "organization_id": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "structs.BirthdayNameDateModify": {
+        "structs.OrganizationModify": {
             "type": "object",
             "required": [
-                "date",
                 "id",
                 "name"
             ],
             "properties": {
-                "date": {
-                    "type": "string",
-                    "example": "2021-01-01"
-                },
                 "id": {
                     "type": "integer",
                     "example": 1
                 },
                 "name": {
                     "type": "string",
-                    "example": "John Doe"
+                    "example": "Acme"
                 }
             }
         },
-        "structs.Error": {
+        "structs.Password": {
             "type": "object",
             "properties": {
-                "error": {
+                "password": {
+                    "type": "string",
+                    "example": "9cc76406913372c2b3a3474e8ebb8dc917bdb9c4a7c5e98c639ed20f5bcf4da1"
+                }
+            }
+        },
+        "structs.Ready": {
+            "type": "object",
+            "properties": {
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "structs.LoginRequest": {
+        "structs.RecoveryCodes": {
+            "type": "object",
+            "properties": {
+                "codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "7kqmz-2xw4p",
+                        "b3n5t-q6hya"
+                    ]
+                }
+            }
+        },
+        "structs.RefreshRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string",
+                    "example": "q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"
+                }
+            }
+        },
+        "structs.RegisterRequest": {
             "type": "object",
             "required": [
                 "email",
-                "password"
+                "password",
+                "reminder_time",
+                "telegram_bot_api_key",
+                "telegram_user_id",
+                "timezone"
             ],
             "properties": {
                 "email": {
                     "type": "string",
                     "example": "example@lotiguere.com"
                 },
+                "invite_token": {
+                    "type": "string",
+                    "example": "q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"
+                },
                 "password": {
                     "type": "string",
                     "example": "9cc76406913372c2b3a3474e8ebb8dc917bdb9c4a7c5e98c639ed20f5bcf4da1"
-                }
-            }
-        },
-        "structs.LoginSuccess": {
-            "type": "object",
-            "properties": {
-                "birthdays": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/structs.BirthdayFull"
-                    }
                 },
                 "reminder_time": {
                     "type": "string",
@@ -619,106 +6459,272 @@ const docTemplate = `{
                 "timezone": {
                     "type": "string",
                     "example": "America/New_York"
+                }
+            }
+        },
+        "structs.RevertBirthdayRequest": {
+            "type": "object",
+            "required": [
+                "history_id"
+            ],
+            "properties": {
+                "history_id": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "structs.SessionFull": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T12:00:00Z"
                 },
-                "token": {
-                    "type": "string"
+                "current": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-01-31T12:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "ip": {
+                    "type": "string",
+                    "example": "203.0.113.7"
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "2025-01-02T08:30:00Z"
+                },
+                "user_agent": {
+                    "type": "string",
+                    "example": "Mozilla/5.0"
                 }
             }
         },
-        "structs.ModifyUserRequest": {
+        "structs.SessionID": {
             "type": "object",
             "required": [
-                "new_reminder_time",
-                "new_telegram_bot_api_key",
-                "new_telegram_user_id",
-                "new_timezone"
+                "id"
             ],
             "properties": {
-                "new_email": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "structs.SessionTokens": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
                     "type": "string",
-                    "example": "example2@lotiguere.com"
+                    "example": "2025-01-01T12:15:00Z"
                 },
-                "new_password": {
+                "refresh_token": {
                     "type": "string",
-                    "example": "9cc76406913372c2b3a3474e8ebb8dc917bdb9c4a7c5e98c639ed20f5bcf4da1"
+                    "example": "q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"
                 },
-                "new_reminder_time": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "structs.SharedBirthday": {
+            "type": "object",
+            "properties": {
+                "date": {
                     "type": "string",
-                    "example": "15:04"
+                    "example": "--03-02"
                 },
-                "new_telegram_bot_api_key": {
+                "names": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "John Doe",
+                        "Jane Doe"
+                    ]
+                }
+            }
+        },
+        "structs.SharedList": {
+            "type": "object",
+            "properties": {
+                "birthdays": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/structs.SharedListBirthday"
+                    }
+                },
+                "name": {
                     "type": "string",
-                    "example": "270485614:AAHfiqksKZ8WmR2zSjiQ7jd8Eud81ggE3e-3"
+                    "example": "Family"
+                }
+            }
+        },
+        "structs.SharedListBirthday": {
+            "type": "object",
+            "properties": {
+                "calendar": {
+                    "type": "string",
+                    "example": "gregorian"
                 },
-                "new_telegram_user_id": {
+                "date": {
                     "type": "string",
-                    "example": "123456789"
+                    "example": "1990-03-02"
                 },
-                "new_timezone": {
+                "event_type": {
                     "type": "string",
-                    "example": "America/New_York"
+                    "example": "birthday"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "next_date": {
+                    "type": "string",
+                    "example": "2025-03-02"
+                },
+                "years": {
+                    "type": "integer",
+                    "example": 35
                 }
             }
         },
-        "structs.Password": {
+        "structs.Success": {
             "type": "object",
             "properties": {
-                "password": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "structs.TOTPChallenge": {
+            "type": "object",
+            "properties": {
+                "error": {
                     "type": "string",
-                    "example": "9cc76406913372c2b3a3474e8ebb8dc917bdb9c4a7c5e98c639ed20f5bcf4da1"
+                    "example": "two-factor authentication code required"
+                },
+                "totp_required": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "structs.Ready": {
+        "structs.TOTPCode": {
             "type": "object",
+            "required": [
+                "code"
+            ],
             "properties": {
-                "status": {
-                    "type": "string"
+                "code": {
+                    "type": "string",
+                    "example": "123456"
                 }
             }
         },
-        "structs.RegisterRequest": {
+        "structs.TOTPReauth": {
             "type": "object",
             "required": [
-                "email",
-                "password",
-                "reminder_time",
-                "telegram_bot_api_key",
-                "telegram_user_id",
-                "timezone"
+                "code",
+                "password"
             ],
             "properties": {
-                "email": {
+                "code": {
                     "type": "string",
-                    "example": "example@lotiguere.com"
+                    "example": "123456"
                 },
                 "password": {
                     "type": "string",
                     "example": "9cc76406913372c2b3a3474e8ebb8dc917bdb9c4a7c5e98c639ed20f5bcf4da1"
-                },
-                "reminder_time": {
+                }
+            }
+        },
+        "structs.TOTPSetup": {
+            "type": "object",
+            "properties": {
+                "secret": {
                     "type": "string",
-                    "example": "15:04"
+                    "example": "JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
                 },
-                "telegram_bot_api_key": {
+                "uri": {
                     "type": "string",
-                    "example": "270485614:AAHfiqksKZ8WmR2zSjiQ7jd8Eud81ggE3e-3"
+                    "example": "otpauth://totp/HBD:example@lotiguere.com?algorithm=SHA1\u0026digits=6\u0026issuer=HBD\u0026period=30\u0026secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
+                }
+            }
+        },
+        "structs.TrashedBirthday": {
+            "type": "object",
+            "properties": {
+                "birthday": {
+                    "$ref": "#/definitions/structs.BirthdayFull"
                 },
-                "telegram_user_id": {
+                "deleted_at": {
                     "type": "string",
-                    "example": "123456789"
+                    "example": "2024-03-02T15:04:05Z"
                 },
-                "timezone": {
+                "purge_at": {
                     "type": "string",
-                    "example": "America/New_York"
+                    "example": "2024-04-01T15:04:05Z"
                 }
             }
         },
-        "structs.Success": {
+        "structs.UpcomingBirthday": {
             "type": "object",
             "properties": {
-                "success": {
-                    "type": "boolean"
+                "calendar": {
+                    "type": "string",
+                    "example": "gregorian"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-03-02"
+                },
+                "days_until": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "event_type": {
+                    "type": "string",
+                    "example": "birthday"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "milestone": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "family",
+                        "football club"
+                    ]
+                },
+                "year_known": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "years": {
+                    "type": "integer",
+                    "example": 35
                 }
             }
         },
@@ -735,6 +6741,23 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 1
                 },
+                "milestone_lead_days": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "milestones": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        18,
+                        21,
+                        30,
+                        40,
+                        50
+                    ]
+                },
                 "reminder_time": {
                     "type": "string",
                     "example": "15:04"
@@ -750,6 +6773,31 @@ const docTemplate = `{
                 "timezone": {
                     "type": "string",
                     "example": "America/New_York"
+                },
+                "totp_enabled": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "structs.WeekCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "end": {
+                    "type": "string",
+                    "example": "--03-10"
+                },
+                "start": {
+                    "type": "string",
+                    "example": "--03-04"
+                },
+                "week": {
+                    "type": "integer",
+                    "example": 10
                 }
             }
         }
@@ -3,6 +3,7 @@ package encryption
 import (
 	"crypto/rand"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 
@@ -65,3 +66,12 @@ func HashStringWithSHA256(str string) string {
 	hash.Write([]byte(str))
 	return hex.EncodeToString(hash.Sum(nil))
 }
+
+// random URL-safe token with 256 bits of entropy, only its SHA-256 hash should be stored
+func GenerateToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
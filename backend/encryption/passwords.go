@@ -0,0 +1,124 @@
+package encryption
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Argon2Params are the cost parameters of the Argon2id password hashes
+type Argon2Params struct {
+	// Memory in KiB
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+}
+
+// Default cost parameters, from the second recommended option of RFC 9106 with less parallelism, and the length of
+// the salts and keys in bytes
+const (
+	defaultArgon2Memory      = 64 * 1024
+	defaultArgon2Iterations  = 3
+	defaultArgon2Parallelism = 2
+	argon2SaltLength         = 16
+	argon2KeyLength          = 32
+)
+
+// ErrInvalidPasswordHash is returned when a stored password hash can't be parsed
+var ErrInvalidPasswordHash = errors.New("invalid password hash")
+
+// envUint reads a positive integer from an environment variable, falling back to the default if it's unset or invalid
+func envUint(name string, bits int, def uint64) uint64 {
+	str := os.Getenv(name)
+	if str == "" {
+		return def
+	}
+	parsed, err := strconv.ParseUint(str, 10, bits)
+	if err != nil || parsed == 0 {
+		log.Println("Invalid", name+", using the default of", def)
+		return def
+	}
+	return parsed
+}
+
+// PasswordParams returns the cost parameters of new password hashes, from HBD_ARGON2_MEMORY (in KiB),
+// HBD_ARGON2_ITERATIONS and HBD_ARGON2_PARALLELISM
+func PasswordParams() Argon2Params {
+	return Argon2Params{
+		Memory:      uint32(envUint("HBD_ARGON2_MEMORY", 32, defaultArgon2Memory)),
+		Iterations:  uint32(envUint("HBD_ARGON2_ITERATIONS", 32, defaultArgon2Iterations)),
+		Parallelism: uint8(envUint("HBD_ARGON2_PARALLELISM", 8, defaultArgon2Parallelism)),
+	}
+}
+
+// HashPassword hashes a password with Argon2id and a random salt, in the PHC string format that keeps the parameters
+// along with the hash: $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+func HashPassword(password string) (string, error) {
+	params := PasswordParams()
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, argon2KeyLength)
+	return fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, params.Memory, params.Iterations, params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// parsePasswordHash parses an Argon2id hash in the PHC string format
+func parsePasswordHash(encoded string) (Argon2Params, []byte, []byte, error) {
+	var params Argon2Params
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return params, nil, nil, ErrInvalidPasswordHash
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, ErrInvalidPasswordHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, ErrInvalidPasswordHash
+	}
+	// Argon2 panics with zero cost parameters, and trailing text after them would be ignored by Sscanf
+	if params.Memory == 0 || params.Iterations == 0 || params.Parallelism == 0 ||
+		parts[3] != fmt.Sprintf("m=%d,t=%d,p=%d", params.Memory, params.Iterations, params.Parallelism) {
+		return params, nil, nil, ErrInvalidPasswordHash
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, ErrInvalidPasswordHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, ErrInvalidPasswordHash
+	}
+	return params, salt, key, nil
+}
+
+// VerifyPassword checks a password against its stored hash. Hashes from before Argon2id are unsalted SHA-256 hashes,
+// which are still accepted so users can log in and be migrated. The password needs rehashing when it matches a legacy
+// hash or a hash with other cost parameters than the current ones.
+func VerifyPassword(password, encoded string) (match bool, needsRehash bool, err error) {
+	if !strings.HasPrefix(encoded, "$") {
+		match = subtle.ConstantTimeCompare([]byte(HashStringWithSHA256(password)), []byte(encoded)) == 1
+		return match, match, nil
+	}
+
+	params, salt, key, err := parsePasswordHash(encoded)
+	if err != nil {
+		return false, false, err
+	}
+	candidate := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+	match = subtle.ConstantTimeCompare(candidate, key) == 1
+	return match, match && params != PasswordParams(), nil
+}
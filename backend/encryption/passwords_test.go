@@ -0,0 +1,153 @@
+package encryption
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// useTestParams makes the password hashes cheap for the duration of the test
+func useTestParams(t *testing.T, memory, iterations, parallelism string) {
+	t.Setenv("HBD_ARGON2_MEMORY", memory)
+	t.Setenv("HBD_ARGON2_ITERATIONS", iterations)
+	t.Setenv("HBD_ARGON2_PARALLELISM", parallelism)
+}
+
+func TestHashPassword(t *testing.T) {
+	useTestParams(t, "64", "1", "1")
+
+	tests := []string{"hunter2", "", "pässwörd with spaces", strings.Repeat("long", 100)}
+	for _, password := range tests {
+		hash, err := HashPassword(password)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(hash, "$argon2id$v=19$m=64,t=1,p=1$") {
+			t.Errorf("HashPassword(%q) = %s, not in the PHC format with the current parameters", password, hash)
+		}
+
+		match, needsRehash, err := VerifyPassword(password, hash)
+		if err != nil || !match || needsRehash {
+			t.Errorf("VerifyPassword(%q) with its hash = %v, %v, %v, want a match without rehashing", password, match, needsRehash, err)
+		}
+		match, needsRehash, err = VerifyPassword(password+"x", hash)
+		if err != nil || match || needsRehash {
+			t.Errorf("VerifyPassword(%q) with the hash of %q = %v, %v, %v, want no match", password+"x", password, match, needsRehash, err)
+		}
+	}
+
+	// The same password gets a different salt each time
+	first, _ := HashPassword("hunter2")
+	second, _ := HashPassword("hunter2")
+	if first == second {
+		t.Error("HashPassword() returned the same hash twice")
+	}
+}
+
+func TestParsePasswordHash(t *testing.T) {
+	salt, key := "c2FsdHNhbHRzYWx0c2FsdA", "a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U"
+	valid := "$argon2id$v=19$m=64,t=1,p=1$" + salt + "$" + key
+	params, _, _, err := parsePasswordHash(valid)
+	if err != nil || params != (Argon2Params{Memory: 64, Iterations: 1, Parallelism: 1}) {
+		t.Fatalf("parsePasswordHash(%s) = %v, %v", valid, params, err)
+	}
+
+	tests := []struct {
+		name    string
+		encoded string
+	}{
+		{"empty", ""},
+		{"missing parts", "$argon2id$v=19$m=64,t=1,p=1$" + salt},
+		{"extra parts", valid + "$extra"},
+		{"argon2i", "$argon2i$v=19$m=64,t=1,p=1$" + salt + "$" + key},
+		{"bcrypt", "$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy"},
+		{"old version", "$argon2id$v=16$m=64,t=1,p=1$" + salt + "$" + key},
+		{"missing version", "$argon2id$m=64,t=1,p=1$" + salt + "$" + key + "$"},
+		{"parameters out of order", "$argon2id$v=19$t=1,m=64,p=1$" + salt + "$" + key},
+		{"zero memory", "$argon2id$v=19$m=0,t=1,p=1$" + salt + "$" + key},
+		{"zero iterations", "$argon2id$v=19$m=64,t=0,p=1$" + salt + "$" + key},
+		{"zero parallelism", "$argon2id$v=19$m=64,t=1,p=0$" + salt + "$" + key},
+		{"parallelism overflow", "$argon2id$v=19$m=64,t=1,p=256$" + salt + "$" + key},
+		{"trailing parameters", "$argon2id$v=19$m=64,t=1,p=1,k=2$" + salt + "$" + key},
+		{"invalid salt", "$argon2id$v=19$m=64,t=1,p=1$not base64!$" + key},
+		{"padded salt", "$argon2id$v=19$m=64,t=1,p=1$" + salt + "==$" + key},
+		{"invalid key", "$argon2id$v=19$m=64,t=1,p=1$" + salt + "$not base64!"},
+		{"empty key", "$argon2id$v=19$m=64,t=1,p=1$" + salt + "$"},
+	}
+	for _, tt := range tests {
+		if _, _, _, err := parsePasswordHash(tt.encoded); !errors.Is(err, ErrInvalidPasswordHash) {
+			t.Errorf("%s: parsePasswordHash(%q) error = %v, want ErrInvalidPasswordHash", tt.name, tt.encoded, err)
+		}
+		// Malformed hashes never match, whatever the password
+		if match, _, err := VerifyPassword("hunter2", tt.encoded); match || (err == nil && strings.HasPrefix(tt.encoded, "$")) {
+			t.Errorf("%s: VerifyPassword() = %v, %v, want no match and an error", tt.name, match, err)
+		}
+	}
+}
+
+func TestVerifyLegacyPassword(t *testing.T) {
+	useTestParams(t, "64", "1", "1")
+
+	// Passwords from before Argon2id were stored as unsalted SHA-256 hashes
+	legacy := HashStringWithSHA256("hunter2")
+	tests := []struct {
+		password    string
+		match       bool
+		needsRehash bool
+	}{
+		{"hunter2", true, true},
+		{"Hunter2", false, false},
+		{"", false, false},
+	}
+	for _, tt := range tests {
+		match, needsRehash, err := VerifyPassword(tt.password, legacy)
+		if err != nil || match != tt.match || needsRehash != tt.needsRehash {
+			t.Errorf("VerifyPassword(%q) with a legacy hash = %v, %v, %v, want %v, %v", tt.password, match, needsRehash, err, tt.match, tt.needsRehash)
+		}
+	}
+}
+
+func TestPasswordRehash(t *testing.T) {
+	useTestParams(t, "64", "1", "1")
+	hash, err := HashPassword("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name                            string
+		memory, iterations, parallelism string
+		needsRehash                     bool
+	}{
+		{"same parameters", "64", "1", "1", false},
+		{"more memory", "128", "1", "1", true},
+		{"more iterations", "64", "2", "1", true},
+		{"more parallelism", "64", "1", "2", true},
+		{"less memory", "32", "1", "1", true},
+		{"invalid value uses the default", "64", "zero", "1", true},
+	}
+	for _, tt := range tests {
+		useTestParams(t, tt.memory, tt.iterations, tt.parallelism)
+		match, needsRehash, err := VerifyPassword("hunter2", hash)
+		if err != nil || !match || needsRehash != tt.needsRehash {
+			t.Errorf("%s: VerifyPassword() = %v, %v, %v, want a match with needsRehash %v", tt.name, match, needsRehash, err, tt.needsRehash)
+		}
+		// A wrong password never needs rehashing
+		if _, needsRehash, _ = VerifyPassword("wrong", hash); needsRehash {
+			t.Errorf("%s: VerifyPassword() of a wrong password needs rehashing", tt.name)
+		}
+	}
+
+	// The new hash has the new parameters and doesn't need rehashing again
+	useTestParams(t, "128", "2", "1")
+	rehashed, err := HashPassword("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(rehashed, "$m=128,t=2,p=1$") {
+		t.Errorf("HashPassword() = %s, want the new parameters", rehashed)
+	}
+	if match, needsRehash, _ := VerifyPassword("hunter2", rehashed); !match || needsRehash {
+		t.Errorf("VerifyPassword() of the rehashed password = %v, %v, want a match without rehashing", match, needsRehash)
+	}
+}
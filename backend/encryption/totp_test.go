@@ -0,0 +1,141 @@
+package encryption
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Secret of the RFC 6238 test vectors, the ASCII string "12345678901234567890" in base32
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// SHA-1 test vectors from RFC 6238 appendix B, the codes are the last 6 digits of the published 8 digit codes
+var totpVectors = []struct {
+	unix int64
+	code string
+}{
+	{59, "287082"},
+	{1111111109, "081804"},
+	{1111111111, "050471"},
+	{1234567890, "005924"},
+	{2000000000, "279037"},
+	{20000000000, "353130"},
+}
+
+func TestTOTPCode(t *testing.T) {
+	for _, v := range totpVectors {
+		code, err := TOTPCode(rfcSecret, TOTPStep(time.Unix(v.unix, 0)))
+		if err != nil {
+			t.Fatalf("TOTPCode at %d: %v", v.unix, err)
+		}
+		if code != v.code {
+			t.Errorf("TOTPCode at %d = %s, want %s", v.unix, code, v.code)
+		}
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	// Fixed clock in the middle of step 37037037
+	now := time.Unix(1111111111, 0)
+	current := TOTPStep(now)
+	codeAt := func(step int64) string {
+		code, err := TOTPCode(rfcSecret, step)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return code
+	}
+
+	tests := []struct {
+		name     string
+		code     string
+		lastStep int64
+		step     int64
+		ok       bool
+	}{
+		{"current step", codeAt(current), 0, current, true},
+		{"previous step", codeAt(current - 1), 0, current - 1, true},
+		{"next step", codeAt(current + 1), 0, current + 1, true},
+		{"too old", codeAt(current - 2), 0, 0, false},
+		{"too new", codeAt(current + 2), 0, 0, false},
+		{"spaces are ignored", codeAt(current)[:3] + " " + codeAt(current)[3:], 0, current, true},
+		{"replayed code", codeAt(current), current, 0, false},
+		{"code of a step before the last used one", codeAt(current - 1), current - 1, 0, false},
+		{"later code after a used one", codeAt(current + 1), current, current + 1, true},
+		{"wrong length", codeAt(current)[:5], 0, 0, false},
+		{"empty", "", 0, 0, false},
+	}
+	for _, tt := range tests {
+		step, ok := ValidateTOTP(rfcSecret, tt.code, now, tt.lastStep)
+		if ok != tt.ok || step != tt.step {
+			t.Errorf("%s: ValidateTOTP(%q) = %d, %v, want %d, %v", tt.name, tt.code, step, ok, tt.step, tt.ok)
+		}
+	}
+
+	// The published code at the time of the vector is accepted with the same clock
+	if _, ok := ValidateTOTP(rfcSecret, "050471", now, 0); !ok {
+		t.Error("ValidateTOTP rejected the RFC 6238 code at its own time")
+	}
+}
+
+func TestGenerateTOTPSecret(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := base32NoPadding.DecodeString(secret)
+	if err != nil || len(key) != totpSecretLength {
+		t.Fatalf("GenerateTOTPSecret() = %q, not a base32 secret of %d bytes", secret, totpSecretLength)
+	}
+
+	// A code computed with the new secret validates at the same fixed time
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	code, err := TOTPCode(secret, TOTPStep(now))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := ValidateTOTP(secret, code, now, 0); !ok {
+		t.Errorf("ValidateTOTP rejected a code of a generated secret")
+	}
+}
+
+func TestTOTPURI(t *testing.T) {
+	uri := TOTPURI(rfcSecret, "HBD", "example@lotiguere.com")
+	want := "otpauth://totp/HBD:example@lotiguere.com?algorithm=SHA1&digits=6&issuer=HBD&period=30&secret=" + rfcSecret
+	if uri != want {
+		t.Errorf("TOTPURI() = %s, want %s", uri, want)
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != RecoveryCodeCount {
+		t.Fatalf("GenerateRecoveryCodes() returned %d codes, want %d", len(codes), RecoveryCodeCount)
+	}
+	format := regexp.MustCompile(`^[a-z2-7]{5}-[a-z2-7]{5}$`)
+	seen := map[string]bool{}
+	for _, code := range codes {
+		if !format.MatchString(code) {
+			t.Errorf("recovery code %q doesn't match %s", code, format)
+		}
+		if seen[code] {
+			t.Errorf("recovery code %q is repeated", code)
+		}
+		seen[code] = true
+	}
+
+	// The hash ignores case, spaces and dashes
+	hash := HashRecoveryCode(codes[0])
+	for _, variant := range []string{strings.ToUpper(codes[0]), strings.ReplaceAll(codes[0], "-", ""), strings.ReplaceAll(codes[0], "-", " ")} {
+		if HashRecoveryCode(variant) != hash {
+			t.Errorf("HashRecoveryCode(%q) differs from the hash of %q", variant, codes[0])
+		}
+	}
+	if HashRecoveryCode(codes[1]) == hash {
+		t.Error("different recovery codes have the same hash")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
@@ -57,7 +58,7 @@ func db() *sql.DB {
 	if DBType() == "postgres" {
 		db, err = sql.Open("postgres", databaseURL)
 	} else {
-		db, err = sql.Open("sqlite3", databaseURL)
+		db, err = sql.Open("sqlite3", sqliteDSN(databaseURL))
 	}
 
 	if err != nil {
@@ -67,6 +68,18 @@ func db() *sql.DB {
 	return db
 }
 
+// sqliteDSN turns on the foreign keys of every SQLite connection, they're off by default and the migrations rely on
+// them to delete the rows of users, lists and organizations along with them
+func sqliteDSN(databaseURL string) string {
+	if strings.Contains(databaseURL, "_foreign_keys=") || strings.Contains(databaseURL, "_fk=") {
+		return databaseURL
+	}
+	if strings.Contains(databaseURL, "?") {
+		return databaseURL + "&_foreign_keys=on"
+	}
+	return databaseURL + "?_foreign_keys=on"
+}
+
 // Custom domain for CORS
 func customDomain() string {
 	loadDotenv()
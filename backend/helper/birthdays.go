@@ -0,0 +1,40 @@
+package helper
+
+import (
+	"sort"
+
+	"hbd/models"
+	"hbd/structs"
+)
+
+// BirthdayFull converts a Birthday model into its response representation, the tags are taken from the loaded relationship
+func BirthdayFull(b *models.Birthday) structs.BirthdayFull {
+	return structs.BirthdayFull{
+		ID:               b.ID.Int64,
+		Name:             b.Name,
+		Date:             FormatBirthdayDate(b.Date, b.YearKnown),
+		YearKnown:        b.YearKnown,
+		EventType:        b.EventType,
+		YearsLabel:       b.YearsLabel.String,
+		Calendar:         b.Calendar,
+		CalendarDate:     FormatCalendarDate(b.Calendar, b.Date, b.YearKnown),
+		Notes:            b.Notes.String,
+		Tags:             BirthdayTags(b),
+		TelegramUsername: b.TelegramUsername.String,
+		Phone:            b.Phone.String,
+		Email:            b.Email.String,
+		ListID:           b.ListID.Int64,
+	}
+}
+
+// BirthdayTags returns the sorted tags of a birthday from the loaded relationship
+func BirthdayTags(b *models.Birthday) []string {
+	tags := []string{}
+	if b.R != nil {
+		for _, tag := range b.R.BirthdayTags {
+			tags = append(tags, tag.Tag)
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
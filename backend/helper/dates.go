@@ -0,0 +1,58 @@
+package helper
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"hbd/calendars"
+)
+
+// Layouts for birthdays with a known year (YYYY-MM-DD) and with an unknown year (--MM-DD, as in ISO 8601)
+const (
+	DateLayout        = "2006-01-02"
+	PartialDateLayout = "--01-02"
+)
+
+// ParseBirthdayDate parses a birthday date, either a full date or a date without year.
+// Dates with year 0000 are also treated as dates without year.
+// Dates without year are returned with year 0, which is a leap year, so February 29 is accepted.
+func ParseBirthdayDate(str string) (time.Time, bool, error) {
+	if strings.HasPrefix(str, "--") {
+		date, err := time.Parse(PartialDateLayout, str)
+		if err != nil {
+			return time.Time{}, false, errors.New("invalid date format, should be YYYY-MM-DD or --MM-DD")
+		}
+		return date, false, nil
+	}
+
+	date, err := time.Parse(DateLayout, str)
+	if err != nil {
+		return time.Time{}, false, errors.New("invalid date format, should be YYYY-MM-DD or --MM-DD")
+	}
+	if date.Year() == 0 {
+		return date, false, nil
+	}
+
+	return date, true, nil
+}
+
+// FormatBirthdayDate formats a birthday date as YYYY-MM-DD, or --MM-DD if the year is unknown
+func FormatBirthdayDate(date time.Time, yearKnown bool) string {
+	if !yearKnown {
+		return date.Format(PartialDateLayout)
+	}
+	return date.Format(DateLayout)
+}
+
+// FormatCalendarDate formats a birthday date in its calendar system, it returns an empty string for the Gregorian calendar
+func FormatCalendarDate(calendar string, date time.Time, yearKnown bool) string {
+	if calendar == calendars.Gregorian || !yearKnown {
+		return ""
+	}
+	calendarDate, err := calendars.FromGregorian(calendar, date)
+	if err != nil {
+		return ""
+	}
+	return calendars.Format(calendar, calendarDate)
+}
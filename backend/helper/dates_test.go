@@ -0,0 +1,73 @@
+package helper
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseBirthdayDate(t *testing.T) {
+	tests := []struct {
+		str       string
+		date      string
+		yearKnown bool
+		ok        bool
+	}{
+		{"1990-03-02", "1990-03-02", true, true},
+		{"2000-02-29", "2000-02-29", true, true},
+		{"--04-12", "0000-04-12", false, true},
+		{"--02-29", "0000-02-29", false, true},
+		{"0000-04-12", "0000-04-12", false, true},
+		{"0000-02-29", "0000-02-29", false, true},
+		// Clients use the current year for an unknown year, so dates later this year or in the future are valid
+		{"2999-12-25", "2999-12-25", true, true},
+		{"1900-02-29", "", false, false},
+		{"--02-30", "", false, false},
+		{"--13-01", "", false, false},
+		{"--4-12", "", false, false},
+		{"1990-3-2", "", false, false},
+		{"02/03/1990", "", false, false},
+		{"", "", false, false},
+	}
+	for _, tt := range tests {
+		date, yearKnown, err := ParseBirthdayDate(tt.str)
+		if (err == nil) != tt.ok {
+			t.Errorf("ParseBirthdayDate(%q) error = %v, want ok %v", tt.str, err, tt.ok)
+			continue
+		}
+		if !tt.ok {
+			continue
+		}
+		if date.Format(DateLayout) != tt.date || yearKnown != tt.yearKnown {
+			t.Errorf("ParseBirthdayDate(%q) = %s, %v, want %s, %v", tt.str, date.Format(DateLayout), yearKnown, tt.date, tt.yearKnown)
+		}
+	}
+}
+
+func TestFormatBirthdayDate(t *testing.T) {
+	tests := []struct {
+		date      time.Time
+		yearKnown bool
+		str       string
+	}{
+		{time.Date(1990, 3, 2, 0, 0, 0, 0, time.UTC), true, "1990-03-02"},
+		{time.Date(1990, 3, 2, 0, 0, 0, 0, time.UTC), false, "--03-02"},
+		{time.Date(0, 2, 29, 0, 0, 0, 0, time.UTC), false, "--02-29"},
+		{time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC), true, "2000-02-29"},
+	}
+	for _, tt := range tests {
+		if str := FormatBirthdayDate(tt.date, tt.yearKnown); str != tt.str {
+			t.Errorf("FormatBirthdayDate(%s, %v) = %s, want %s", tt.date.Format(DateLayout), tt.yearKnown, str, tt.str)
+		}
+	}
+
+	// Parsing and formatting again gives the same date, including year 0000 rows, which are formatted as partial dates
+	for str, want := range map[string]string{"1990-03-02": "1990-03-02", "--02-29": "--02-29", "0000-02-29": "--02-29"} {
+		date, yearKnown, err := ParseBirthdayDate(str)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := FormatBirthdayDate(date, yearKnown); got != want {
+			t.Errorf("FormatBirthdayDate(ParseBirthdayDate(%q)) = %s, want %s", str, got, want)
+		}
+	}
+}
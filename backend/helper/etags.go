@@ -0,0 +1,66 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"hbd/structs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// ErrVersionConflict is returned when a row was changed by another request since it was loaded
+var ErrVersionConflict = errors.New("the resource was changed by another request")
+
+// ETag returns the entity tag of a resource from its version
+func ETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// PreconditionFailed checks the If-Match header of the request against the current entity tag of the resource,
+// responding with 412 Precondition Failed if it doesn't match. Requests without If-Match are always allowed.
+func PreconditionFailed(c *gin.Context, etag string) bool {
+	header := c.GetHeader("If-Match")
+	if header == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || candidate == etag {
+			return false
+		}
+	}
+	c.JSON(http.StatusPreconditionFailed, structs.Error{Error: ErrVersionConflict.Error()})
+	return true
+}
+
+// BumpVersion increments the version of a row only if it still has the version it was loaded with,
+// so concurrent changes can't overwrite each other. The table name must not come from user input.
+func BumpVersion(ctx context.Context, exec boil.ContextExecutor, table string, id int64, version int64) error {
+	result, err := queries.Raw("UPDATE "+table+" SET version = version + 1 WHERE id = $1 AND version = $2", id, version).ExecContext(ctx, exec)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+// VersionHE handles errors of changes guarded by a version, responding with 412 Precondition Failed on version conflicts
+// and with 500 and the given message on any other error
+func VersionHE(c *gin.Context, err error, message string) bool {
+	if errors.Is(err, ErrVersionConflict) {
+		return HE(c, err, http.StatusPreconditionFailed, message, true)
+	}
+	return HE(c, err, http.StatusInternalServerError, message, false)
+}
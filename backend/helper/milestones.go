@@ -0,0 +1,42 @@
+package helper
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/volatiletech/null/v8"
+)
+
+// DefaultMilestones are the ages treated as milestones when the user hasn't chosen their own: 18, 21, then every decade from 30
+var DefaultMilestones = []int{18, 21, 30, 40, 50, 60, 70, 80, 90, 100}
+
+// ParseMilestones reads the comma separated milestone ages of a user, falling back to the default set if they're not set or invalid.
+// An empty list means the user has no milestones.
+func ParseMilestones(str null.String) []int {
+	if !str.Valid {
+		return DefaultMilestones
+	}
+	milestones := []int{}
+	if str.String == "" {
+		return milestones
+	}
+	for _, part := range strings.Split(str.String, ",") {
+		age, err := strconv.Atoi(part)
+		if err != nil {
+			return DefaultMilestones
+		}
+		milestones = append(milestones, age)
+	}
+	sort.Ints(milestones)
+	return milestones
+}
+
+// FormatMilestones stores milestone ages as a comma separated list
+func FormatMilestones(milestones []int) string {
+	parts := make([]string, len(milestones))
+	for i, age := range milestones {
+		parts[i] = strconv.Itoa(age)
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,129 @@
+package ics
+
+import (
+	"bufio"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Event holds the properties of a VEVENT that are relevant for birthdays
+type Event struct {
+	Summary string
+	Start   time.Time
+	RRule   string
+	// OmitYear is set when the year of the start date is a placeholder (X-APPLE-OMIT-YEAR or year 0000)
+	OmitYear bool
+}
+
+// Parse reads the VEVENT components from the content of an ICS file.
+// Folded lines are unfolded and escaped text values are unescaped, any other component is ignored.
+func Parse(content string) ([]Event, error) {
+	lines := unfold(content)
+
+	var events []Event
+	var current *Event
+	for _, line := range lines {
+		name, params, value, ok := splitProperty(line)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			current = &Event{}
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if current != nil {
+				events = append(events, *current)
+			}
+			current = nil
+		case current == nil:
+			continue
+		case name == "SUMMARY":
+			current.Summary = unescapeText(value)
+		case name == "DTSTART":
+			start, err := parseDate(value, params)
+			if err == nil {
+				current.Start = start
+				current.OmitYear = start.Year() == 0 || params["X-APPLE-OMIT-YEAR"] == start.Format("2006")
+			}
+		case name == "RRULE":
+			current.RRule = strings.ToUpper(value)
+		}
+	}
+
+	if len(events) == 0 && !strings.Contains(strings.ToUpper(content), "BEGIN:VCALENDAR") {
+		return nil, errors.New("not a valid ICS file")
+	}
+
+	return events, nil
+}
+
+// IsYearly checks if the event recurs every year, either as FREQ=YEARLY or as FREQ=MONTHLY every 12 months
+func (e Event) IsYearly() bool {
+	rule := map[string]string{}
+	for _, part := range strings.Split(e.RRule, ";") {
+		key, value, found := strings.Cut(part, "=")
+		if found {
+			rule[key] = value
+		}
+	}
+
+	switch rule["FREQ"] {
+	case "YEARLY":
+		return rule["INTERVAL"] == "" || rule["INTERVAL"] == "1"
+	case "MONTHLY":
+		return rule["INTERVAL"] == "12"
+	}
+	return false
+}
+
+// unfold joins the lines that were folded following RFC 5545 (continuation lines start with a space or a tab)
+func unfold(content string) []string {
+	var lines []string
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// splitProperty splits a content line into its name, parameters and value
+func splitProperty(line string) (string, map[string]string, string, bool) {
+	head, value, found := strings.Cut(line, ":")
+	if !found {
+		return "", nil, "", false
+	}
+
+	parts := strings.Split(head, ";")
+	params := map[string]string{}
+	for _, param := range parts[1:] {
+		key, paramValue, _ := strings.Cut(param, "=")
+		params[strings.ToUpper(key)] = strings.Trim(paramValue, `"`)
+	}
+
+	return strings.ToUpper(parts[0]), params, value, true
+}
+
+// parseDate parses DATE and DATE-TIME values, only the date part is kept
+func parseDate(value string, params map[string]string) (time.Time, error) {
+	if len(value) < 8 {
+		return time.Time{}, errors.New("invalid date")
+	}
+	if params["VALUE"] != "" && params["VALUE"] != "DATE" && params["VALUE"] != "DATE-TIME" {
+		return time.Time{}, errors.New("invalid date")
+	}
+	return time.Parse("20060102", value[:8])
+}
+
+// unescapeText reverts the escaping of TEXT values
+func unescapeText(value string) string {
+	replacer := strings.NewReplacer(`\\`, `\`, `\;`, `;`, `\,`, `,`, `\n`, " ", `\N`, " ")
+	return strings.TrimSpace(replacer.Replace(value))
+}
@@ -0,0 +1,261 @@
+package ics
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Export of Google Calendar's Birthdays calendar, with CRLF line endings, folded lines, an escaped comma and an invalid
+// date, which is left unset. The last event has the X-APPLE-OMIT-YEAR parameter that marks dates without a year.
+const googleBirthdays = "BEGIN:VCALENDAR\r\n" +
+	"PRODID:-//Google Inc//Google Calendar 70.9054//EN\r\n" +
+	"VERSION:2.0\r\n" +
+	"CALSCALE:GREGORIAN\r\n" +
+	"METHOD:PUBLISH\r\n" +
+	"X-WR-CALNAME:Birthdays\r\n" +
+	"X-WR-TIMEZONE:Europe/Madrid\r\n" +
+	"X-WR-CALDESC:Displays birthdays\\, anniversaries\\, and other event dates of p\r\n" +
+	" eople in Google Contacts.\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:19900302\r\n" +
+	"DTEND;VALUE=DATE:19900303\r\n" +
+	"RRULE:FREQ=YEARLY\r\n" +
+	"DTSTAMP:20240601T101500Z\r\n" +
+	"UID:2_c1f0a8e60a2b4f2f@google.com\r\n" +
+	"X-GOOGLE-CALENDAR-CONTENT-DISPLAY:chip\r\n" +
+	"X-GOOGLE-CALENDAR-CONTENT-ICON:https://calendar.google.com/googlecalendar/i\r\n" +
+	" mages/cake.gif\r\n" +
+	"CLASS:PUBLIC\r\n" +
+	"DESCRIPTION:Happy birthday!\\nVisit the contact in Google Contacts.\r\n" +
+	"SEQUENCE:0\r\n" +
+	"STATUS:CONFIRMED\r\n" +
+	"SUMMARY:John Doe's birthday\r\n" +
+	"TRANSP:TRANSPARENT\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:20100229\r\n" +
+	"DTEND;VALUE=DATE:20100301\r\n" +
+	"RRULE:FREQ=YEARLY\r\n" +
+	"UID:2_5d8c3b7e41f04a1e@google.com\r\n" +
+	"SUMMARY:Smith\\, Jane's birthday\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:20080229\r\n" +
+	"RRULE:FREQ=YEARLY;INTERVAL=1\r\n" +
+	"UID:2_9a1c22f0be6e4d55@google.com\r\n" +
+	"SUMMARY:Leap Day Lee's birthday\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE;X-APPLE-OMIT-YEAR=1604:16040412\r\n" +
+	"RRULE:FREQ=YEARLY\r\n" +
+	"SUMMARY:Maria's birthday\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+// Export of a Thunderbird calendar with LF line endings, a timezone, a birthday that repeats every 12 months, a
+// summary folded with a tab, a birthday with a DATE-TIME start and events that aren't birthdays
+const thunderbirdCalendar = `BEGIN:VCALENDAR
+PRODID:-//Mozilla.org/NONSGML Mozilla Calendar V1.1//EN
+VERSION:2.0
+BEGIN:VTIMEZONE
+TZID:Europe/Berlin
+BEGIN:DAYLIGHT
+TZOFFSETFROM:+0100
+TZOFFSETTO:+0200
+TZNAME:CEST
+DTSTART:19700329T020000
+RRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=-1SU
+END:DAYLIGHT
+END:VTIMEZONE
+BEGIN:VEVENT
+CREATED:20240105T101010Z
+LAST-MODIFIED:20240105T101046Z
+DTSTAMP:20240105T101046Z
+UID:0f3b6a2c-7d1e-4c5b-9f0a-2e8d4c6b1a90
+SUMMARY:Birthday of Anna Müller
+RRULE:FREQ=YEARLY
+DTSTART;VALUE=DATE:19851231
+DTEND;VALUE=DATE:19860101
+TRANSP:TRANSPARENT
+X-MOZ-GENERATION:1
+END:VEVENT
+BEGIN:VEVENT
+UID:5b1a9e7c-2f4d-4e8a-b6c3-7d9f0e1a2b3c
+SUMMARY:Birthday of Bartholomew Alexander
+	 Fitzgerald-Smythe
+RRULE:FREQ=MONTHLY;INTERVAL=12
+DTSTART;VALUE=DATE:19770115
+END:VEVENT
+BEGIN:VEVENT
+UID:8c2d4e6f-1a3b-4c5d-8e9f-0a1b2c3d4e5f
+SUMMARY:Birthday of Carl
+RRULE:FREQ=YEARLY
+DTSTART;TZID=Europe/Berlin:19920704T090000
+DTEND;TZID=Europe/Berlin:19920704T100000
+END:VEVENT
+BEGIN:VEVENT
+UID:a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d
+SUMMARY:Team meeting
+RRULE:FREQ=WEEKLY;BYDAY=MO
+DTSTART;TZID=Europe/Berlin:20240108T100000
+END:VEVENT
+BEGIN:VEVENT
+UID:f0e1d2c3-b4a5-4968-8776-655443322110
+SUMMARY:Dentist
+DTSTART;VALUE=DATE:20240312
+END:VEVENT
+END:VCALENDAR
+`
+
+func date(str string) time.Time {
+	d, err := time.Parse("2006-01-02", str)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		events  []Event
+		yearly  []bool
+	}{
+		{"google", googleBirthdays, []Event{
+			{Summary: "John Doe's birthday", Start: date("1990-03-02"), RRule: "FREQ=YEARLY"},
+			{Summary: "Smith, Jane's birthday", RRule: "FREQ=YEARLY"},
+			{Summary: "Leap Day Lee's birthday", Start: date("2008-02-29"), RRule: "FREQ=YEARLY;INTERVAL=1"},
+			{Summary: "Maria's birthday", Start: date("1604-04-12"), RRule: "FREQ=YEARLY", OmitYear: true},
+		}, []bool{true, true, true, true}},
+		{"thunderbird", thunderbirdCalendar, []Event{
+			{Summary: "Birthday of Anna Müller", Start: date("1985-12-31"), RRule: "FREQ=YEARLY"},
+			{Summary: "Birthday of Bartholomew Alexander Fitzgerald-Smythe", Start: date("1977-01-15"), RRule: "FREQ=MONTHLY;INTERVAL=12"},
+			{Summary: "Birthday of Carl", Start: date("1992-07-04"), RRule: "FREQ=YEARLY"},
+			{Summary: "Team meeting", Start: date("2024-01-08"), RRule: "FREQ=WEEKLY;BYDAY=MO"},
+			{Summary: "Dentist", Start: date("2024-03-12")},
+		}, []bool{true, true, true, false, false}},
+	}
+	for _, tt := range tests {
+		events, err := Parse(tt.content)
+		if err != nil {
+			t.Fatalf("%s: Parse() error: %v", tt.name, err)
+		}
+		if !reflect.DeepEqual(events, tt.events) {
+			t.Errorf("%s: Parse() = %+v, want %+v", tt.name, events, tt.events)
+			continue
+		}
+		for i, event := range events {
+			if event.IsYearly() != tt.yearly[i] {
+				t.Errorf("%s: IsYearly() of %q = %v, want %v", tt.name, event.Summary, event.IsYearly(), tt.yearly[i])
+			}
+		}
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		value  string
+		params map[string]string
+		want   time.Time
+		ok     bool
+	}{
+		{"19900302", map[string]string{"VALUE": "DATE"}, date("1990-03-02"), true},
+		{"19900302", map[string]string{}, date("1990-03-02"), true},
+		{"19900302T235959Z", map[string]string{"VALUE": "DATE-TIME"}, date("1990-03-02"), true},
+		{"19900302T090000", map[string]string{"TZID": "Europe/Berlin"}, date("1990-03-02"), true},
+		{"00000412", map[string]string{"VALUE": "DATE"}, date("0000-04-12"), true},
+		{"20000229", map[string]string{}, date("2000-02-29"), true},
+		{"19000229", map[string]string{}, time.Time{}, false},
+		{"1990030", map[string]string{}, time.Time{}, false},
+		{"1990-03-02", map[string]string{}, time.Time{}, false},
+		{"19900302", map[string]string{"VALUE": "PERIOD"}, time.Time{}, false},
+	}
+	for _, tt := range tests {
+		got, err := parseDate(tt.value, tt.params)
+		if (err == nil) != tt.ok || !got.Equal(tt.want) {
+			t.Errorf("parseDate(%q, %v) = %v, %v, want %v, ok %v", tt.value, tt.params, got, err, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestOmitYear(t *testing.T) {
+	tests := []struct {
+		dtstart  string
+		omitYear bool
+	}{
+		{"DTSTART;VALUE=DATE;X-APPLE-OMIT-YEAR=1604:16040412", true},
+		{"DTSTART;VALUE=DATE:00000412", true},
+		{"DTSTART;VALUE=DATE;X-APPLE-OMIT-YEAR=1604:19900412", false},
+		{"DTSTART;VALUE=DATE:16040412", false},
+	}
+	for _, tt := range tests {
+		events, err := Parse("BEGIN:VCALENDAR\nBEGIN:VEVENT\n" + tt.dtstart + "\nEND:VEVENT\nEND:VCALENDAR\n")
+		if err != nil || len(events) != 1 {
+			t.Fatalf("Parse(%s) = %v, %v", tt.dtstart, events, err)
+		}
+		if events[0].OmitYear != tt.omitYear {
+			t.Errorf("OmitYear of %s = %v, want %v", tt.dtstart, events[0].OmitYear, tt.omitYear)
+		}
+	}
+}
+
+func TestUnfold(t *testing.T) {
+	tests := []struct {
+		content string
+		lines   []string
+	}{
+		{"A:1\r\nB:2\r\n", []string{"A:1", "B:2"}},
+		{"A:1\nB:2", []string{"A:1", "B:2"}},
+		{"SUMMARY:John\r\n  Doe\r\n", []string{"SUMMARY:John Doe"}},
+		{"SUMMARY:Jo\n\thn\n Doe\n", []string{"SUMMARY:JohnDoe"}},
+		{" leading continuation\nA:1", []string{" leading continuation", "A:1"}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if lines := unfold(tt.content); !reflect.DeepEqual(lines, tt.lines) {
+			t.Errorf("unfold(%q) = %q, want %q", tt.content, lines, tt.lines)
+		}
+	}
+}
+
+func TestIsYearly(t *testing.T) {
+	tests := []struct {
+		rrule  string
+		yearly bool
+	}{
+		{"FREQ=YEARLY", true},
+		{"FREQ=YEARLY;INTERVAL=1", true},
+		{"FREQ=YEARLY;BYMONTH=3;BYMONTHDAY=2", true},
+		{"FREQ=YEARLY;INTERVAL=2", false},
+		{"FREQ=MONTHLY;INTERVAL=12", true},
+		{"FREQ=MONTHLY", false},
+		{"FREQ=WEEKLY", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if yearly := (Event{RRule: tt.rrule}).IsYearly(); yearly != tt.yearly {
+			t.Errorf("IsYearly(%q) = %v, want %v", tt.rrule, yearly, tt.yearly)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	if _, err := Parse("name,date\nJohn,1990-03-02\n"); err == nil {
+		t.Error("Parse() accepted a file that isn't ICS")
+	}
+
+	// A calendar without events is valid, it just has nothing to import
+	events, err := Parse("BEGIN:VCALENDAR\nVERSION:2.0\nEND:VCALENDAR\n")
+	if err != nil || len(events) != 0 {
+		t.Errorf("Parse() of an empty calendar = %v, %v", events, err)
+	}
+
+	// Lines of events that aren't properties are ignored
+	events, err = Parse("BEGIN:VCALENDAR\nBEGIN:VEVENT\nnot a property\nSUMMARY:" + strings.Repeat("a", 10) + "\nEND:VEVENT\nEND:VCALENDAR")
+	if err != nil || len(events) != 1 || events[0].Summary != "aaaaaaaaaa" {
+		t.Errorf("Parse() with an invalid line = %v, %v", events, err)
+	}
+}
@@ -0,0 +1,74 @@
+package ics
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Year used as a placeholder for dates without a known year, a leap year so February 29 can be represented
+const omitYearPlaceholder = 1604
+
+// ExportEvent holds the properties of an all-day VEVENT written to an ICS file
+type ExportEvent struct {
+	UID     string
+	Summary string
+	Start   time.Time
+	// Yearly makes the event recur every year on the start date
+	Yearly bool
+	// OmitYear writes the start date with a placeholder year, marked with X-APPLE-OMIT-YEAR
+	OmitYear bool
+}
+
+// Write creates the content of an ICS file with the given events, stamped with the given time
+func Write(events []ExportEvent, stamp time.Time) string {
+	var b strings.Builder
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//hbd//hbd//EN")
+	writeLine(&b, "CALSCALE:GREGORIAN")
+
+	for _, event := range events {
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, "UID:"+escapeText(event.UID))
+		writeLine(&b, "DTSTAMP:"+stamp.UTC().Format("20060102T150405Z"))
+		if event.OmitYear {
+			start := time.Date(omitYearPlaceholder, event.Start.Month(), event.Start.Day(), 0, 0, 0, 0, time.UTC)
+			writeLine(&b, fmt.Sprintf("DTSTART;VALUE=DATE;X-APPLE-OMIT-YEAR=%d:%s", omitYearPlaceholder, start.Format("20060102")))
+		} else {
+			writeLine(&b, "DTSTART;VALUE=DATE:"+event.Start.Format("20060102"))
+		}
+		if event.Yearly {
+			writeLine(&b, "RRULE:FREQ=YEARLY")
+		}
+		writeLine(&b, "SUMMARY:"+escapeText(event.Summary))
+		writeLine(&b, "TRANSP:TRANSPARENT")
+		writeLine(&b, "END:VEVENT")
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+// writeLine writes a content line, folded at 75 octets following RFC 5545 without splitting UTF-8 sequences
+func writeLine(b *strings.Builder, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		// Move the cut back to the start of a UTF-8 sequence
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space, which counts towards the limit
+		limit = 74
+	}
+	b.WriteString(line + "\r\n")
+}
+
+// escapeText escapes TEXT values
+func escapeText(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, "\r\n", `\n`, "\n", `\n`)
+	return replacer.Replace(value)
+}
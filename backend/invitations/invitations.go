@@ -0,0 +1,106 @@
+// Package invitations finds and accepts the invitations to join shared lists and organizations, on registration as
+// well as by users that already have an account.
+package invitations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"hbd/encryption"
+	"hbd/helper"
+	"hbd/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Errors of the invitations that can't be accepted
+var (
+	ErrNotFound = errors.New("Invitation not found, it may have expired or been used already")
+	ErrEmail    = errors.New("This invitation was sent to another email")
+)
+
+// Find gets the invitation with the given token, which must not be expired nor accepted
+func Find(ctx context.Context, exec boil.ContextExecutor, token string, now time.Time) (*models.Invitation, error) {
+	invitation, err := models.Invitations(
+		models.InvitationWhere.TokenHash.EQ(encryption.HashStringWithSHA256(token)),
+	).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if invitation.AcceptedAt.Valid || !invitation.ExpiresAt.After(now) {
+		return nil, ErrNotFound
+	}
+	return invitation, nil
+}
+
+// CheckEmail checks that the invitation can be accepted by the user with the given email hash, invitations
+// that weren't sent by email can be accepted by anyone with the token
+func CheckEmail(invitation *models.Invitation, emailHash string) error {
+	if invitation.EmailHash.Valid && invitation.EmailHash.String != emailHash {
+		return ErrEmail
+	}
+	return nil
+}
+
+// Accept adds the user to the list or organization of the invitation with its role, keeping the role of users
+// that are already members, and marks the invitation as accepted so it can't be used again
+func Accept(ctx context.Context, exec boil.ContextExecutor, invitation *models.Invitation, userID int64, now time.Time) error {
+	// Mark the invitation as accepted, only if no other request accepted it in the meantime
+	affected, err := models.Invitations(
+		models.InvitationWhere.ID.EQ(invitation.ID),
+		models.InvitationWhere.AcceptedAt.IsNull(),
+	).UpdateAll(ctx, exec, models.M{
+		models.InvitationColumns.AcceptedBy: userID,
+		models.InvitationColumns.AcceptedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+	invitation.AcceptedBy = null.Int64From(userID)
+	invitation.AcceptedAt = null.TimeFrom(now)
+
+	if invitation.ListID.Valid {
+		exists, err := models.ListMembers(
+			models.ListMemberWhere.ListID.EQ(invitation.ListID.Int64),
+			models.ListMemberWhere.UserID.EQ(userID),
+		).Exists(ctx, exec)
+		if err != nil || exists {
+			return err
+		}
+		member := &models.ListMember{ListID: invitation.ListID.Int64, UserID: userID, Role: invitation.Role}
+		return member.Insert(ctx, exec, boil.Infer())
+	}
+
+	exists, err := models.OrganizationMembers(
+		models.OrganizationMemberWhere.OrganizationID.EQ(invitation.OrganizationID.Int64),
+		models.OrganizationMemberWhere.UserID.EQ(userID),
+	).Exists(ctx, exec)
+	if err != nil || exists {
+		return err
+	}
+	member := &models.OrganizationMember{OrganizationID: invitation.OrganizationID.Int64, UserID: userID, Role: invitation.Role}
+	return member.Insert(ctx, exec, boil.Infer())
+}
+
+// HE handles errors of accepting invitations, responding with 404 for invitations that can't be found, 403 for
+// invitations sent to another email and with 500 and the given message on any other error
+func HE(c *gin.Context, err error, message string) bool {
+	if errors.Is(err, ErrNotFound) {
+		return helper.HE(c, err, http.StatusNotFound, message, true)
+	}
+	if errors.Is(err, ErrEmail) {
+		return helper.HE(c, err, http.StatusForbidden, message, true)
+	}
+	return helper.HE(c, err, http.StatusInternalServerError, message, false)
+}
@@ -0,0 +1,154 @@
+// Package lists holds the roles of the members of shared lists and the rules for which birthdays they can see and
+// change, used by the handlers of both accounts and birthdays.
+package lists
+
+import (
+	"context"
+
+	"hbd/models"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Roles of the members of a shared list, owners manage the members and editors change the birthdays
+const (
+	Owner  = "owner"
+	Editor = "editor"
+	Viewer = "viewer"
+)
+
+// ReadableBirthdaysSQL matches the birthdays a user can see: their own birthdays outside of lists and the birthdays
+// of the lists they're a member of. Both placeholders take the ID of the user.
+const ReadableBirthdaysSQL = "((birthdays.list_id IS NULL AND birthdays.user_id = ?) OR birthdays.list_id IN (SELECT list_members.list_id FROM list_members WHERE list_members.user_id = ?))"
+
+// EditableBirthdaysSQL matches the birthdays a user can change, which excludes the lists they're a viewer of.
+// Both placeholders take the ID of the user.
+const EditableBirthdaysSQL = "((birthdays.list_id IS NULL AND birthdays.user_id = ?) OR birthdays.list_id IN (SELECT list_members.list_id FROM list_members WHERE list_members.user_id = ? AND list_members.role <> 'viewer'))"
+
+// ReadableBirthdays restricts a query to the birthdays the user can see
+func ReadableBirthdays(userID int64) qm.QueryMod {
+	return qm.Where(ReadableBirthdaysSQL, userID, userID)
+}
+
+// EditableBirthdays restricts a query to the birthdays the user can change
+func EditableBirthdays(userID int64) qm.QueryMod {
+	return qm.Where(EditableBirthdaysSQL, userID, userID)
+}
+
+// Roles returns the role of the user in each of their lists, by list ID
+func Roles(ctx context.Context, exec boil.ContextExecutor, userID int64) (map[int64]string, error) {
+	members, err := models.ListMembers(models.ListMemberWhere.UserID.EQ(userID)).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	roles := map[int64]string{}
+	for _, member := range members {
+		roles[member.ListID] = member.Role
+	}
+	return roles, nil
+}
+
+// BirthdayRole returns the role of the user on a birthday given their list roles: owner of their own birthdays outside
+// of lists, their role in the list of the birthday otherwise, or an empty string if they can't see it
+func BirthdayRole(b *models.Birthday, userID int64, roles map[int64]string) string {
+	if !b.ListID.Valid {
+		if b.UserID == userID {
+			return Owner
+		}
+		return ""
+	}
+	return roles[b.ListID.Int64]
+}
+
+// CanEdit checks if a role allows changing birthdays
+func CanEdit(role string) bool {
+	return role == Owner || role == Editor
+}
+
+// PruneGroups removes the birthdays matching the condition from the groups of the users that can no longer see them,
+// after they were moved out of a list or a member left it. The condition is on the birthdays table, with $n placeholders.
+func PruneGroups(ctx context.Context, exec boil.ContextExecutor, where string, args ...any) error {
+	_, err := queries.Raw(`
+		DELETE FROM birthday_group_members
+		WHERE birthday_id IN (SELECT id FROM birthdays WHERE `+where+`) AND group_id NOT IN (
+			SELECT birthday_groups.id FROM birthday_groups, birthdays
+			WHERE birthdays.id = birthday_group_members.birthday_id AND (
+				(birthdays.list_id IS NULL AND birthdays.user_id = birthday_groups.user_id) OR
+				birthdays.list_id IN (SELECT list_members.list_id FROM list_members WHERE list_members.user_id = birthday_groups.user_id)
+			)
+		)`, args...,
+	).ExecContext(ctx, exec)
+	return err
+}
+
+// RemoveMember removes the user from the list. If the list is left without an owner the earliest remaining member
+// becomes its owner, and the birthdays the user added to the list are handed over to an owner. The last member to leave
+// keeps the birthdays of the list as their own and the list is deleted along with its shares and invitations.
+func RemoveMember(ctx context.Context, exec boil.ContextExecutor, listID, userID int64) error {
+	if _, err := models.ListMembers(
+		models.ListMemberWhere.ListID.EQ(listID),
+		models.ListMemberWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	// Get the remaining members, earliest first
+	members, err := models.ListMembers(
+		models.ListMemberWhere.ListID.EQ(listID),
+		qm.OrderBy("created_at, user_id"),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+	birthdays := models.Birthdays(qm.WithDeleted(), models.BirthdayWhere.ListID.EQ(null.Int64From(listID)))
+
+	// Give the birthdays back to the user and delete the list if nobody else is left
+	if len(members) == 0 {
+		if _, err := birthdays.UpdateAll(ctx, exec, models.M{
+			models.BirthdayColumns.UserID: userID,
+			models.BirthdayColumns.ListID: nil,
+		}); err != nil {
+			return err
+		}
+		if _, err := models.ListShares(models.ListShareWhere.ListID.EQ(listID)).DeleteAll(ctx, exec); err != nil {
+			return err
+		}
+		if _, err := models.Invitations(models.InvitationWhere.ListID.EQ(null.Int64From(listID))).DeleteAll(ctx, exec); err != nil {
+			return err
+		}
+		_, err := models.Lists(models.ListWhere.ID.EQ(null.Int64From(listID))).DeleteAll(ctx, exec)
+		return err
+	}
+
+	// Remove the birthdays of the list from the groups of the user
+	if err := PruneGroups(ctx, exec, "list_id = $1", listID); err != nil {
+		return err
+	}
+
+	// Make sure the list still has an owner
+	owner := members[0]
+	hasOwner := false
+	for _, member := range members {
+		if member.Role == Owner {
+			owner, hasOwner = member, true
+			break
+		}
+	}
+	if !hasOwner {
+		owner.Role = Owner
+		if _, err := owner.Update(ctx, exec, boil.Whitelist(models.ListMemberColumns.Role)); err != nil {
+			return err
+		}
+	}
+
+	// Hand the birthdays added by the user over to the owner
+	_, err = models.Birthdays(
+		qm.WithDeleted(),
+		models.BirthdayWhere.ListID.EQ(null.Int64From(listID)),
+		models.BirthdayWhere.UserID.EQ(userID),
+	).UpdateAll(ctx, exec, models.M{models.BirthdayColumns.UserID: owner.UserID})
+	return err
+}
@@ -27,7 +27,10 @@ func main() {
 	// Set up the cron job to check for birthday reminder checks every minute
 	c := cron.New()
 	c.AddFunc("* * * * *", birthdays.CheckReminders)
+	c.AddFunc("* * * * *", birthdays.CheckOrganizationReminders)
 	c.AddFunc("0 0 * * *", backups.BackupDBToS3)
+	c.AddFunc("0 * * * *", birthdays.PurgeTrash)
+	c.AddFunc("0 * * * *", auth.PurgeSessions)
 	c.Start()
 
 	// Initialize the database connection and run migrations
@@ -40,9 +43,9 @@ func main() {
 	// Configure CORS
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"http://localhost:8417", "http://localhost:8418", "http://localhost:3000", "http://0.0.0.0:8418", env.CD},
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE"},
-		AllowHeaders:     []string{"Origin", "Content-Length", "Content-Type", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
+		AllowHeaders:     []string{"Origin", "Content-Length", "Content-Type", "Authorization", "If-Match"},
+		ExposeHeaders:    []string{"Content-Length", "Location", "ETag"},
 		AllowCredentials: true,
 		MaxAge:           24 * time.Hour,
 	}))
@@ -58,6 +61,11 @@ func main() {
 	docs.SwaggerInfo.Description = "Endpoints for the HBD application"
 	docs.SwaggerInfo.Schemes = []string{"http", "https"}
 
+	// Scopes that API tokens need for each authenticated route
+	read := middlewares.RequireScope(auth.ScopeBirthdaysRead)
+	write := middlewares.RequireScope(auth.ScopeBirthdaysWrite)
+	admin := middlewares.RequireScope(auth.ScopeAccountAdmin)
+
 	// Auth routes
 	// Create API route group
 	api := router.Group("/api")
@@ -71,22 +79,112 @@ func main() {
 		// Public routes
 		api.POST("/register", auth.Register)
 		api.POST("/login", auth.Login)
+		api.POST("/refresh", auth.RefreshSession)
+		api.GET("/.well-known/jwks.json", auth.JWKS)
+		api.GET("/oidc/login", auth.OIDCLogin)
+		api.GET("/oidc/callback", auth.OIDCCallback)
 		api.GET("/generate-password", auth.GetPassword)
+		api.GET("/shared-lists/:token", middlewares.ShareRateLimitMiddleware(), birthdays.ViewSharedList)
+		api.GET("/invitations/:token", middlewares.ShareRateLimitMiddleware(), birthdays.ViewInvitation)
 
 		// Requires authentication
 		authenticated := api.Group("/")
 		authenticated.Use(middlewares.JWTAuthMiddleware())
 		{
 			// User routes
-			authenticated.GET("/me", auth.Me)
-			authenticated.DELETE("/delete-user", auth.DeleteUser)
-			authenticated.PUT("/modify-user", auth.ModifyUser)
+			authenticated.GET("/me", admin, auth.Me)
+			authenticated.DELETE("/delete-user", admin, auth.DeleteUser)
+			authenticated.PUT("/modify-user", admin, auth.ModifyUser)
+			authenticated.POST("/logout", admin, auth.Logout)
+			authenticated.POST("/logout-everywhere", admin, auth.LogoutEverywhere)
+			authenticated.GET("/sessions", admin, auth.ListSessions)
+			authenticated.DELETE("/delete-session", admin, auth.DeleteSession)
+			authenticated.POST("/add-api-token", admin, auth.AddAPIToken)
+			authenticated.GET("/api-tokens", admin, auth.ListAPITokens)
+			authenticated.PUT("/modify-api-token", admin, auth.ModifyAPIToken)
+			authenticated.DELETE("/delete-api-token", admin, auth.DeleteAPIToken)
+			authenticated.POST("/setup-totp", admin, auth.SetupTOTP)
+			authenticated.POST("/enable-totp", admin, auth.EnableTOTP)
+			authenticated.POST("/regenerate-recovery-codes", admin, auth.RegenerateRecoveryCodes)
+			authenticated.POST("/disable-totp", admin, auth.DisableTOTP)
 
 			// Birthday routes
-			authenticated.PATCH("/check-birthdays", birthdays.CallReminderChecker)
-			authenticated.POST("/add-birthday", birthdays.AddBirthday)
-			authenticated.PUT("/modify-birthday", birthdays.ModifyBirthday)
-			authenticated.DELETE("/delete-birthday", birthdays.DeleteBirthday)
+			authenticated.PATCH("/check-birthdays", write, birthdays.CallReminderChecker)
+			authenticated.POST("/add-birthday", write, birthdays.AddBirthday)
+			authenticated.PUT("/modify-birthday", write, birthdays.ModifyBirthday)
+			authenticated.DELETE("/delete-birthday", write, birthdays.DeleteBirthday)
+			authenticated.POST("/preview-ics-import", write, birthdays.PreviewICSImport)
+			authenticated.POST("/import-ics", write, birthdays.ImportICS)
+			authenticated.GET("/birthdays", read, birthdays.ListBirthdays)
+			authenticated.GET("/upcoming-birthdays", read, birthdays.UpcomingBirthdays)
+			authenticated.GET("/export-ics", read, birthdays.ExportICS)
+			authenticated.GET("/duplicate-birthdays", read, birthdays.DuplicateBirthdays)
+			authenticated.POST("/merge-birthdays", write, birthdays.MergeBirthdays)
+			authenticated.GET("/trash", read, birthdays.ListTrash)
+			authenticated.POST("/restore-birthday", write, birthdays.RestoreBirthday)
+			authenticated.GET("/birthday-stats", read, birthdays.BirthdayStats)
+			authenticated.PUT("/modify-milestones", admin, birthdays.ModifyMilestones)
+			authenticated.POST("/batch-birthdays", write, birthdays.BatchBirthdays)
+			authenticated.GET("/birthdays/:id/history", read, birthdays.BirthdayHistory)
+			authenticated.POST("/birthdays/:id/revert", write, birthdays.RevertBirthday)
+
+			// Group routes
+			authenticated.POST("/add-group", write, birthdays.AddGroup)
+			authenticated.GET("/groups", read, birthdays.ListGroups)
+			authenticated.PUT("/modify-group", write, birthdays.ModifyGroup)
+			authenticated.DELETE("/delete-group", write, birthdays.DeleteGroup)
+
+			// Shared list routes, deleting lists, membership and sharing need account:admin
+			authenticated.POST("/add-list", write, birthdays.AddList)
+			authenticated.GET("/lists", read, birthdays.ListLists)
+			authenticated.PUT("/modify-list", write, birthdays.ModifyList)
+			authenticated.DELETE("/delete-list", admin, birthdays.DeleteList)
+			authenticated.PUT("/list-member", admin, birthdays.SetListMember)
+			authenticated.DELETE("/list-member", admin, birthdays.RemoveListMember)
+			authenticated.PUT("/move-birthday", write, birthdays.MoveBirthday)
+			authenticated.POST("/add-list-share", admin, birthdays.AddListShare)
+			authenticated.GET("/list-shares", read, birthdays.ListListShares)
+			authenticated.DELETE("/delete-list-share", admin, birthdays.DeleteListShare)
+
+			// Organization routes, deleting organizations, membership and channels need account:admin
+			authenticated.POST("/add-organization", write, birthdays.AddOrganization)
+			authenticated.GET("/organizations", read, birthdays.ListOrganizations)
+			authenticated.PUT("/modify-organization", write, birthdays.ModifyOrganization)
+			authenticated.DELETE("/delete-organization", admin, birthdays.DeleteOrganization)
+			authenticated.PUT("/organization-member", admin, birthdays.SetOrganizationMember)
+			authenticated.DELETE("/organization-member", admin, birthdays.RemoveOrganizationMember)
+			authenticated.GET("/organization-directory", read, birthdays.OrganizationDirectory)
+			authenticated.PUT("/organization-directory", write, birthdays.SetDirectoryEntry)
+			authenticated.DELETE("/organization-directory", write, birthdays.RemoveDirectoryEntry)
+			authenticated.POST("/add-organization-channel", admin, birthdays.AddOrganizationChannel)
+			authenticated.GET("/organization-channels", read, birthdays.ListOrganizationChannels)
+			authenticated.DELETE("/delete-organization-channel", admin, birthdays.DeleteOrganizationChannel)
+
+			// Invitation routes, they change memberships so they need account:admin
+			authenticated.POST("/add-invitation", admin, birthdays.AddInvitation)
+			authenticated.GET("/invitations", read, birthdays.ListInvitations)
+			authenticated.GET("/received-invitations", read, birthdays.ReceivedInvitations)
+			authenticated.DELETE("/revoke-invitation", admin, birthdays.RevokeInvitation)
+			authenticated.POST("/accept-invitation", admin, birthdays.AcceptInvitation)
+		}
+
+		// Resource routes, the verb-named routes above are kept for existing clients
+		v2 := api.Group("/v2")
+		v2.Use(middlewares.JWTAuthMiddleware())
+		{
+			// Birthday routes
+			v2.GET("/birthdays", read, birthdays.ListBirthdays)
+			v2.POST("/birthdays", write, birthdays.CreateBirthdayV2)
+			v2.GET("/birthdays/:id", read, birthdays.GetBirthdayV2)
+			v2.PATCH("/birthdays/:id", write, birthdays.UpdateBirthdayV2)
+			v2.DELETE("/birthdays/:id", write, birthdays.DeleteBirthdayV2)
+
+			// Gift routes
+			v2.GET("/birthdays/:id/gift-ideas", read, birthdays.ListGifts)
+			v2.POST("/birthdays/:id/gift-ideas", write, birthdays.AddGift)
+			v2.PATCH("/birthdays/:id/gift-ideas/:gift_id", write, birthdays.UpdateGift)
+			v2.DELETE("/birthdays/:id/gift-ideas/:gift_id", write, birthdays.DeleteGift)
+			v2.GET("/gift-history", read, birthdays.GiftHistory)
 		}
 	}
 
@@ -2,6 +2,9 @@ package middlewares
 
 import (
 	"sync"
+	"time"
+
+	"hbd/encryption"
 
 	"github.com/gin-gonic/gin"
 	"golang.org/x/time/rate"
@@ -12,8 +15,26 @@ var (
 	mu           sync.Mutex
 	rateLimit    = rate.Limit(2) // 2 requests per second
 	burstLimit   = 10            // Allow bursts of up to 10 requests
+
+	shareLimits     = make(map[string]*shareLimiter)
+	shareRateLimit  = rate.Every(6 * time.Second) // 10 requests per minute for each share token
+	shareBurstLimit = 5                           // Allow bursts of up to 5 requests
+	shareLastSweep  time.Time
+)
+
+// Limiters of share tokens that weren't used for shareLimiterTTL are removed, checking at most every
+// shareSweepInterval. An idle limiter refills in 30 seconds, so removing it after that doesn't change the limits.
+const (
+	shareLimiterTTL    = 10 * time.Minute
+	shareSweepInterval = time.Minute
 )
 
+// shareLimiter is the rate limiter of a share token along with the last time it was used
+type shareLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
 // getClientLimiter retrieves the rate limiter for a specific client IP
 func getClientLimiter(clientIP string) *rate.Limiter {
 	mu.Lock()
@@ -41,3 +62,42 @@ func RateLimitMiddleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// getShareLimiter retrieves the rate limiter for a share token, by the hash of the token. Any token gets a limiter,
+// even invalid ones since the limit applies before the token is looked up, so the idle limiters are removed as well.
+func getShareLimiter(tokenHash string, now time.Time) *rate.Limiter {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if now.Sub(shareLastSweep) >= shareSweepInterval {
+		for hash, entry := range shareLimits {
+			if now.Sub(entry.lastSeen) >= shareLimiterTTL {
+				delete(shareLimits, hash)
+			}
+		}
+		shareLastSweep = now
+	}
+
+	entry, exists := shareLimits[tokenHash]
+	if !exists {
+		entry = &shareLimiter{limiter: rate.NewLimiter(shareRateLimit, shareBurstLimit)}
+		shareLimits[tokenHash] = entry
+	}
+	entry.lastSeen = now
+	return entry.limiter
+}
+
+// ShareRateLimitMiddleware applies rate limiting to each token of a public link, such as shares and invitations, on top
+// of the limit of the client, so a link that was passed around can't be used to scrape the list
+func ShareRateLimitMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limiter := getShareLimiter(encryption.HashStringWithSHA256(c.Param("token")), time.Now())
+
+		if !limiter.Allow() {
+			c.AbortWithStatusJSON(429, gin.H{"error": "too many requests"})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,43 @@
+package middlewares
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestShareLimiterEviction(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	// Every token gets a limiter, valid or not
+	for i := 0; i < 100; i++ {
+		getShareLimiter(fmt.Sprint("invalid", i), now)
+	}
+	used := getShareLimiter("used", now)
+	if len(shareLimits) != 101 {
+		t.Fatalf("%d limiters, want 101", len(shareLimits))
+	}
+
+	// Limiters in use are kept with their state
+	for i := 0; i < shareBurstLimit; i++ {
+		used.Allow()
+	}
+	if getShareLimiter("used", now.Add(shareLimiterTTL-time.Second)) != used {
+		t.Error("the limiter of a token in use was replaced")
+	}
+
+	// The idle limiters are removed once they expire, on the next sweep
+	later := now.Add(shareLimiterTTL + shareSweepInterval)
+	getShareLimiter("new", later)
+	if len(shareLimits) != 2 {
+		t.Errorf("%d limiters after the TTL, want 2", len(shareLimits))
+	}
+	if getShareLimiter("used", later) != used {
+		t.Error("the limiter of a token in use was removed")
+	}
+
+	// Removed limiters start over
+	if getShareLimiter("invalid0", later).Tokens() < float64(shareBurstLimit) {
+		t.Error("a removed limiter wasn't recreated full")
+	}
+}
@@ -1,6 +1,7 @@
 package middlewares
 
 import (
+	"errors"
 	"hbd/auth"
 	"net/http"
 	"strings"
@@ -20,6 +21,27 @@ func JWTAuthMiddleware() gin.HandlerFunc {
 		// Remove the "Bearer " part if it exists
 		tokenStr = strings.TrimPrefix(tokenStr, "Bearer ")
 
+		// Personal API tokens are only allowed on the routes of their scopes
+		if auth.IsAPIToken(tokenStr) {
+			tokenID, email, scopes, err := auth.ValidateAPIToken(c, tokenStr)
+			if errors.Is(err, auth.ErrInvalidAPIToken) {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+				c.Abort()
+				return
+			}
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to validate token"})
+				c.Abort()
+				return
+			}
+
+			c.Set("Email", email)
+			c.Set("APITokenID", tokenID)
+			c.Set("Scopes", scopes)
+			c.Next()
+			return
+		}
+
 		claims, err := auth.ValidateJWT(tokenStr)
 		if err != nil {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
@@ -27,7 +49,35 @@ func JWTAuthMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		// Check that the session of the token wasn't revoked. Tokens issued before sessions existed have a session ID of 0,
+		// which never matches a session, so they're rejected and their users have to log in again
+		active, err := auth.ValidateSession(c, claims.SessionID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to validate session"})
+			c.Abort()
+			return
+		}
+		if !active {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+			c.Abort()
+			return
+		}
+
 		c.Set("Email", claims.Email)
+		c.Set("SessionID", claims.SessionID)
+		c.Set("Scopes", auth.AllScopes)
+		c.Next()
+	}
+}
+
+// RequireScope only lets through requests whose token has the scope, session tokens have every scope
+func RequireScope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !auth.HasScope(c, scope) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "This token doesn't have the " + scope + " scope"})
+			c.Abort()
+			return
+		}
 		c.Next()
 	}
 }
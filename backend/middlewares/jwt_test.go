@@ -0,0 +1,90 @@
+package middlewares
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"hbd/auth"
+	"hbd/encryption"
+	"hbd/env"
+	"hbd/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestRequireScope(t *testing.T) {
+	ctx := context.Background()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.Use(JWTAuthMiddleware())
+	router.GET("/read", RequireScope(auth.ScopeBirthdaysRead), ok)
+	router.GET("/write", RequireScope(auth.ScopeBirthdaysWrite), ok)
+	router.GET("/admin", RequireScope(auth.ScopeAccountAdmin), ok)
+
+	user := &models.User{EmailHash: encryption.HashStringWithSHA256("scopes@example.com"), ReminderTime: "10:00", Timezone: "UTC"}
+	if err := user.Insert(ctx, env.DB, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	encryptedEmail, _ := encryption.Encrypt(env.MK, "scopes@example.com")
+	insert := func(token, scopes string, expiresAt null.Time) {
+		apiToken := &models.APIToken{
+			UserID:    user.ID.Int64,
+			Name:      token,
+			Email:     hex.EncodeToString(encryptedEmail),
+			TokenHash: encryption.HashStringWithSHA256(token),
+			Scopes:    scopes,
+			ExpiresAt: expiresAt,
+		}
+		if err := apiToken.Insert(ctx, env.DB, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	insert("hbd_pat_read", auth.ScopeBirthdaysRead, null.Time{})
+	insert("hbd_pat_write", auth.ScopeBirthdaysWrite, null.Time{})
+	insert("hbd_pat_admin", auth.ScopeAccountAdmin, null.Time{})
+	insert("hbd_pat_expired", auth.ScopeAccountAdmin, null.TimeFrom(time.Now().Add(-time.Minute)))
+
+	// Access tokens whose session doesn't exist are rejected, whatever their scopes
+	noSession, err := auth.GenerateJWT("scopes@example.com", 0, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		token string
+		path  string
+		want  int
+	}{
+		{"hbd_pat_read", "/read", http.StatusOK},
+		{"hbd_pat_read", "/write", http.StatusForbidden},
+		{"hbd_pat_read", "/admin", http.StatusForbidden},
+		{"hbd_pat_write", "/read", http.StatusOK},
+		{"hbd_pat_write", "/write", http.StatusOK},
+		{"hbd_pat_write", "/admin", http.StatusForbidden},
+		{"hbd_pat_admin", "/read", http.StatusOK},
+		{"hbd_pat_admin", "/write", http.StatusOK},
+		{"hbd_pat_admin", "/admin", http.StatusOK},
+		{"hbd_pat_expired", "/read", http.StatusUnauthorized},
+		{"hbd_pat_unknown", "/read", http.StatusUnauthorized},
+		{noSession, "/read", http.StatusUnauthorized},
+		{"", "/read", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+		if tt.token != "" {
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+		}
+		router.ServeHTTP(w, req)
+		if w.Code != tt.want {
+			t.Errorf("GET %s with %.20s = %d, want %d", tt.path, tt.token, w.Code, tt.want)
+		}
+	}
+}
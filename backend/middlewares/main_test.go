@@ -0,0 +1,13 @@
+package middlewares
+
+import (
+	"os"
+	"testing"
+
+	"hbd/db/dbtest"
+	"hbd/env"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(dbtest.Run(env.DB, m.Run))
+}
@@ -0,0 +1,5 @@
+-- Birthdays with unknown year go back to being stored with year 0000
+UPDATE birthdays SET date = '0000' || substr(date, 5) WHERE year_known = FALSE;
+
+-- Drop the year known flag
+ALTER TABLE birthdays DROP COLUMN year_known;
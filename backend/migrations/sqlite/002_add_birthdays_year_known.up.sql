@@ -0,0 +1,8 @@
+-- Add a flag for birthdays whose year is known
+ALTER TABLE birthdays ADD COLUMN year_known BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Birthdays stored with year 0000 or with the year they were added in were the previous ways of saying the year is
+-- unknown, the reminders left out the age of both. Someone added in their year of birth loses their year as well and
+-- has to be given it again.
+UPDATE birthdays SET year_known = TRUE
+WHERE substr(date, 1, 4) != '0000' AND (created_at IS NULL OR substr(date, 1, 4) != substr(created_at, 1, 4));
@@ -0,0 +1,5 @@
+-- Drop the years label
+ALTER TABLE birthdays DROP COLUMN years_label;
+
+-- Drop the event type
+ALTER TABLE birthdays DROP COLUMN event_type;
@@ -0,0 +1,5 @@
+-- Add the type of the recurring event, existing rows are birthdays
+ALTER TABLE birthdays ADD COLUMN event_type TEXT NOT NULL DEFAULT 'birthday';
+
+-- Optional label for the years since the event, overrides the phrasing of the event type
+ALTER TABLE birthdays ADD COLUMN years_label TEXT;
@@ -0,0 +1,2 @@
+-- Drop the calendar system
+ALTER TABLE birthdays DROP COLUMN calendar;
@@ -0,0 +1,3 @@
+-- Add the calendar system of the date, existing rows use the Gregorian calendar.
+-- Dates in other calendars are stored as the Gregorian date of the original event.
+ALTER TABLE birthdays ADD COLUMN calendar TEXT NOT NULL DEFAULT 'gregorian';
@@ -0,0 +1,8 @@
+-- Drop the birthday tags table
+DROP TABLE birthday_tags;
+
+-- Drop the notes and contact details
+ALTER TABLE birthdays DROP COLUMN email;
+ALTER TABLE birthdays DROP COLUMN phone;
+ALTER TABLE birthdays DROP COLUMN telegram_username;
+ALTER TABLE birthdays DROP COLUMN notes;
@@ -0,0 +1,16 @@
+-- Add free-text notes and contact details to birthdays
+ALTER TABLE birthdays ADD COLUMN notes TEXT;
+ALTER TABLE birthdays ADD COLUMN telegram_username TEXT;
+ALTER TABLE birthdays ADD COLUMN phone TEXT;
+ALTER TABLE birthdays ADD COLUMN email TEXT;
+
+-- Create the birthday tags table
+CREATE TABLE birthday_tags (
+    birthday_id INTEGER NOT NULL,
+    tag TEXT NOT NULL,
+    PRIMARY KEY(birthday_id, tag),
+    FOREIGN KEY(birthday_id) REFERENCES birthdays(id) ON DELETE CASCADE
+);
+
+-- Index to filter birthdays by tag
+CREATE INDEX birthday_tags_tag ON birthday_tags(tag);
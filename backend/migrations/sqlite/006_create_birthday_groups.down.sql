@@ -0,0 +1,4 @@
+-- Drop the birthday groups tables
+DROP TRIGGER IF EXISTS update_birthday_groups_updated_at;
+DROP TABLE birthday_group_members;
+DROP TABLE birthday_groups;
@@ -0,0 +1,30 @@
+-- Create the birthday groups table, each group has its own reminder settings
+CREATE TABLE birthday_groups (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    lead_days TEXT NOT NULL DEFAULT '0',
+    destination TEXT,
+    template TEXT,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_id, name),
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create the table of birthdays in each group
+CREATE TABLE birthday_group_members (
+    group_id INTEGER NOT NULL,
+    birthday_id INTEGER NOT NULL,
+    PRIMARY KEY(group_id, birthday_id),
+    FOREIGN KEY(group_id) REFERENCES birthday_groups(id) ON DELETE CASCADE,
+    FOREIGN KEY(birthday_id) REFERENCES birthdays(id) ON DELETE CASCADE
+);
+
+-- Trigger to automatically update the updated_at column on birthday_groups table update
+CREATE TRIGGER update_birthday_groups_updated_at
+AFTER UPDATE ON birthday_groups
+FOR EACH ROW
+BEGIN
+    UPDATE birthday_groups SET updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+END;
@@ -0,0 +1,8 @@
+-- Purge the birthdays in the trash, since they can't be told apart without the column
+DELETE FROM birthday_tags WHERE birthday_id IN (SELECT id FROM birthdays WHERE deleted_at IS NOT NULL);
+DELETE FROM birthday_group_members WHERE birthday_id IN (SELECT id FROM birthdays WHERE deleted_at IS NOT NULL);
+DELETE FROM birthdays WHERE deleted_at IS NOT NULL;
+
+-- Drop the deletion time
+DROP INDEX birthdays_deleted_at;
+ALTER TABLE birthdays DROP COLUMN deleted_at;
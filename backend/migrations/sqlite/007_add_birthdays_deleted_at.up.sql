@@ -0,0 +1,5 @@
+-- Add the time at which a birthday was moved to the trash, birthdays in the trash are purged after the retention period
+ALTER TABLE birthdays ADD COLUMN deleted_at DATETIME;
+
+-- Index to find the birthdays to purge
+CREATE INDEX birthdays_deleted_at ON birthdays(deleted_at);
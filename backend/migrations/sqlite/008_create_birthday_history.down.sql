@@ -0,0 +1,2 @@
+-- Drop the birthday history table
+DROP TABLE birthday_history;
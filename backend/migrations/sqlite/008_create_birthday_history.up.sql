@@ -0,0 +1,15 @@
+-- Create the birthday history table, with the values of a birthday before and after each change
+CREATE TABLE birthday_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    birthday_id INTEGER NOT NULL,
+    user_id INTEGER,
+    action TEXT NOT NULL,
+    old_values TEXT,
+    new_values TEXT,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(birthday_id) REFERENCES birthdays(id) ON DELETE CASCADE,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- Index to list the history of a birthday
+CREATE INDEX birthday_history_birthday_id ON birthday_history(birthday_id);
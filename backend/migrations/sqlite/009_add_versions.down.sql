@@ -0,0 +1,3 @@
+-- Drop the versions
+ALTER TABLE birthdays DROP COLUMN version;
+ALTER TABLE users DROP COLUMN version;
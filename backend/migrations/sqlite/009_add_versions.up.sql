@@ -0,0 +1,3 @@
+-- Add a version to users and birthdays, incremented on every change and used for their ETags
+ALTER TABLE users ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE birthdays ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,3 @@
+-- Drop the gifts table
+DROP TRIGGER update_gifts_updated_at;
+DROP TABLE gifts;
@@ -0,0 +1,25 @@
+-- Create the gifts table, each gift idea belongs to a birthday and moves from idea to bought to given
+CREATE TABLE gifts (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    birthday_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'idea',
+    price REAL,
+    link TEXT,
+    year_given INTEGER,
+    notes TEXT,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(birthday_id) REFERENCES birthdays(id) ON DELETE CASCADE
+);
+
+-- Index to list the gifts of a birthday
+CREATE INDEX gifts_birthday_id ON gifts(birthday_id);
+
+-- Trigger to automatically update the updated_at column on gifts table update
+CREATE TRIGGER update_gifts_updated_at
+AFTER UPDATE ON gifts
+FOR EACH ROW
+BEGIN
+    UPDATE gifts SET updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+END;
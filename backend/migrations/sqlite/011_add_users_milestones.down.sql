@@ -0,0 +1,3 @@
+-- Drop the milestone settings
+ALTER TABLE users DROP COLUMN milestone_lead_days;
+ALTER TABLE users DROP COLUMN milestones;
@@ -0,0 +1,5 @@
+-- Add the milestone ages of each user, comma separated, the default set is used if empty
+ALTER TABLE users ADD COLUMN milestones TEXT;
+
+-- Add the days before milestone birthdays on which an extra reminder is sent, 0 disables it
+ALTER TABLE users ADD COLUMN milestone_lead_days INTEGER NOT NULL DEFAULT 30;
@@ -0,0 +1,8 @@
+-- Drop the list of the birthdays, they stay with the users that added them
+DROP INDEX birthdays_list_id;
+ALTER TABLE birthdays DROP COLUMN list_id;
+
+-- Drop the shared lists tables
+DROP TRIGGER IF EXISTS update_lists_updated_at;
+DROP TABLE list_members;
+DROP TABLE lists;
@@ -0,0 +1,35 @@
+-- Create the shared lists table, the birthdays in a list are shared between its members
+CREATE TABLE lists (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create the table of members of each list, with their role: owner, editor or viewer
+CREATE TABLE list_members (
+    list_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    role TEXT NOT NULL DEFAULT 'viewer',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY(list_id, user_id),
+    FOREIGN KEY(list_id) REFERENCES lists(id) ON DELETE CASCADE,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Index to find the lists of a user
+CREATE INDEX list_members_user_id ON list_members(user_id);
+
+-- Add the list of a birthday, birthdays outside of lists belong only to their user
+ALTER TABLE birthdays ADD COLUMN list_id INTEGER;
+
+-- Index to find the birthdays of a list
+CREATE INDEX birthdays_list_id ON birthdays(list_id);
+
+-- Trigger to automatically update the updated_at column on lists table update
+CREATE TRIGGER update_lists_updated_at
+AFTER UPDATE ON lists
+FOR EACH ROW
+BEGIN
+    UPDATE lists SET updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+END;
@@ -0,0 +1,2 @@
+-- Drop the list shares table
+DROP TABLE list_shares;
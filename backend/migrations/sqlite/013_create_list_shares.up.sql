@@ -0,0 +1,16 @@
+-- Create the list shares table, each share is a token that gives read-only access to a list without an account
+-- Only the hash of the token is stored
+CREATE TABLE list_shares (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    list_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    hide_years BOOLEAN NOT NULL DEFAULT FALSE,
+    expires_at DATETIME,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(list_id) REFERENCES lists(id) ON DELETE CASCADE,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Index to list the shares of a list
+CREATE INDEX list_shares_list_id ON list_shares(list_id);
@@ -0,0 +1,5 @@
+-- Drop the organizations tables
+DROP TRIGGER IF EXISTS update_organizations_updated_at;
+DROP TABLE organization_channels;
+DROP TABLE organization_members;
+DROP TABLE organizations;
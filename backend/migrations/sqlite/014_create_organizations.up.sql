@@ -0,0 +1,51 @@
+-- Create the organizations table, for teams that run hbd for their members
+CREATE TABLE organizations (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create the table of members of each organization, with their role: admin or member
+-- Members opt into the directory of the organization by setting the name and birthday shown in it
+CREATE TABLE organization_members (
+    organization_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    role TEXT NOT NULL DEFAULT 'member',
+    directory_name TEXT,
+    directory_date DATE,
+    directory_year_known BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY(organization_id, user_id),
+    FOREIGN KEY(organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Index to find the organizations of a user
+CREATE INDEX organization_members_user_id ON organization_members(user_id);
+
+-- Create the organization channels table, each channel is a Telegram chat that receives the birthdays of the directory
+-- The bot API key and chat ID are encrypted, the reminder time is in UTC
+CREATE TABLE organization_channels (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    organization_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    telegram_bot_api_key TEXT NOT NULL,
+    telegram_chat_id TEXT NOT NULL,
+    reminder_time TEXT NOT NULL,
+    lead_days INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(organization_id) REFERENCES organizations(id) ON DELETE CASCADE
+);
+
+-- Indexes to list the channels of an organization and to find the channels to remind
+CREATE INDEX organization_channels_organization_id ON organization_channels(organization_id);
+CREATE INDEX organization_channels_reminder_time ON organization_channels(reminder_time);
+
+-- Trigger to automatically update the updated_at column on organizations table update
+CREATE TRIGGER update_organizations_updated_at
+AFTER UPDATE ON organizations
+FOR EACH ROW
+BEGIN
+    UPDATE organizations SET updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+END;
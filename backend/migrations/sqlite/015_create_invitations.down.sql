@@ -0,0 +1,2 @@
+-- Drop the invitations table
+DROP TABLE invitations;
@@ -0,0 +1,23 @@
+-- Create the invitations table, each invitation is a one-time token to join a shared list or an organization with a role
+-- Invitations sent by email can only be accepted by the user with that email, only the hashes of the email and token are stored
+CREATE TABLE invitations (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    list_id INTEGER,
+    organization_id INTEGER,
+    user_id INTEGER NOT NULL,
+    role TEXT NOT NULL,
+    email_hash TEXT,
+    token_hash TEXT NOT NULL UNIQUE,
+    expires_at DATETIME NOT NULL,
+    accepted_by INTEGER,
+    accepted_at DATETIME,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(list_id) REFERENCES lists(id) ON DELETE CASCADE,
+    FOREIGN KEY(organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Indexes to list the invitations of a list or organization and the invitations sent to an email
+CREATE INDEX invitations_list_id ON invitations(list_id);
+CREATE INDEX invitations_organization_id ON invitations(organization_id);
+CREATE INDEX invitations_email_hash ON invitations(email_hash);
@@ -0,0 +1,20 @@
+-- Create the sessions table, each login starts a session that lasts as long as its refresh token
+-- Refresh tokens rotate on every use, the previous one is kept to detect reuse of a stolen token, and only their hashes are stored
+-- The email is encrypted, it's needed to issue new access tokens
+CREATE TABLE sessions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    email TEXT NOT NULL,
+    refresh_token_hash TEXT NOT NULL UNIQUE,
+    previous_refresh_token_hash TEXT,
+    user_agent TEXT NOT NULL DEFAULT '',
+    ip TEXT NOT NULL DEFAULT '',
+    expires_at DATETIME NOT NULL,
+    last_used_at DATETIME,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Indexes to list the sessions of a user and to find reused refresh tokens
+CREATE INDEX sessions_user_id ON sessions(user_id);
+CREATE INDEX sessions_previous_refresh_token_hash ON sessions(previous_refresh_token_hash);
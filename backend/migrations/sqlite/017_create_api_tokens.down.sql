@@ -0,0 +1,2 @@
+-- Drop the personal API tokens table
+DROP TABLE api_tokens;
@@ -0,0 +1,17 @@
+-- Create the personal API tokens table, for the CLI and automations, each token is limited to its scopes
+-- Only the hashes of the tokens are stored, the email is encrypted since it identifies the user in requests
+CREATE TABLE api_tokens (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    email TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    scopes TEXT NOT NULL,
+    expires_at DATETIME,
+    last_used_at DATETIME,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Index to list the tokens of a user
+CREATE INDEX api_tokens_user_id ON api_tokens(user_id);
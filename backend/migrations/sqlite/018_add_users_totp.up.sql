@@ -0,0 +1,19 @@
+-- Add the TOTP secret of each user, encrypted, it's set when enrolment starts and only used once it's verified and enabled
+ALTER TABLE users ADD COLUMN totp_secret TEXT;
+ALTER TABLE users ADD COLUMN totp_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Add the time step of the last accepted TOTP code, so codes can't be used twice
+ALTER TABLE users ADD COLUMN totp_last_step INTEGER;
+
+-- Create the recovery codes table, each code can be used once instead of a TOTP code and only its hash is stored
+CREATE TABLE recovery_codes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    code_hash TEXT NOT NULL,
+    used_at DATETIME,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Index to find the recovery codes of a user
+CREATE INDEX recovery_codes_user_id ON recovery_codes(user_id);
@@ -0,0 +1,2 @@
+-- Drop the OpenID Connect identities table
+DROP TABLE oidc_identities;
@@ -0,0 +1,16 @@
+-- Create the table of accounts of OpenID Connect providers linked to users, found by their issuer and subject
+-- The email of the user is encrypted, it's needed to start sessions since users are only stored with its hash
+CREATE TABLE oidc_identities (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    issuer TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    email TEXT NOT NULL,
+    last_login_at DATETIME,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(issuer, subject),
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Index to find the identities of a user
+CREATE INDEX oidc_identities_user_id ON oidc_identities(user_id);
@@ -0,0 +1,3 @@
+-- Drop the failed two-factor authentication attempts
+ALTER TABLE users DROP COLUMN totp_locked_until;
+ALTER TABLE users DROP COLUMN totp_failures;
@@ -0,0 +1,3 @@
+-- Count the failed two-factor authentication attempts in a row of each user, too many lock the second factor for a while
+ALTER TABLE users ADD COLUMN totp_failures INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN totp_locked_until DATETIME;
@@ -0,0 +1 @@
+-- The orphaned rows can't be restored
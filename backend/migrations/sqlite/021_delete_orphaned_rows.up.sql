@@ -0,0 +1,20 @@
+-- Foreign keys were off on SQLite connections before, so rows that should have been deleted along with their user, list,
+-- organization or birthday may have been left behind. Delete them now that the foreign keys are enforced.
+DELETE FROM sessions WHERE user_id NOT IN (SELECT id FROM users);
+DELETE FROM api_tokens WHERE user_id NOT IN (SELECT id FROM users);
+DELETE FROM recovery_codes WHERE user_id NOT IN (SELECT id FROM users);
+DELETE FROM oidc_identities WHERE user_id NOT IN (SELECT id FROM users);
+DELETE FROM list_members WHERE user_id NOT IN (SELECT id FROM users) OR list_id NOT IN (SELECT id FROM lists);
+DELETE FROM list_shares WHERE user_id NOT IN (SELECT id FROM users) OR list_id NOT IN (SELECT id FROM lists);
+DELETE FROM organization_members WHERE user_id NOT IN (SELECT id FROM users) OR organization_id NOT IN (SELECT id FROM organizations);
+DELETE FROM organization_channels WHERE organization_id NOT IN (SELECT id FROM organizations);
+DELETE FROM invitations WHERE user_id NOT IN (SELECT id FROM users)
+    OR (list_id IS NOT NULL AND list_id NOT IN (SELECT id FROM lists))
+    OR (organization_id IS NOT NULL AND organization_id NOT IN (SELECT id FROM organizations));
+DELETE FROM birthday_groups WHERE user_id NOT IN (SELECT id FROM users);
+DELETE FROM birthdays WHERE user_id NOT IN (SELECT id FROM users);
+DELETE FROM birthday_group_members WHERE group_id NOT IN (SELECT id FROM birthday_groups) OR birthday_id NOT IN (SELECT id FROM birthdays);
+DELETE FROM birthday_tags WHERE birthday_id NOT IN (SELECT id FROM birthdays);
+DELETE FROM gifts WHERE birthday_id NOT IN (SELECT id FROM birthdays);
+DELETE FROM birthday_history WHERE birthday_id NOT IN (SELECT id FROM birthdays);
+UPDATE birthday_history SET user_id = NULL WHERE user_id NOT IN (SELECT id FROM users);
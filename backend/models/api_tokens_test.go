@@ -0,0 +1,851 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAPITokens(t *testing.T) {
+	t.Parallel()
+
+	query := APITokens()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAPITokensDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &APIToken{}
+	if err = randomize.Struct(seed, o, apiTokenDBTypes, true, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := APITokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAPITokensQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &APIToken{}
+	if err = randomize.Struct(seed, o, apiTokenDBTypes, true, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := APITokens().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := APITokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAPITokensSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &APIToken{}
+	if err = randomize.Struct(seed, o, apiTokenDBTypes, true, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := APITokenSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := APITokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAPITokensExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &APIToken{}
+	if err = randomize.Struct(seed, o, apiTokenDBTypes, true, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := APITokenExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if APIToken exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected APITokenExists to return true, but got false.")
+	}
+}
+
+func testAPITokensFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &APIToken{}
+	if err = randomize.Struct(seed, o, apiTokenDBTypes, true, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	apiTokenFound, err := FindAPIToken(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if apiTokenFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAPITokensBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &APIToken{}
+	if err = randomize.Struct(seed, o, apiTokenDBTypes, true, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = APITokens().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAPITokensOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &APIToken{}
+	if err = randomize.Struct(seed, o, apiTokenDBTypes, true, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := APITokens().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAPITokensAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	apiTokenOne := &APIToken{}
+	apiTokenTwo := &APIToken{}
+	if err = randomize.Struct(seed, apiTokenOne, apiTokenDBTypes, false, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+	if err = randomize.Struct(seed, apiTokenTwo, apiTokenDBTypes, false, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = apiTokenOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = apiTokenTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := APITokens().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAPITokensCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	apiTokenOne := &APIToken{}
+	apiTokenTwo := &APIToken{}
+	if err = randomize.Struct(seed, apiTokenOne, apiTokenDBTypes, false, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+	if err = randomize.Struct(seed, apiTokenTwo, apiTokenDBTypes, false, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = apiTokenOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = apiTokenTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := APITokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func apiTokenBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *APIToken) error {
+	*o = APIToken{}
+	return nil
+}
+
+func apiTokenAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *APIToken) error {
+	*o = APIToken{}
+	return nil
+}
+
+func apiTokenAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *APIToken) error {
+	*o = APIToken{}
+	return nil
+}
+
+func apiTokenBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *APIToken) error {
+	*o = APIToken{}
+	return nil
+}
+
+func apiTokenAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *APIToken) error {
+	*o = APIToken{}
+	return nil
+}
+
+func apiTokenBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *APIToken) error {
+	*o = APIToken{}
+	return nil
+}
+
+func apiTokenAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *APIToken) error {
+	*o = APIToken{}
+	return nil
+}
+
+func apiTokenBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *APIToken) error {
+	*o = APIToken{}
+	return nil
+}
+
+func apiTokenAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *APIToken) error {
+	*o = APIToken{}
+	return nil
+}
+
+func testAPITokensHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &APIToken{}
+	o := &APIToken{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, apiTokenDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize APIToken object: %s", err)
+	}
+
+	AddAPITokenHook(boil.BeforeInsertHook, apiTokenBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	apiTokenBeforeInsertHooks = []APITokenHook{}
+
+	AddAPITokenHook(boil.AfterInsertHook, apiTokenAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	apiTokenAfterInsertHooks = []APITokenHook{}
+
+	AddAPITokenHook(boil.AfterSelectHook, apiTokenAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	apiTokenAfterSelectHooks = []APITokenHook{}
+
+	AddAPITokenHook(boil.BeforeUpdateHook, apiTokenBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	apiTokenBeforeUpdateHooks = []APITokenHook{}
+
+	AddAPITokenHook(boil.AfterUpdateHook, apiTokenAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	apiTokenAfterUpdateHooks = []APITokenHook{}
+
+	AddAPITokenHook(boil.BeforeDeleteHook, apiTokenBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	apiTokenBeforeDeleteHooks = []APITokenHook{}
+
+	AddAPITokenHook(boil.AfterDeleteHook, apiTokenAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	apiTokenAfterDeleteHooks = []APITokenHook{}
+
+	AddAPITokenHook(boil.BeforeUpsertHook, apiTokenBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	apiTokenBeforeUpsertHooks = []APITokenHook{}
+
+	AddAPITokenHook(boil.AfterUpsertHook, apiTokenAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	apiTokenAfterUpsertHooks = []APITokenHook{}
+}
+
+func testAPITokensInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &APIToken{}
+	if err = randomize.Struct(seed, o, apiTokenDBTypes, true, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := APITokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAPITokensInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &APIToken{}
+	if err = randomize.Struct(seed, o, apiTokenDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(apiTokenColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := APITokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAPITokenToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local APIToken
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, apiTokenDBTypes, false, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.UserID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := APITokenSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*APIToken)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testAPITokenToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a APIToken
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, apiTokenDBTypes, false, strmangle.SetComplement(apiTokenPrimaryKeyColumns, apiTokenColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.APITokens[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testAPITokensReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &APIToken{}
+	if err = randomize.Struct(seed, o, apiTokenDBTypes, true, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAPITokensReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &APIToken{}
+	if err = randomize.Struct(seed, o, apiTokenDBTypes, true, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := APITokenSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAPITokensSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &APIToken{}
+	if err = randomize.Struct(seed, o, apiTokenDBTypes, true, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := APITokens().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	apiTokenDBTypes = map[string]string{`ID`: `INTEGER`, `UserID`: `INTEGER`, `Name`: `TEXT`, `Email`: `TEXT`, `TokenHash`: `TEXT`, `Scopes`: `TEXT`, `ExpiresAt`: `DATETIME`, `LastUsedAt`: `DATETIME`, `CreatedAt`: `DATETIME`}
+	_               = bytes.MinRead
+)
+
+func testAPITokensUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(apiTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(apiTokenAllColumns) == len(apiTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &APIToken{}
+	if err = randomize.Struct(seed, o, apiTokenDBTypes, true, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := APITokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, apiTokenDBTypes, true, apiTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAPITokensSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(apiTokenAllColumns) == len(apiTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &APIToken{}
+	if err = randomize.Struct(seed, o, apiTokenDBTypes, true, apiTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := APITokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, apiTokenDBTypes, true, apiTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(apiTokenAllColumns, apiTokenPrimaryKeyColumns) {
+		fields = apiTokenAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			apiTokenAllColumns,
+			apiTokenPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, apiTokenGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := APITokenSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAPITokensUpsert(t *testing.T) {
+	t.Parallel()
+	if len(apiTokenAllColumns) == len(apiTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := APIToken{}
+	if err = randomize.Struct(seed, &o, apiTokenDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert APIToken: %s", err)
+	}
+
+	count, err := APITokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, apiTokenDBTypes, false, apiTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize APIToken struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert APIToken: %s", err)
+	}
+
+	count, err = APITokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1530 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// BirthdayGroup is an object representing the database table.
+type BirthdayGroup struct {
+	ID          null.Int64  `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	UserID      int64       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Name        string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	LeadDays    string      `boil:"lead_days" json:"lead_days" toml:"lead_days" yaml:"lead_days"`
+	Destination null.String `boil:"destination" json:"destination,omitempty" toml:"destination" yaml:"destination,omitempty"`
+	Template    null.String `boil:"template" json:"template,omitempty" toml:"template" yaml:"template,omitempty"`
+	CreatedAt   null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt   null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *birthdayGroupR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L birthdayGroupL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BirthdayGroupColumns = struct {
+	ID          string
+	UserID      string
+	Name        string
+	LeadDays    string
+	Destination string
+	Template    string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	UserID:      "user_id",
+	Name:        "name",
+	LeadDays:    "lead_days",
+	Destination: "destination",
+	Template:    "template",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var BirthdayGroupTableColumns = struct {
+	ID          string
+	UserID      string
+	Name        string
+	LeadDays    string
+	Destination string
+	Template    string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "birthday_groups.id",
+	UserID:      "birthday_groups.user_id",
+	Name:        "birthday_groups.name",
+	LeadDays:    "birthday_groups.lead_days",
+	Destination: "birthday_groups.destination",
+	Template:    "birthday_groups.template",
+	CreatedAt:   "birthday_groups.created_at",
+	UpdatedAt:   "birthday_groups.updated_at",
+}
+
+// Generated where
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var BirthdayGroupWhere = struct {
+	ID          whereHelpernull_Int64
+	UserID      whereHelperint64
+	Name        whereHelperstring
+	LeadDays    whereHelperstring
+	Destination whereHelpernull_String
+	Template    whereHelpernull_String
+	CreatedAt   whereHelpernull_Time
+	UpdatedAt   whereHelpernull_Time
+}{
+	ID:          whereHelpernull_Int64{field: "\"birthday_groups\".\"id\""},
+	UserID:      whereHelperint64{field: "\"birthday_groups\".\"user_id\""},
+	Name:        whereHelperstring{field: "\"birthday_groups\".\"name\""},
+	LeadDays:    whereHelperstring{field: "\"birthday_groups\".\"lead_days\""},
+	Destination: whereHelpernull_String{field: "\"birthday_groups\".\"destination\""},
+	Template:    whereHelpernull_String{field: "\"birthday_groups\".\"template\""},
+	CreatedAt:   whereHelpernull_Time{field: "\"birthday_groups\".\"created_at\""},
+	UpdatedAt:   whereHelpernull_Time{field: "\"birthday_groups\".\"updated_at\""},
+}
+
+// BirthdayGroupRels is where relationship names are stored.
+var BirthdayGroupRels = struct {
+	User      string
+	Birthdays string
+}{
+	User:      "User",
+	Birthdays: "Birthdays",
+}
+
+// birthdayGroupR is where relationships are stored.
+type birthdayGroupR struct {
+	User      *User         `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Birthdays BirthdaySlice `boil:"Birthdays" json:"Birthdays" toml:"Birthdays" yaml:"Birthdays"`
+}
+
+// NewStruct creates a new relationship struct
+func (*birthdayGroupR) NewStruct() *birthdayGroupR {
+	return &birthdayGroupR{}
+}
+
+func (r *birthdayGroupR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *birthdayGroupR) GetBirthdays() BirthdaySlice {
+	if r == nil {
+		return nil
+	}
+	return r.Birthdays
+}
+
+// birthdayGroupL is where Load methods for each relationship are stored.
+type birthdayGroupL struct{}
+
+var (
+	birthdayGroupAllColumns            = []string{"id", "user_id", "name", "lead_days", "destination", "template", "created_at", "updated_at"}
+	birthdayGroupColumnsWithoutDefault = []string{"user_id", "name"}
+	birthdayGroupColumnsWithDefault    = []string{"id", "lead_days", "destination", "template", "created_at", "updated_at"}
+	birthdayGroupPrimaryKeyColumns     = []string{"id"}
+	birthdayGroupGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// BirthdayGroupSlice is an alias for a slice of pointers to BirthdayGroup.
+	// This should almost always be used instead of []BirthdayGroup.
+	BirthdayGroupSlice []*BirthdayGroup
+	// BirthdayGroupHook is the signature for custom BirthdayGroup hook methods
+	BirthdayGroupHook func(context.Context, boil.ContextExecutor, *BirthdayGroup) error
+
+	birthdayGroupQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	birthdayGroupType                 = reflect.TypeOf(&BirthdayGroup{})
+	birthdayGroupMapping              = queries.MakeStructMapping(birthdayGroupType)
+	birthdayGroupPrimaryKeyMapping, _ = queries.BindMapping(birthdayGroupType, birthdayGroupMapping, birthdayGroupPrimaryKeyColumns)
+	birthdayGroupInsertCacheMut       sync.RWMutex
+	birthdayGroupInsertCache          = make(map[string]insertCache)
+	birthdayGroupUpdateCacheMut       sync.RWMutex
+	birthdayGroupUpdateCache          = make(map[string]updateCache)
+	birthdayGroupUpsertCacheMut       sync.RWMutex
+	birthdayGroupUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var birthdayGroupAfterSelectMu sync.Mutex
+var birthdayGroupAfterSelectHooks []BirthdayGroupHook
+
+var birthdayGroupBeforeInsertMu sync.Mutex
+var birthdayGroupBeforeInsertHooks []BirthdayGroupHook
+var birthdayGroupAfterInsertMu sync.Mutex
+var birthdayGroupAfterInsertHooks []BirthdayGroupHook
+
+var birthdayGroupBeforeUpdateMu sync.Mutex
+var birthdayGroupBeforeUpdateHooks []BirthdayGroupHook
+var birthdayGroupAfterUpdateMu sync.Mutex
+var birthdayGroupAfterUpdateHooks []BirthdayGroupHook
+
+var birthdayGroupBeforeDeleteMu sync.Mutex
+var birthdayGroupBeforeDeleteHooks []BirthdayGroupHook
+var birthdayGroupAfterDeleteMu sync.Mutex
+var birthdayGroupAfterDeleteHooks []BirthdayGroupHook
+
+var birthdayGroupBeforeUpsertMu sync.Mutex
+var birthdayGroupBeforeUpsertHooks []BirthdayGroupHook
+var birthdayGroupAfterUpsertMu sync.Mutex
+var birthdayGroupAfterUpsertHooks []BirthdayGroupHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BirthdayGroup) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayGroupAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BirthdayGroup) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayGroupBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BirthdayGroup) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayGroupAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BirthdayGroup) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayGroupBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BirthdayGroup) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayGroupAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BirthdayGroup) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayGroupBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BirthdayGroup) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayGroupAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BirthdayGroup) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayGroupBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BirthdayGroup) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayGroupAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBirthdayGroupHook registers your hook function for all future operations.
+func AddBirthdayGroupHook(hookPoint boil.HookPoint, birthdayGroupHook BirthdayGroupHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		birthdayGroupAfterSelectMu.Lock()
+		birthdayGroupAfterSelectHooks = append(birthdayGroupAfterSelectHooks, birthdayGroupHook)
+		birthdayGroupAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		birthdayGroupBeforeInsertMu.Lock()
+		birthdayGroupBeforeInsertHooks = append(birthdayGroupBeforeInsertHooks, birthdayGroupHook)
+		birthdayGroupBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		birthdayGroupAfterInsertMu.Lock()
+		birthdayGroupAfterInsertHooks = append(birthdayGroupAfterInsertHooks, birthdayGroupHook)
+		birthdayGroupAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		birthdayGroupBeforeUpdateMu.Lock()
+		birthdayGroupBeforeUpdateHooks = append(birthdayGroupBeforeUpdateHooks, birthdayGroupHook)
+		birthdayGroupBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		birthdayGroupAfterUpdateMu.Lock()
+		birthdayGroupAfterUpdateHooks = append(birthdayGroupAfterUpdateHooks, birthdayGroupHook)
+		birthdayGroupAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		birthdayGroupBeforeDeleteMu.Lock()
+		birthdayGroupBeforeDeleteHooks = append(birthdayGroupBeforeDeleteHooks, birthdayGroupHook)
+		birthdayGroupBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		birthdayGroupAfterDeleteMu.Lock()
+		birthdayGroupAfterDeleteHooks = append(birthdayGroupAfterDeleteHooks, birthdayGroupHook)
+		birthdayGroupAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		birthdayGroupBeforeUpsertMu.Lock()
+		birthdayGroupBeforeUpsertHooks = append(birthdayGroupBeforeUpsertHooks, birthdayGroupHook)
+		birthdayGroupBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		birthdayGroupAfterUpsertMu.Lock()
+		birthdayGroupAfterUpsertHooks = append(birthdayGroupAfterUpsertHooks, birthdayGroupHook)
+		birthdayGroupAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single birthdayGroup record from the query.
+func (q birthdayGroupQuery) One(ctx context.Context, exec boil.ContextExecutor) (*BirthdayGroup, error) {
+	o := &BirthdayGroup{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for birthday_groups")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all BirthdayGroup records from the query.
+func (q birthdayGroupQuery) All(ctx context.Context, exec boil.ContextExecutor) (BirthdayGroupSlice, error) {
+	var o []*BirthdayGroup
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to BirthdayGroup slice")
+	}
+
+	if len(birthdayGroupAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all BirthdayGroup records in the query.
+func (q birthdayGroupQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count birthday_groups rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q birthdayGroupQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if birthday_groups exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *BirthdayGroup) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Birthdays retrieves all the birthday's Birthdays with an executor.
+func (o *BirthdayGroup) Birthdays(mods ...qm.QueryMod) birthdayQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"birthday_group_members\" on \"birthdays\".\"id\" = \"birthday_group_members\".\"birthday_id\""),
+		qm.Where("\"birthday_group_members\".\"group_id\"=?", o.ID),
+	)
+
+	return Birthdays(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (birthdayGroupL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBirthdayGroup interface{}, mods queries.Applicator) error {
+	var slice []*BirthdayGroup
+	var object *BirthdayGroup
+
+	if singular {
+		var ok bool
+		object, ok = maybeBirthdayGroup.(*BirthdayGroup)
+		if !ok {
+			object = new(BirthdayGroup)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBirthdayGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBirthdayGroup))
+			}
+		}
+	} else {
+		s, ok := maybeBirthdayGroup.(*[]*BirthdayGroup)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBirthdayGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBirthdayGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &birthdayGroupR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args[object.UserID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &birthdayGroupR{}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args[obj.UserID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.BirthdayGroups = append(foreign.R.BirthdayGroups, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.BirthdayGroups = append(foreign.R.BirthdayGroups, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBirthdays allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (birthdayGroupL) LoadBirthdays(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBirthdayGroup interface{}, mods queries.Applicator) error {
+	var slice []*BirthdayGroup
+	var object *BirthdayGroup
+
+	if singular {
+		var ok bool
+		object, ok = maybeBirthdayGroup.(*BirthdayGroup)
+		if !ok {
+			object = new(BirthdayGroup)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBirthdayGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBirthdayGroup))
+			}
+		}
+	} else {
+		s, ok := maybeBirthdayGroup.(*[]*BirthdayGroup)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBirthdayGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBirthdayGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &birthdayGroupR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &birthdayGroupR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"birthdays\".\"id\", \"birthdays\".\"user_id\", \"birthdays\".\"name\", \"birthdays\".\"date\", \"birthdays\".\"created_at\", \"birthdays\".\"updated_at\", \"birthdays\".\"year_known\", \"birthdays\".\"event_type\", \"birthdays\".\"years_label\", \"birthdays\".\"calendar\", \"birthdays\".\"notes\", \"birthdays\".\"telegram_username\", \"birthdays\".\"phone\", \"birthdays\".\"email\", \"birthdays\".\"deleted_at\", \"birthdays\".\"version\", \"birthdays\".\"list_id\", \"a\".\"group_id\""),
+		qm.From("\"birthdays\""),
+		qm.InnerJoin("\"birthday_group_members\" as \"a\" on \"birthdays\".\"id\" = \"a\".\"birthday_id\""),
+		qm.WhereIn("\"a\".\"group_id\" in ?", argsSlice...),
+		qmhelper.WhereIsNull("\"birthdays\".\"deleted_at\""),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load birthdays")
+	}
+
+	var resultSlice []*Birthday
+
+	var localJoinCols []int64
+	for results.Next() {
+		one := new(Birthday)
+		var localJoinCol int64
+
+		err = results.Scan(&one.ID, &one.UserID, &one.Name, &one.Date, &one.CreatedAt, &one.UpdatedAt, &one.YearKnown, &one.EventType, &one.YearsLabel, &one.Calendar, &one.Notes, &one.TelegramUsername, &one.Phone, &one.Email, &one.DeletedAt, &one.Version, &one.ListID, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for birthdays")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice birthdays")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on birthdays")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for birthdays")
+	}
+
+	if len(birthdayAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Birthdays = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &birthdayR{}
+			}
+			foreign.R.GroupBirthdayGroups = append(foreign.R.GroupBirthdayGroups, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if queries.Equal(local.ID, localJoinCol) {
+				local.R.Birthdays = append(local.R.Birthdays, foreign)
+				if foreign.R == nil {
+					foreign.R = &birthdayR{}
+				}
+				foreign.R.GroupBirthdayGroups = append(foreign.R.GroupBirthdayGroups, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the birthdayGroup to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.BirthdayGroups.
+func (o *BirthdayGroup) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"birthday_groups\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 0, birthdayGroupPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserID, related.ID)
+	if o.R == nil {
+		o.R = &birthdayGroupR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			BirthdayGroups: BirthdayGroupSlice{o},
+		}
+	} else {
+		related.R.BirthdayGroups = append(related.R.BirthdayGroups, o)
+	}
+
+	return nil
+}
+
+// AddBirthdays adds the given related objects to the existing relationships
+// of the birthday_group, optionally inserting them as new records.
+// Appends related to o.R.Birthdays.
+// Sets related.R.GroupBirthdayGroups appropriately.
+func (o *BirthdayGroup) AddBirthdays(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Birthday) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"birthday_group_members\" (\"group_id\", \"birthday_id\") values (?, ?)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &birthdayGroupR{
+			Birthdays: related,
+		}
+	} else {
+		o.R.Birthdays = append(o.R.Birthdays, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &birthdayR{
+				GroupBirthdayGroups: BirthdayGroupSlice{o},
+			}
+		} else {
+			rel.R.GroupBirthdayGroups = append(rel.R.GroupBirthdayGroups, o)
+		}
+	}
+	return nil
+}
+
+// SetBirthdays removes all previously related items of the
+// birthday_group replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.GroupBirthdayGroups's Birthdays accordingly.
+// Replaces o.R.Birthdays with related.
+// Sets related.R.GroupBirthdayGroups's Birthdays accordingly.
+func (o *BirthdayGroup) SetBirthdays(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Birthday) error {
+	query := "delete from \"birthday_group_members\" where \"group_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeBirthdaysFromGroupBirthdayGroupsSlice(o, related)
+	if o.R != nil {
+		o.R.Birthdays = nil
+	}
+
+	return o.AddBirthdays(ctx, exec, insert, related...)
+}
+
+// RemoveBirthdays relationships from objects passed in.
+// Removes related items from R.Birthdays (uses pointer comparison, removal does not keep order)
+// Sets related.R.GroupBirthdayGroups.
+func (o *BirthdayGroup) RemoveBirthdays(ctx context.Context, exec boil.ContextExecutor, related ...*Birthday) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"birthday_group_members\" where \"group_id\" = ? and \"birthday_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeBirthdaysFromGroupBirthdayGroupsSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Birthdays {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Birthdays)
+			if ln > 1 && i < ln-1 {
+				o.R.Birthdays[i] = o.R.Birthdays[ln-1]
+			}
+			o.R.Birthdays = o.R.Birthdays[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeBirthdaysFromGroupBirthdayGroupsSlice(o *BirthdayGroup, related []*Birthday) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.GroupBirthdayGroups {
+			if !queries.Equal(o.ID, ri.ID) {
+				continue
+			}
+
+			ln := len(rel.R.GroupBirthdayGroups)
+			if ln > 1 && i < ln-1 {
+				rel.R.GroupBirthdayGroups[i] = rel.R.GroupBirthdayGroups[ln-1]
+			}
+			rel.R.GroupBirthdayGroups = rel.R.GroupBirthdayGroups[:ln-1]
+			break
+		}
+	}
+}
+
+// BirthdayGroups retrieves all the records using an executor.
+func BirthdayGroups(mods ...qm.QueryMod) birthdayGroupQuery {
+	mods = append(mods, qm.From("\"birthday_groups\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"birthday_groups\".*"})
+	}
+
+	return birthdayGroupQuery{q}
+}
+
+// FindBirthdayGroup retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBirthdayGroup(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*BirthdayGroup, error) {
+	birthdayGroupObj := &BirthdayGroup{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"birthday_groups\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, birthdayGroupObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from birthday_groups")
+	}
+
+	if err = birthdayGroupObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return birthdayGroupObj, err
+	}
+
+	return birthdayGroupObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BirthdayGroup) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no birthday_groups provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(birthdayGroupColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	birthdayGroupInsertCacheMut.RLock()
+	cache, cached := birthdayGroupInsertCache[key]
+	birthdayGroupInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			birthdayGroupAllColumns,
+			birthdayGroupColumnsWithDefault,
+			birthdayGroupColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, birthdayGroupGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(birthdayGroupType, birthdayGroupMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(birthdayGroupType, birthdayGroupMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"birthday_groups\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"birthday_groups\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into birthday_groups")
+	}
+
+	if !cached {
+		birthdayGroupInsertCacheMut.Lock()
+		birthdayGroupInsertCache[key] = cache
+		birthdayGroupInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the BirthdayGroup.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BirthdayGroup) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	birthdayGroupUpdateCacheMut.RLock()
+	cache, cached := birthdayGroupUpdateCache[key]
+	birthdayGroupUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			birthdayGroupAllColumns,
+			birthdayGroupPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, birthdayGroupGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update birthday_groups, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"birthday_groups\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, birthdayGroupPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(birthdayGroupType, birthdayGroupMapping, append(wl, birthdayGroupPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update birthday_groups row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for birthday_groups")
+	}
+
+	if !cached {
+		birthdayGroupUpdateCacheMut.Lock()
+		birthdayGroupUpdateCache[key] = cache
+		birthdayGroupUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q birthdayGroupQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for birthday_groups")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for birthday_groups")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BirthdayGroupSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), birthdayGroupPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"birthday_groups\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, birthdayGroupPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in birthdayGroup slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all birthdayGroup")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *BirthdayGroup) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no birthday_groups provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(birthdayGroupColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	birthdayGroupUpsertCacheMut.RLock()
+	cache, cached := birthdayGroupUpsertCache[key]
+	birthdayGroupUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			birthdayGroupAllColumns,
+			birthdayGroupColumnsWithDefault,
+			birthdayGroupColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			birthdayGroupAllColumns,
+			birthdayGroupPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert birthday_groups, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(birthdayGroupAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(birthdayGroupPrimaryKeyColumns))
+			copy(conflict, birthdayGroupPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"birthday_groups\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(birthdayGroupType, birthdayGroupMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(birthdayGroupType, birthdayGroupMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert birthday_groups")
+	}
+
+	if !cached {
+		birthdayGroupUpsertCacheMut.Lock()
+		birthdayGroupUpsertCache[key] = cache
+		birthdayGroupUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single BirthdayGroup record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BirthdayGroup) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no BirthdayGroup provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), birthdayGroupPrimaryKeyMapping)
+	sql := "DELETE FROM \"birthday_groups\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from birthday_groups")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for birthday_groups")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q birthdayGroupQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no birthdayGroupQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from birthday_groups")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for birthday_groups")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BirthdayGroupSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(birthdayGroupBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), birthdayGroupPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"birthday_groups\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, birthdayGroupPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from birthdayGroup slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for birthday_groups")
+	}
+
+	if len(birthdayGroupAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BirthdayGroup) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBirthdayGroup(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BirthdayGroupSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BirthdayGroupSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), birthdayGroupPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"birthday_groups\".* FROM \"birthday_groups\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, birthdayGroupPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in BirthdayGroupSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BirthdayGroupExists checks if the BirthdayGroup row exists.
+func BirthdayGroupExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"birthday_groups\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if birthday_groups exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the BirthdayGroup row exists.
+func (o *BirthdayGroup) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BirthdayGroupExists(ctx, exec, o.ID)
+}
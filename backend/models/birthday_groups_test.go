@@ -0,0 +1,1163 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testBirthdayGroups(t *testing.T) {
+	t.Parallel()
+
+	query := BirthdayGroups()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testBirthdayGroupsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayGroup{}
+	if err = randomize.Struct(seed, o, birthdayGroupDBTypes, true, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BirthdayGroups().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBirthdayGroupsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayGroup{}
+	if err = randomize.Struct(seed, o, birthdayGroupDBTypes, true, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := BirthdayGroups().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BirthdayGroups().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBirthdayGroupsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayGroup{}
+	if err = randomize.Struct(seed, o, birthdayGroupDBTypes, true, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BirthdayGroupSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BirthdayGroups().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBirthdayGroupsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayGroup{}
+	if err = randomize.Struct(seed, o, birthdayGroupDBTypes, true, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := BirthdayGroupExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if BirthdayGroup exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected BirthdayGroupExists to return true, but got false.")
+	}
+}
+
+func testBirthdayGroupsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayGroup{}
+	if err = randomize.Struct(seed, o, birthdayGroupDBTypes, true, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	birthdayGroupFound, err := FindBirthdayGroup(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if birthdayGroupFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testBirthdayGroupsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayGroup{}
+	if err = randomize.Struct(seed, o, birthdayGroupDBTypes, true, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = BirthdayGroups().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBirthdayGroupsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayGroup{}
+	if err = randomize.Struct(seed, o, birthdayGroupDBTypes, true, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := BirthdayGroups().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testBirthdayGroupsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	birthdayGroupOne := &BirthdayGroup{}
+	birthdayGroupTwo := &BirthdayGroup{}
+	if err = randomize.Struct(seed, birthdayGroupOne, birthdayGroupDBTypes, false, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+	if err = randomize.Struct(seed, birthdayGroupTwo, birthdayGroupDBTypes, false, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = birthdayGroupOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = birthdayGroupTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := BirthdayGroups().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testBirthdayGroupsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	birthdayGroupOne := &BirthdayGroup{}
+	birthdayGroupTwo := &BirthdayGroup{}
+	if err = randomize.Struct(seed, birthdayGroupOne, birthdayGroupDBTypes, false, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+	if err = randomize.Struct(seed, birthdayGroupTwo, birthdayGroupDBTypes, false, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = birthdayGroupOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = birthdayGroupTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BirthdayGroups().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func birthdayGroupBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayGroup) error {
+	*o = BirthdayGroup{}
+	return nil
+}
+
+func birthdayGroupAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayGroup) error {
+	*o = BirthdayGroup{}
+	return nil
+}
+
+func birthdayGroupAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayGroup) error {
+	*o = BirthdayGroup{}
+	return nil
+}
+
+func birthdayGroupBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayGroup) error {
+	*o = BirthdayGroup{}
+	return nil
+}
+
+func birthdayGroupAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayGroup) error {
+	*o = BirthdayGroup{}
+	return nil
+}
+
+func birthdayGroupBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayGroup) error {
+	*o = BirthdayGroup{}
+	return nil
+}
+
+func birthdayGroupAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayGroup) error {
+	*o = BirthdayGroup{}
+	return nil
+}
+
+func birthdayGroupBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayGroup) error {
+	*o = BirthdayGroup{}
+	return nil
+}
+
+func birthdayGroupAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayGroup) error {
+	*o = BirthdayGroup{}
+	return nil
+}
+
+func testBirthdayGroupsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &BirthdayGroup{}
+	o := &BirthdayGroup{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, birthdayGroupDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup object: %s", err)
+	}
+
+	AddBirthdayGroupHook(boil.BeforeInsertHook, birthdayGroupBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	birthdayGroupBeforeInsertHooks = []BirthdayGroupHook{}
+
+	AddBirthdayGroupHook(boil.AfterInsertHook, birthdayGroupAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	birthdayGroupAfterInsertHooks = []BirthdayGroupHook{}
+
+	AddBirthdayGroupHook(boil.AfterSelectHook, birthdayGroupAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	birthdayGroupAfterSelectHooks = []BirthdayGroupHook{}
+
+	AddBirthdayGroupHook(boil.BeforeUpdateHook, birthdayGroupBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	birthdayGroupBeforeUpdateHooks = []BirthdayGroupHook{}
+
+	AddBirthdayGroupHook(boil.AfterUpdateHook, birthdayGroupAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	birthdayGroupAfterUpdateHooks = []BirthdayGroupHook{}
+
+	AddBirthdayGroupHook(boil.BeforeDeleteHook, birthdayGroupBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	birthdayGroupBeforeDeleteHooks = []BirthdayGroupHook{}
+
+	AddBirthdayGroupHook(boil.AfterDeleteHook, birthdayGroupAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	birthdayGroupAfterDeleteHooks = []BirthdayGroupHook{}
+
+	AddBirthdayGroupHook(boil.BeforeUpsertHook, birthdayGroupBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	birthdayGroupBeforeUpsertHooks = []BirthdayGroupHook{}
+
+	AddBirthdayGroupHook(boil.AfterUpsertHook, birthdayGroupAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	birthdayGroupAfterUpsertHooks = []BirthdayGroupHook{}
+}
+
+func testBirthdayGroupsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayGroup{}
+	if err = randomize.Struct(seed, o, birthdayGroupDBTypes, true, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BirthdayGroups().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBirthdayGroupsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayGroup{}
+	if err = randomize.Struct(seed, o, birthdayGroupDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(birthdayGroupColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BirthdayGroups().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBirthdayGroupToManyBirthdays(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a BirthdayGroup
+	var b, c Birthday
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayGroupDBTypes, true, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, birthdayDBTypes, false, birthdayColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, birthdayDBTypes, false, birthdayColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = tx.Exec("insert into \"birthday_group_members\" (\"group_id\", \"birthday_id\") values (?, ?)", a.ID, b.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = tx.Exec("insert into \"birthday_group_members\" (\"group_id\", \"birthday_id\") values (?, ?)", a.ID, c.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Birthdays().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.ID, b.ID) {
+			bFound = true
+		}
+		if queries.Equal(v.ID, c.ID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := BirthdayGroupSlice{&a}
+	if err = a.L.LoadBirthdays(ctx, tx, false, (*[]*BirthdayGroup)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Birthdays); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Birthdays = nil
+	if err = a.L.LoadBirthdays(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Birthdays); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testBirthdayGroupToManyAddOpBirthdays(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a BirthdayGroup
+	var b, c, d, e Birthday
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayGroupDBTypes, false, strmangle.SetComplement(birthdayGroupPrimaryKeyColumns, birthdayGroupColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Birthday{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, birthdayDBTypes, false, strmangle.SetComplement(birthdayPrimaryKeyColumns, birthdayColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Birthday{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddBirthdays(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if first.R.GroupBirthdayGroups[0] != &a {
+			t.Error("relationship was not added properly to the slice")
+		}
+		if second.R.GroupBirthdayGroups[0] != &a {
+			t.Error("relationship was not added properly to the slice")
+		}
+
+		if a.R.Birthdays[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Birthdays[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Birthdays().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testBirthdayGroupToManySetOpBirthdays(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a BirthdayGroup
+	var b, c, d, e Birthday
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayGroupDBTypes, false, strmangle.SetComplement(birthdayGroupPrimaryKeyColumns, birthdayGroupColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Birthday{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, birthdayDBTypes, false, strmangle.SetComplement(birthdayPrimaryKeyColumns, birthdayColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetBirthdays(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Birthdays().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetBirthdays(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Birthdays().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	// The following checks cannot be implemented since we have no handle
+	// to these when we call Set(). Leaving them here as wishful thinking
+	// and to let people know there's dragons.
+	//
+	// if len(b.R.GroupBirthdayGroups) != 0 {
+	// 	t.Error("relationship was not removed properly from the slice")
+	// }
+	// if len(c.R.GroupBirthdayGroups) != 0 {
+	// 	t.Error("relationship was not removed properly from the slice")
+	// }
+	if d.R.GroupBirthdayGroups[0] != &a {
+		t.Error("relationship was not added properly to the slice")
+	}
+	if e.R.GroupBirthdayGroups[0] != &a {
+		t.Error("relationship was not added properly to the slice")
+	}
+
+	if a.R.Birthdays[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Birthdays[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testBirthdayGroupToManyRemoveOpBirthdays(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a BirthdayGroup
+	var b, c, d, e Birthday
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayGroupDBTypes, false, strmangle.SetComplement(birthdayGroupPrimaryKeyColumns, birthdayGroupColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Birthday{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, birthdayDBTypes, false, strmangle.SetComplement(birthdayPrimaryKeyColumns, birthdayColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddBirthdays(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Birthdays().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveBirthdays(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Birthdays().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if len(b.R.GroupBirthdayGroups) != 0 {
+		t.Error("relationship was not removed properly from the slice")
+	}
+	if len(c.R.GroupBirthdayGroups) != 0 {
+		t.Error("relationship was not removed properly from the slice")
+	}
+	if d.R.GroupBirthdayGroups[0] != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.GroupBirthdayGroups[0] != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if len(a.R.Birthdays) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Birthdays[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Birthdays[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testBirthdayGroupToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local BirthdayGroup
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, birthdayGroupDBTypes, false, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.UserID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := BirthdayGroupSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*BirthdayGroup)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testBirthdayGroupToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a BirthdayGroup
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayGroupDBTypes, false, strmangle.SetComplement(birthdayGroupPrimaryKeyColumns, birthdayGroupColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.BirthdayGroups[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testBirthdayGroupsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayGroup{}
+	if err = randomize.Struct(seed, o, birthdayGroupDBTypes, true, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBirthdayGroupsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayGroup{}
+	if err = randomize.Struct(seed, o, birthdayGroupDBTypes, true, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BirthdayGroupSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBirthdayGroupsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayGroup{}
+	if err = randomize.Struct(seed, o, birthdayGroupDBTypes, true, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := BirthdayGroups().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	birthdayGroupDBTypes = map[string]string{`ID`: `INTEGER`, `UserID`: `INTEGER`, `Name`: `TEXT`, `LeadDays`: `TEXT`, `Destination`: `TEXT`, `Template`: `TEXT`, `CreatedAt`: `DATETIME`, `UpdatedAt`: `DATETIME`}
+	_                    = bytes.MinRead
+)
+
+func testBirthdayGroupsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(birthdayGroupPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(birthdayGroupAllColumns) == len(birthdayGroupPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayGroup{}
+	if err = randomize.Struct(seed, o, birthdayGroupDBTypes, true, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BirthdayGroups().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, birthdayGroupDBTypes, true, birthdayGroupPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testBirthdayGroupsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(birthdayGroupAllColumns) == len(birthdayGroupPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayGroup{}
+	if err = randomize.Struct(seed, o, birthdayGroupDBTypes, true, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BirthdayGroups().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, birthdayGroupDBTypes, true, birthdayGroupPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(birthdayGroupAllColumns, birthdayGroupPrimaryKeyColumns) {
+		fields = birthdayGroupAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			birthdayGroupAllColumns,
+			birthdayGroupPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, birthdayGroupGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := BirthdayGroupSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testBirthdayGroupsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(birthdayGroupAllColumns) == len(birthdayGroupPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := BirthdayGroup{}
+	if err = randomize.Struct(seed, &o, birthdayGroupDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert BirthdayGroup: %s", err)
+	}
+
+	count, err := BirthdayGroups().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, birthdayGroupDBTypes, false, birthdayGroupPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BirthdayGroup struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert BirthdayGroup: %s", err)
+	}
+
+	count, err = BirthdayGroups().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
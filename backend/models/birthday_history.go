@@ -0,0 +1,1394 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// BirthdayHistory is an object representing the database table.
+type BirthdayHistory struct {
+	ID         null.Int64  `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	BirthdayID int64       `boil:"birthday_id" json:"birthday_id" toml:"birthday_id" yaml:"birthday_id"`
+	UserID     null.Int64  `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
+	Action     string      `boil:"action" json:"action" toml:"action" yaml:"action"`
+	OldValues  null.String `boil:"old_values" json:"old_values,omitempty" toml:"old_values" yaml:"old_values,omitempty"`
+	NewValues  null.String `boil:"new_values" json:"new_values,omitempty" toml:"new_values" yaml:"new_values,omitempty"`
+	CreatedAt  null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *birthdayHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L birthdayHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BirthdayHistoryColumns = struct {
+	ID         string
+	BirthdayID string
+	UserID     string
+	Action     string
+	OldValues  string
+	NewValues  string
+	CreatedAt  string
+}{
+	ID:         "id",
+	BirthdayID: "birthday_id",
+	UserID:     "user_id",
+	Action:     "action",
+	OldValues:  "old_values",
+	NewValues:  "new_values",
+	CreatedAt:  "created_at",
+}
+
+var BirthdayHistoryTableColumns = struct {
+	ID         string
+	BirthdayID string
+	UserID     string
+	Action     string
+	OldValues  string
+	NewValues  string
+	CreatedAt  string
+}{
+	ID:         "birthday_history.id",
+	BirthdayID: "birthday_history.birthday_id",
+	UserID:     "birthday_history.user_id",
+	Action:     "birthday_history.action",
+	OldValues:  "birthday_history.old_values",
+	NewValues:  "birthday_history.new_values",
+	CreatedAt:  "birthday_history.created_at",
+}
+
+// Generated where
+
+var BirthdayHistoryWhere = struct {
+	ID         whereHelpernull_Int64
+	BirthdayID whereHelperint64
+	UserID     whereHelpernull_Int64
+	Action     whereHelperstring
+	OldValues  whereHelpernull_String
+	NewValues  whereHelpernull_String
+	CreatedAt  whereHelpernull_Time
+}{
+	ID:         whereHelpernull_Int64{field: "\"birthday_history\".\"id\""},
+	BirthdayID: whereHelperint64{field: "\"birthday_history\".\"birthday_id\""},
+	UserID:     whereHelpernull_Int64{field: "\"birthday_history\".\"user_id\""},
+	Action:     whereHelperstring{field: "\"birthday_history\".\"action\""},
+	OldValues:  whereHelpernull_String{field: "\"birthday_history\".\"old_values\""},
+	NewValues:  whereHelpernull_String{field: "\"birthday_history\".\"new_values\""},
+	CreatedAt:  whereHelpernull_Time{field: "\"birthday_history\".\"created_at\""},
+}
+
+// BirthdayHistoryRels is where relationship names are stored.
+var BirthdayHistoryRels = struct {
+	User     string
+	Birthday string
+}{
+	User:     "User",
+	Birthday: "Birthday",
+}
+
+// birthdayHistoryR is where relationships are stored.
+type birthdayHistoryR struct {
+	User     *User     `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Birthday *Birthday `boil:"Birthday" json:"Birthday" toml:"Birthday" yaml:"Birthday"`
+}
+
+// NewStruct creates a new relationship struct
+func (*birthdayHistoryR) NewStruct() *birthdayHistoryR {
+	return &birthdayHistoryR{}
+}
+
+func (r *birthdayHistoryR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *birthdayHistoryR) GetBirthday() *Birthday {
+	if r == nil {
+		return nil
+	}
+	return r.Birthday
+}
+
+// birthdayHistoryL is where Load methods for each relationship are stored.
+type birthdayHistoryL struct{}
+
+var (
+	birthdayHistoryAllColumns            = []string{"id", "birthday_id", "user_id", "action", "old_values", "new_values", "created_at"}
+	birthdayHistoryColumnsWithoutDefault = []string{"birthday_id", "action"}
+	birthdayHistoryColumnsWithDefault    = []string{"id", "user_id", "old_values", "new_values", "created_at"}
+	birthdayHistoryPrimaryKeyColumns     = []string{"id"}
+	birthdayHistoryGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// BirthdayHistorySlice is an alias for a slice of pointers to BirthdayHistory.
+	// This should almost always be used instead of []BirthdayHistory.
+	BirthdayHistorySlice []*BirthdayHistory
+	// BirthdayHistoryHook is the signature for custom BirthdayHistory hook methods
+	BirthdayHistoryHook func(context.Context, boil.ContextExecutor, *BirthdayHistory) error
+
+	birthdayHistoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	birthdayHistoryType                 = reflect.TypeOf(&BirthdayHistory{})
+	birthdayHistoryMapping              = queries.MakeStructMapping(birthdayHistoryType)
+	birthdayHistoryPrimaryKeyMapping, _ = queries.BindMapping(birthdayHistoryType, birthdayHistoryMapping, birthdayHistoryPrimaryKeyColumns)
+	birthdayHistoryInsertCacheMut       sync.RWMutex
+	birthdayHistoryInsertCache          = make(map[string]insertCache)
+	birthdayHistoryUpdateCacheMut       sync.RWMutex
+	birthdayHistoryUpdateCache          = make(map[string]updateCache)
+	birthdayHistoryUpsertCacheMut       sync.RWMutex
+	birthdayHistoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var birthdayHistoryAfterSelectMu sync.Mutex
+var birthdayHistoryAfterSelectHooks []BirthdayHistoryHook
+
+var birthdayHistoryBeforeInsertMu sync.Mutex
+var birthdayHistoryBeforeInsertHooks []BirthdayHistoryHook
+var birthdayHistoryAfterInsertMu sync.Mutex
+var birthdayHistoryAfterInsertHooks []BirthdayHistoryHook
+
+var birthdayHistoryBeforeUpdateMu sync.Mutex
+var birthdayHistoryBeforeUpdateHooks []BirthdayHistoryHook
+var birthdayHistoryAfterUpdateMu sync.Mutex
+var birthdayHistoryAfterUpdateHooks []BirthdayHistoryHook
+
+var birthdayHistoryBeforeDeleteMu sync.Mutex
+var birthdayHistoryBeforeDeleteHooks []BirthdayHistoryHook
+var birthdayHistoryAfterDeleteMu sync.Mutex
+var birthdayHistoryAfterDeleteHooks []BirthdayHistoryHook
+
+var birthdayHistoryBeforeUpsertMu sync.Mutex
+var birthdayHistoryBeforeUpsertHooks []BirthdayHistoryHook
+var birthdayHistoryAfterUpsertMu sync.Mutex
+var birthdayHistoryAfterUpsertHooks []BirthdayHistoryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BirthdayHistory) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayHistoryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BirthdayHistory) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayHistoryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BirthdayHistory) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayHistoryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BirthdayHistory) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayHistoryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BirthdayHistory) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayHistoryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BirthdayHistory) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayHistoryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BirthdayHistory) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayHistoryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BirthdayHistory) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayHistoryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BirthdayHistory) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayHistoryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBirthdayHistoryHook registers your hook function for all future operations.
+func AddBirthdayHistoryHook(hookPoint boil.HookPoint, birthdayHistoryHook BirthdayHistoryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		birthdayHistoryAfterSelectMu.Lock()
+		birthdayHistoryAfterSelectHooks = append(birthdayHistoryAfterSelectHooks, birthdayHistoryHook)
+		birthdayHistoryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		birthdayHistoryBeforeInsertMu.Lock()
+		birthdayHistoryBeforeInsertHooks = append(birthdayHistoryBeforeInsertHooks, birthdayHistoryHook)
+		birthdayHistoryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		birthdayHistoryAfterInsertMu.Lock()
+		birthdayHistoryAfterInsertHooks = append(birthdayHistoryAfterInsertHooks, birthdayHistoryHook)
+		birthdayHistoryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		birthdayHistoryBeforeUpdateMu.Lock()
+		birthdayHistoryBeforeUpdateHooks = append(birthdayHistoryBeforeUpdateHooks, birthdayHistoryHook)
+		birthdayHistoryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		birthdayHistoryAfterUpdateMu.Lock()
+		birthdayHistoryAfterUpdateHooks = append(birthdayHistoryAfterUpdateHooks, birthdayHistoryHook)
+		birthdayHistoryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		birthdayHistoryBeforeDeleteMu.Lock()
+		birthdayHistoryBeforeDeleteHooks = append(birthdayHistoryBeforeDeleteHooks, birthdayHistoryHook)
+		birthdayHistoryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		birthdayHistoryAfterDeleteMu.Lock()
+		birthdayHistoryAfterDeleteHooks = append(birthdayHistoryAfterDeleteHooks, birthdayHistoryHook)
+		birthdayHistoryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		birthdayHistoryBeforeUpsertMu.Lock()
+		birthdayHistoryBeforeUpsertHooks = append(birthdayHistoryBeforeUpsertHooks, birthdayHistoryHook)
+		birthdayHistoryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		birthdayHistoryAfterUpsertMu.Lock()
+		birthdayHistoryAfterUpsertHooks = append(birthdayHistoryAfterUpsertHooks, birthdayHistoryHook)
+		birthdayHistoryAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single birthdayHistory record from the query.
+func (q birthdayHistoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*BirthdayHistory, error) {
+	o := &BirthdayHistory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for birthday_history")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all BirthdayHistory records from the query.
+func (q birthdayHistoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (BirthdayHistorySlice, error) {
+	var o []*BirthdayHistory
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to BirthdayHistory slice")
+	}
+
+	if len(birthdayHistoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all BirthdayHistory records in the query.
+func (q birthdayHistoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count birthday_history rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q birthdayHistoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if birthday_history exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *BirthdayHistory) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Birthday pointed to by the foreign key.
+func (o *BirthdayHistory) Birthday(mods ...qm.QueryMod) birthdayQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.BirthdayID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Birthdays(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (birthdayHistoryL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBirthdayHistory interface{}, mods queries.Applicator) error {
+	var slice []*BirthdayHistory
+	var object *BirthdayHistory
+
+	if singular {
+		var ok bool
+		object, ok = maybeBirthdayHistory.(*BirthdayHistory)
+		if !ok {
+			object = new(BirthdayHistory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBirthdayHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBirthdayHistory))
+			}
+		}
+	} else {
+		s, ok := maybeBirthdayHistory.(*[]*BirthdayHistory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBirthdayHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBirthdayHistory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &birthdayHistoryR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args[object.UserID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &birthdayHistoryR{}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args[obj.UserID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.BirthdayHistories = append(foreign.R.BirthdayHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.BirthdayHistories = append(foreign.R.BirthdayHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBirthday allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (birthdayHistoryL) LoadBirthday(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBirthdayHistory interface{}, mods queries.Applicator) error {
+	var slice []*BirthdayHistory
+	var object *BirthdayHistory
+
+	if singular {
+		var ok bool
+		object, ok = maybeBirthdayHistory.(*BirthdayHistory)
+		if !ok {
+			object = new(BirthdayHistory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBirthdayHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBirthdayHistory))
+			}
+		}
+	} else {
+		s, ok := maybeBirthdayHistory.(*[]*BirthdayHistory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBirthdayHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBirthdayHistory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &birthdayHistoryR{}
+		}
+		if !queries.IsNil(object.BirthdayID) {
+			args[object.BirthdayID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &birthdayHistoryR{}
+			}
+
+			if !queries.IsNil(obj.BirthdayID) {
+				args[obj.BirthdayID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`birthdays`),
+		qm.WhereIn(`birthdays.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`birthdays.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Birthday")
+	}
+
+	var resultSlice []*Birthday
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Birthday")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for birthdays")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for birthdays")
+	}
+
+	if len(birthdayAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Birthday = foreign
+		if foreign.R == nil {
+			foreign.R = &birthdayR{}
+		}
+		foreign.R.BirthdayHistories = append(foreign.R.BirthdayHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.BirthdayID, foreign.ID) {
+				local.R.Birthday = foreign
+				if foreign.R == nil {
+					foreign.R = &birthdayR{}
+				}
+				foreign.R.BirthdayHistories = append(foreign.R.BirthdayHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the birthdayHistory to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.BirthdayHistories.
+func (o *BirthdayHistory) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"birthday_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 0, birthdayHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserID, related.ID)
+	if o.R == nil {
+		o.R = &birthdayHistoryR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			BirthdayHistories: BirthdayHistorySlice{o},
+		}
+	} else {
+		related.R.BirthdayHistories = append(related.R.BirthdayHistories, o)
+	}
+
+	return nil
+}
+
+// RemoveUser relationship.
+// Sets o.R.User to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *BirthdayHistory) RemoveUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.UserID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.User = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.BirthdayHistories {
+		if queries.Equal(o.UserID, ri.UserID) {
+			continue
+		}
+
+		ln := len(related.R.BirthdayHistories)
+		if ln > 1 && i < ln-1 {
+			related.R.BirthdayHistories[i] = related.R.BirthdayHistories[ln-1]
+		}
+		related.R.BirthdayHistories = related.R.BirthdayHistories[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetBirthday of the birthdayHistory to the related item.
+// Sets o.R.Birthday to related.
+// Adds o to related.R.BirthdayHistories.
+func (o *BirthdayHistory) SetBirthday(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Birthday) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"birthday_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"birthday_id"}),
+		strmangle.WhereClause("\"", "\"", 0, birthdayHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.BirthdayID, related.ID)
+	if o.R == nil {
+		o.R = &birthdayHistoryR{
+			Birthday: related,
+		}
+	} else {
+		o.R.Birthday = related
+	}
+
+	if related.R == nil {
+		related.R = &birthdayR{
+			BirthdayHistories: BirthdayHistorySlice{o},
+		}
+	} else {
+		related.R.BirthdayHistories = append(related.R.BirthdayHistories, o)
+	}
+
+	return nil
+}
+
+// BirthdayHistories retrieves all the records using an executor.
+func BirthdayHistories(mods ...qm.QueryMod) birthdayHistoryQuery {
+	mods = append(mods, qm.From("\"birthday_history\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"birthday_history\".*"})
+	}
+
+	return birthdayHistoryQuery{q}
+}
+
+// FindBirthdayHistory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBirthdayHistory(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*BirthdayHistory, error) {
+	birthdayHistoryObj := &BirthdayHistory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"birthday_history\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, birthdayHistoryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from birthday_history")
+	}
+
+	if err = birthdayHistoryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return birthdayHistoryObj, err
+	}
+
+	return birthdayHistoryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BirthdayHistory) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no birthday_history provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(birthdayHistoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	birthdayHistoryInsertCacheMut.RLock()
+	cache, cached := birthdayHistoryInsertCache[key]
+	birthdayHistoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			birthdayHistoryAllColumns,
+			birthdayHistoryColumnsWithDefault,
+			birthdayHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, birthdayHistoryGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(birthdayHistoryType, birthdayHistoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(birthdayHistoryType, birthdayHistoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"birthday_history\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"birthday_history\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into birthday_history")
+	}
+
+	if !cached {
+		birthdayHistoryInsertCacheMut.Lock()
+		birthdayHistoryInsertCache[key] = cache
+		birthdayHistoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the BirthdayHistory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BirthdayHistory) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	birthdayHistoryUpdateCacheMut.RLock()
+	cache, cached := birthdayHistoryUpdateCache[key]
+	birthdayHistoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			birthdayHistoryAllColumns,
+			birthdayHistoryPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, birthdayHistoryGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update birthday_history, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"birthday_history\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, birthdayHistoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(birthdayHistoryType, birthdayHistoryMapping, append(wl, birthdayHistoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update birthday_history row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for birthday_history")
+	}
+
+	if !cached {
+		birthdayHistoryUpdateCacheMut.Lock()
+		birthdayHistoryUpdateCache[key] = cache
+		birthdayHistoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q birthdayHistoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for birthday_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for birthday_history")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BirthdayHistorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), birthdayHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"birthday_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, birthdayHistoryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in birthdayHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all birthdayHistory")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *BirthdayHistory) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no birthday_history provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(birthdayHistoryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	birthdayHistoryUpsertCacheMut.RLock()
+	cache, cached := birthdayHistoryUpsertCache[key]
+	birthdayHistoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			birthdayHistoryAllColumns,
+			birthdayHistoryColumnsWithDefault,
+			birthdayHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			birthdayHistoryAllColumns,
+			birthdayHistoryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert birthday_history, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(birthdayHistoryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(birthdayHistoryPrimaryKeyColumns))
+			copy(conflict, birthdayHistoryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"birthday_history\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(birthdayHistoryType, birthdayHistoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(birthdayHistoryType, birthdayHistoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert birthday_history")
+	}
+
+	if !cached {
+		birthdayHistoryUpsertCacheMut.Lock()
+		birthdayHistoryUpsertCache[key] = cache
+		birthdayHistoryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single BirthdayHistory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BirthdayHistory) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no BirthdayHistory provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), birthdayHistoryPrimaryKeyMapping)
+	sql := "DELETE FROM \"birthday_history\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from birthday_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for birthday_history")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q birthdayHistoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no birthdayHistoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from birthday_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for birthday_history")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BirthdayHistorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(birthdayHistoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), birthdayHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"birthday_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, birthdayHistoryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from birthdayHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for birthday_history")
+	}
+
+	if len(birthdayHistoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BirthdayHistory) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBirthdayHistory(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BirthdayHistorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BirthdayHistorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), birthdayHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"birthday_history\".* FROM \"birthday_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, birthdayHistoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in BirthdayHistorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BirthdayHistoryExists checks if the BirthdayHistory row exists.
+func BirthdayHistoryExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"birthday_history\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if birthday_history exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the BirthdayHistory row exists.
+func (o *BirthdayHistory) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BirthdayHistoryExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1021 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testBirthdayHistories(t *testing.T) {
+	t.Parallel()
+
+	query := BirthdayHistories()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testBirthdayHistoriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayHistory{}
+	if err = randomize.Struct(seed, o, birthdayHistoryDBTypes, true, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BirthdayHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBirthdayHistoriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayHistory{}
+	if err = randomize.Struct(seed, o, birthdayHistoryDBTypes, true, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := BirthdayHistories().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BirthdayHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBirthdayHistoriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayHistory{}
+	if err = randomize.Struct(seed, o, birthdayHistoryDBTypes, true, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BirthdayHistorySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BirthdayHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBirthdayHistoriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayHistory{}
+	if err = randomize.Struct(seed, o, birthdayHistoryDBTypes, true, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := BirthdayHistoryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if BirthdayHistory exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected BirthdayHistoryExists to return true, but got false.")
+	}
+}
+
+func testBirthdayHistoriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayHistory{}
+	if err = randomize.Struct(seed, o, birthdayHistoryDBTypes, true, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	birthdayHistoryFound, err := FindBirthdayHistory(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if birthdayHistoryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testBirthdayHistoriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayHistory{}
+	if err = randomize.Struct(seed, o, birthdayHistoryDBTypes, true, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = BirthdayHistories().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBirthdayHistoriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayHistory{}
+	if err = randomize.Struct(seed, o, birthdayHistoryDBTypes, true, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := BirthdayHistories().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testBirthdayHistoriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	birthdayHistoryOne := &BirthdayHistory{}
+	birthdayHistoryTwo := &BirthdayHistory{}
+	if err = randomize.Struct(seed, birthdayHistoryOne, birthdayHistoryDBTypes, false, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, birthdayHistoryTwo, birthdayHistoryDBTypes, false, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = birthdayHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = birthdayHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := BirthdayHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testBirthdayHistoriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	birthdayHistoryOne := &BirthdayHistory{}
+	birthdayHistoryTwo := &BirthdayHistory{}
+	if err = randomize.Struct(seed, birthdayHistoryOne, birthdayHistoryDBTypes, false, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, birthdayHistoryTwo, birthdayHistoryDBTypes, false, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = birthdayHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = birthdayHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BirthdayHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func birthdayHistoryBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayHistory) error {
+	*o = BirthdayHistory{}
+	return nil
+}
+
+func birthdayHistoryAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayHistory) error {
+	*o = BirthdayHistory{}
+	return nil
+}
+
+func birthdayHistoryAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayHistory) error {
+	*o = BirthdayHistory{}
+	return nil
+}
+
+func birthdayHistoryBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayHistory) error {
+	*o = BirthdayHistory{}
+	return nil
+}
+
+func birthdayHistoryAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayHistory) error {
+	*o = BirthdayHistory{}
+	return nil
+}
+
+func birthdayHistoryBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayHistory) error {
+	*o = BirthdayHistory{}
+	return nil
+}
+
+func birthdayHistoryAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayHistory) error {
+	*o = BirthdayHistory{}
+	return nil
+}
+
+func birthdayHistoryBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayHistory) error {
+	*o = BirthdayHistory{}
+	return nil
+}
+
+func birthdayHistoryAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayHistory) error {
+	*o = BirthdayHistory{}
+	return nil
+}
+
+func testBirthdayHistoriesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &BirthdayHistory{}
+	o := &BirthdayHistory{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, birthdayHistoryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory object: %s", err)
+	}
+
+	AddBirthdayHistoryHook(boil.BeforeInsertHook, birthdayHistoryBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	birthdayHistoryBeforeInsertHooks = []BirthdayHistoryHook{}
+
+	AddBirthdayHistoryHook(boil.AfterInsertHook, birthdayHistoryAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	birthdayHistoryAfterInsertHooks = []BirthdayHistoryHook{}
+
+	AddBirthdayHistoryHook(boil.AfterSelectHook, birthdayHistoryAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	birthdayHistoryAfterSelectHooks = []BirthdayHistoryHook{}
+
+	AddBirthdayHistoryHook(boil.BeforeUpdateHook, birthdayHistoryBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	birthdayHistoryBeforeUpdateHooks = []BirthdayHistoryHook{}
+
+	AddBirthdayHistoryHook(boil.AfterUpdateHook, birthdayHistoryAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	birthdayHistoryAfterUpdateHooks = []BirthdayHistoryHook{}
+
+	AddBirthdayHistoryHook(boil.BeforeDeleteHook, birthdayHistoryBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	birthdayHistoryBeforeDeleteHooks = []BirthdayHistoryHook{}
+
+	AddBirthdayHistoryHook(boil.AfterDeleteHook, birthdayHistoryAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	birthdayHistoryAfterDeleteHooks = []BirthdayHistoryHook{}
+
+	AddBirthdayHistoryHook(boil.BeforeUpsertHook, birthdayHistoryBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	birthdayHistoryBeforeUpsertHooks = []BirthdayHistoryHook{}
+
+	AddBirthdayHistoryHook(boil.AfterUpsertHook, birthdayHistoryAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	birthdayHistoryAfterUpsertHooks = []BirthdayHistoryHook{}
+}
+
+func testBirthdayHistoriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayHistory{}
+	if err = randomize.Struct(seed, o, birthdayHistoryDBTypes, true, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BirthdayHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBirthdayHistoriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayHistory{}
+	if err = randomize.Struct(seed, o, birthdayHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(birthdayHistoryColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BirthdayHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBirthdayHistoryToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local BirthdayHistory
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, birthdayHistoryDBTypes, true, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.UserID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := BirthdayHistorySlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*BirthdayHistory)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testBirthdayHistoryToOneBirthdayUsingBirthday(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local BirthdayHistory
+	var foreign Birthday
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, birthdayHistoryDBTypes, false, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, birthdayDBTypes, true, birthdayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Birthday struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.BirthdayID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Birthday().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddBirthdayHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Birthday) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := BirthdayHistorySlice{&local}
+	if err = local.L.LoadBirthday(ctx, tx, false, (*[]*BirthdayHistory)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Birthday == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Birthday = nil
+	if err = local.L.LoadBirthday(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Birthday == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testBirthdayHistoryToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a BirthdayHistory
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayHistoryDBTypes, false, strmangle.SetComplement(birthdayHistoryPrimaryKeyColumns, birthdayHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.BirthdayHistories[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testBirthdayHistoryToOneRemoveOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a BirthdayHistory
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayHistoryDBTypes, false, strmangle.SetComplement(birthdayHistoryPrimaryKeyColumns, birthdayHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.User().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.User != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.UserID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.BirthdayHistories) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testBirthdayHistoryToOneSetOpBirthdayUsingBirthday(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a BirthdayHistory
+	var b, c Birthday
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayHistoryDBTypes, false, strmangle.SetComplement(birthdayHistoryPrimaryKeyColumns, birthdayHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, birthdayDBTypes, false, strmangle.SetComplement(birthdayPrimaryKeyColumns, birthdayColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, birthdayDBTypes, false, strmangle.SetComplement(birthdayPrimaryKeyColumns, birthdayColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Birthday{&b, &c} {
+		err = a.SetBirthday(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Birthday != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.BirthdayHistories[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.BirthdayID, x.ID) {
+			t.Error("foreign key was wrong value", a.BirthdayID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.BirthdayID))
+		reflect.Indirect(reflect.ValueOf(&a.BirthdayID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.BirthdayID, x.ID) {
+			t.Error("foreign key was wrong value", a.BirthdayID, x.ID)
+		}
+	}
+}
+
+func testBirthdayHistoriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayHistory{}
+	if err = randomize.Struct(seed, o, birthdayHistoryDBTypes, true, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBirthdayHistoriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayHistory{}
+	if err = randomize.Struct(seed, o, birthdayHistoryDBTypes, true, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BirthdayHistorySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBirthdayHistoriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayHistory{}
+	if err = randomize.Struct(seed, o, birthdayHistoryDBTypes, true, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := BirthdayHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	birthdayHistoryDBTypes = map[string]string{`ID`: `INTEGER`, `BirthdayID`: `INTEGER`, `UserID`: `INTEGER`, `Action`: `TEXT`, `OldValues`: `TEXT`, `NewValues`: `TEXT`, `CreatedAt`: `DATETIME`}
+	_                      = bytes.MinRead
+)
+
+func testBirthdayHistoriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(birthdayHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(birthdayHistoryAllColumns) == len(birthdayHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayHistory{}
+	if err = randomize.Struct(seed, o, birthdayHistoryDBTypes, true, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BirthdayHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, birthdayHistoryDBTypes, true, birthdayHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testBirthdayHistoriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(birthdayHistoryAllColumns) == len(birthdayHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayHistory{}
+	if err = randomize.Struct(seed, o, birthdayHistoryDBTypes, true, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BirthdayHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, birthdayHistoryDBTypes, true, birthdayHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(birthdayHistoryAllColumns, birthdayHistoryPrimaryKeyColumns) {
+		fields = birthdayHistoryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			birthdayHistoryAllColumns,
+			birthdayHistoryPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, birthdayHistoryGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := BirthdayHistorySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testBirthdayHistoriesUpsert(t *testing.T) {
+	t.Parallel()
+	if len(birthdayHistoryAllColumns) == len(birthdayHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := BirthdayHistory{}
+	if err = randomize.Struct(seed, &o, birthdayHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert BirthdayHistory: %s", err)
+	}
+
+	count, err := BirthdayHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, birthdayHistoryDBTypes, false, birthdayHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BirthdayHistory struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert BirthdayHistory: %s", err)
+	}
+
+	count, err = BirthdayHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
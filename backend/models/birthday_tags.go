@@ -0,0 +1,1117 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// BirthdayTag is an object representing the database table.
+type BirthdayTag struct {
+	BirthdayID int64  `boil:"birthday_id" json:"birthday_id" toml:"birthday_id" yaml:"birthday_id"`
+	Tag        string `boil:"tag" json:"tag" toml:"tag" yaml:"tag"`
+
+	R *birthdayTagR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L birthdayTagL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BirthdayTagColumns = struct {
+	BirthdayID string
+	Tag        string
+}{
+	BirthdayID: "birthday_id",
+	Tag:        "tag",
+}
+
+var BirthdayTagTableColumns = struct {
+	BirthdayID string
+	Tag        string
+}{
+	BirthdayID: "birthday_tags.birthday_id",
+	Tag:        "birthday_tags.tag",
+}
+
+// Generated where
+
+var BirthdayTagWhere = struct {
+	BirthdayID whereHelperint64
+	Tag        whereHelperstring
+}{
+	BirthdayID: whereHelperint64{field: "\"birthday_tags\".\"birthday_id\""},
+	Tag:        whereHelperstring{field: "\"birthday_tags\".\"tag\""},
+}
+
+// BirthdayTagRels is where relationship names are stored.
+var BirthdayTagRels = struct {
+	Birthday string
+}{
+	Birthday: "Birthday",
+}
+
+// birthdayTagR is where relationships are stored.
+type birthdayTagR struct {
+	Birthday *Birthday `boil:"Birthday" json:"Birthday" toml:"Birthday" yaml:"Birthday"`
+}
+
+// NewStruct creates a new relationship struct
+func (*birthdayTagR) NewStruct() *birthdayTagR {
+	return &birthdayTagR{}
+}
+
+func (r *birthdayTagR) GetBirthday() *Birthday {
+	if r == nil {
+		return nil
+	}
+	return r.Birthday
+}
+
+// birthdayTagL is where Load methods for each relationship are stored.
+type birthdayTagL struct{}
+
+var (
+	birthdayTagAllColumns            = []string{"birthday_id", "tag"}
+	birthdayTagColumnsWithoutDefault = []string{"birthday_id", "tag"}
+	birthdayTagColumnsWithDefault    = []string{}
+	birthdayTagPrimaryKeyColumns     = []string{"birthday_id", "tag"}
+	birthdayTagGeneratedColumns      = []string{}
+)
+
+type (
+	// BirthdayTagSlice is an alias for a slice of pointers to BirthdayTag.
+	// This should almost always be used instead of []BirthdayTag.
+	BirthdayTagSlice []*BirthdayTag
+	// BirthdayTagHook is the signature for custom BirthdayTag hook methods
+	BirthdayTagHook func(context.Context, boil.ContextExecutor, *BirthdayTag) error
+
+	birthdayTagQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	birthdayTagType                 = reflect.TypeOf(&BirthdayTag{})
+	birthdayTagMapping              = queries.MakeStructMapping(birthdayTagType)
+	birthdayTagPrimaryKeyMapping, _ = queries.BindMapping(birthdayTagType, birthdayTagMapping, birthdayTagPrimaryKeyColumns)
+	birthdayTagInsertCacheMut       sync.RWMutex
+	birthdayTagInsertCache          = make(map[string]insertCache)
+	birthdayTagUpdateCacheMut       sync.RWMutex
+	birthdayTagUpdateCache          = make(map[string]updateCache)
+	birthdayTagUpsertCacheMut       sync.RWMutex
+	birthdayTagUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var birthdayTagAfterSelectMu sync.Mutex
+var birthdayTagAfterSelectHooks []BirthdayTagHook
+
+var birthdayTagBeforeInsertMu sync.Mutex
+var birthdayTagBeforeInsertHooks []BirthdayTagHook
+var birthdayTagAfterInsertMu sync.Mutex
+var birthdayTagAfterInsertHooks []BirthdayTagHook
+
+var birthdayTagBeforeUpdateMu sync.Mutex
+var birthdayTagBeforeUpdateHooks []BirthdayTagHook
+var birthdayTagAfterUpdateMu sync.Mutex
+var birthdayTagAfterUpdateHooks []BirthdayTagHook
+
+var birthdayTagBeforeDeleteMu sync.Mutex
+var birthdayTagBeforeDeleteHooks []BirthdayTagHook
+var birthdayTagAfterDeleteMu sync.Mutex
+var birthdayTagAfterDeleteHooks []BirthdayTagHook
+
+var birthdayTagBeforeUpsertMu sync.Mutex
+var birthdayTagBeforeUpsertHooks []BirthdayTagHook
+var birthdayTagAfterUpsertMu sync.Mutex
+var birthdayTagAfterUpsertHooks []BirthdayTagHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BirthdayTag) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayTagAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BirthdayTag) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayTagBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BirthdayTag) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayTagAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BirthdayTag) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayTagBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BirthdayTag) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayTagAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BirthdayTag) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayTagBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BirthdayTag) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayTagAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BirthdayTag) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayTagBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BirthdayTag) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range birthdayTagAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBirthdayTagHook registers your hook function for all future operations.
+func AddBirthdayTagHook(hookPoint boil.HookPoint, birthdayTagHook BirthdayTagHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		birthdayTagAfterSelectMu.Lock()
+		birthdayTagAfterSelectHooks = append(birthdayTagAfterSelectHooks, birthdayTagHook)
+		birthdayTagAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		birthdayTagBeforeInsertMu.Lock()
+		birthdayTagBeforeInsertHooks = append(birthdayTagBeforeInsertHooks, birthdayTagHook)
+		birthdayTagBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		birthdayTagAfterInsertMu.Lock()
+		birthdayTagAfterInsertHooks = append(birthdayTagAfterInsertHooks, birthdayTagHook)
+		birthdayTagAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		birthdayTagBeforeUpdateMu.Lock()
+		birthdayTagBeforeUpdateHooks = append(birthdayTagBeforeUpdateHooks, birthdayTagHook)
+		birthdayTagBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		birthdayTagAfterUpdateMu.Lock()
+		birthdayTagAfterUpdateHooks = append(birthdayTagAfterUpdateHooks, birthdayTagHook)
+		birthdayTagAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		birthdayTagBeforeDeleteMu.Lock()
+		birthdayTagBeforeDeleteHooks = append(birthdayTagBeforeDeleteHooks, birthdayTagHook)
+		birthdayTagBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		birthdayTagAfterDeleteMu.Lock()
+		birthdayTagAfterDeleteHooks = append(birthdayTagAfterDeleteHooks, birthdayTagHook)
+		birthdayTagAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		birthdayTagBeforeUpsertMu.Lock()
+		birthdayTagBeforeUpsertHooks = append(birthdayTagBeforeUpsertHooks, birthdayTagHook)
+		birthdayTagBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		birthdayTagAfterUpsertMu.Lock()
+		birthdayTagAfterUpsertHooks = append(birthdayTagAfterUpsertHooks, birthdayTagHook)
+		birthdayTagAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single birthdayTag record from the query.
+func (q birthdayTagQuery) One(ctx context.Context, exec boil.ContextExecutor) (*BirthdayTag, error) {
+	o := &BirthdayTag{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for birthday_tags")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all BirthdayTag records from the query.
+func (q birthdayTagQuery) All(ctx context.Context, exec boil.ContextExecutor) (BirthdayTagSlice, error) {
+	var o []*BirthdayTag
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to BirthdayTag slice")
+	}
+
+	if len(birthdayTagAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all BirthdayTag records in the query.
+func (q birthdayTagQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count birthday_tags rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q birthdayTagQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if birthday_tags exists")
+	}
+
+	return count > 0, nil
+}
+
+// Birthday pointed to by the foreign key.
+func (o *BirthdayTag) Birthday(mods ...qm.QueryMod) birthdayQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.BirthdayID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Birthdays(queryMods...)
+}
+
+// LoadBirthday allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (birthdayTagL) LoadBirthday(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBirthdayTag interface{}, mods queries.Applicator) error {
+	var slice []*BirthdayTag
+	var object *BirthdayTag
+
+	if singular {
+		var ok bool
+		object, ok = maybeBirthdayTag.(*BirthdayTag)
+		if !ok {
+			object = new(BirthdayTag)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBirthdayTag)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBirthdayTag))
+			}
+		}
+	} else {
+		s, ok := maybeBirthdayTag.(*[]*BirthdayTag)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBirthdayTag)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBirthdayTag))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &birthdayTagR{}
+		}
+		if !queries.IsNil(object.BirthdayID) {
+			args[object.BirthdayID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &birthdayTagR{}
+			}
+
+			if !queries.IsNil(obj.BirthdayID) {
+				args[obj.BirthdayID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`birthdays`),
+		qm.WhereIn(`birthdays.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`birthdays.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Birthday")
+	}
+
+	var resultSlice []*Birthday
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Birthday")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for birthdays")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for birthdays")
+	}
+
+	if len(birthdayAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Birthday = foreign
+		if foreign.R == nil {
+			foreign.R = &birthdayR{}
+		}
+		foreign.R.BirthdayTags = append(foreign.R.BirthdayTags, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.BirthdayID, foreign.ID) {
+				local.R.Birthday = foreign
+				if foreign.R == nil {
+					foreign.R = &birthdayR{}
+				}
+				foreign.R.BirthdayTags = append(foreign.R.BirthdayTags, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBirthday of the birthdayTag to the related item.
+// Sets o.R.Birthday to related.
+// Adds o to related.R.BirthdayTags.
+func (o *BirthdayTag) SetBirthday(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Birthday) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"birthday_tags\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"birthday_id"}),
+		strmangle.WhereClause("\"", "\"", 0, birthdayTagPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.BirthdayID, o.Tag}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.BirthdayID, related.ID)
+	if o.R == nil {
+		o.R = &birthdayTagR{
+			Birthday: related,
+		}
+	} else {
+		o.R.Birthday = related
+	}
+
+	if related.R == nil {
+		related.R = &birthdayR{
+			BirthdayTags: BirthdayTagSlice{o},
+		}
+	} else {
+		related.R.BirthdayTags = append(related.R.BirthdayTags, o)
+	}
+
+	return nil
+}
+
+// BirthdayTags retrieves all the records using an executor.
+func BirthdayTags(mods ...qm.QueryMod) birthdayTagQuery {
+	mods = append(mods, qm.From("\"birthday_tags\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"birthday_tags\".*"})
+	}
+
+	return birthdayTagQuery{q}
+}
+
+// FindBirthdayTag retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBirthdayTag(ctx context.Context, exec boil.ContextExecutor, birthdayID int64, tag string, selectCols ...string) (*BirthdayTag, error) {
+	birthdayTagObj := &BirthdayTag{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"birthday_tags\" where \"birthday_id\"=? AND \"tag\"=?", sel,
+	)
+
+	q := queries.Raw(query, birthdayID, tag)
+
+	err := q.Bind(ctx, exec, birthdayTagObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from birthday_tags")
+	}
+
+	if err = birthdayTagObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return birthdayTagObj, err
+	}
+
+	return birthdayTagObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BirthdayTag) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no birthday_tags provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(birthdayTagColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	birthdayTagInsertCacheMut.RLock()
+	cache, cached := birthdayTagInsertCache[key]
+	birthdayTagInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			birthdayTagAllColumns,
+			birthdayTagColumnsWithDefault,
+			birthdayTagColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(birthdayTagType, birthdayTagMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(birthdayTagType, birthdayTagMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"birthday_tags\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"birthday_tags\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into birthday_tags")
+	}
+
+	if !cached {
+		birthdayTagInsertCacheMut.Lock()
+		birthdayTagInsertCache[key] = cache
+		birthdayTagInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the BirthdayTag.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BirthdayTag) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	birthdayTagUpdateCacheMut.RLock()
+	cache, cached := birthdayTagUpdateCache[key]
+	birthdayTagUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			birthdayTagAllColumns,
+			birthdayTagPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update birthday_tags, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"birthday_tags\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, birthdayTagPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(birthdayTagType, birthdayTagMapping, append(wl, birthdayTagPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update birthday_tags row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for birthday_tags")
+	}
+
+	if !cached {
+		birthdayTagUpdateCacheMut.Lock()
+		birthdayTagUpdateCache[key] = cache
+		birthdayTagUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q birthdayTagQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for birthday_tags")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for birthday_tags")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BirthdayTagSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), birthdayTagPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"birthday_tags\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, birthdayTagPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in birthdayTag slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all birthdayTag")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *BirthdayTag) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no birthday_tags provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(birthdayTagColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	birthdayTagUpsertCacheMut.RLock()
+	cache, cached := birthdayTagUpsertCache[key]
+	birthdayTagUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			birthdayTagAllColumns,
+			birthdayTagColumnsWithDefault,
+			birthdayTagColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			birthdayTagAllColumns,
+			birthdayTagPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert birthday_tags, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(birthdayTagAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(birthdayTagPrimaryKeyColumns))
+			copy(conflict, birthdayTagPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"birthday_tags\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(birthdayTagType, birthdayTagMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(birthdayTagType, birthdayTagMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert birthday_tags")
+	}
+
+	if !cached {
+		birthdayTagUpsertCacheMut.Lock()
+		birthdayTagUpsertCache[key] = cache
+		birthdayTagUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single BirthdayTag record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BirthdayTag) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no BirthdayTag provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), birthdayTagPrimaryKeyMapping)
+	sql := "DELETE FROM \"birthday_tags\" WHERE \"birthday_id\"=? AND \"tag\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from birthday_tags")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for birthday_tags")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q birthdayTagQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no birthdayTagQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from birthday_tags")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for birthday_tags")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BirthdayTagSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(birthdayTagBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), birthdayTagPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"birthday_tags\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, birthdayTagPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from birthdayTag slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for birthday_tags")
+	}
+
+	if len(birthdayTagAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BirthdayTag) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBirthdayTag(ctx, exec, o.BirthdayID, o.Tag)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BirthdayTagSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BirthdayTagSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), birthdayTagPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"birthday_tags\".* FROM \"birthday_tags\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, birthdayTagPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in BirthdayTagSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BirthdayTagExists checks if the BirthdayTag row exists.
+func BirthdayTagExists(ctx context.Context, exec boil.ContextExecutor, birthdayID int64, tag string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"birthday_tags\" where \"birthday_id\"=? AND \"tag\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, birthdayID, tag)
+	}
+	row := exec.QueryRowContext(ctx, sql, birthdayID, tag)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if birthday_tags exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the BirthdayTag row exists.
+func (o *BirthdayTag) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BirthdayTagExists(ctx, exec, o.BirthdayID, o.Tag)
+}
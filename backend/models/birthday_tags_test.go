@@ -0,0 +1,846 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testBirthdayTags(t *testing.T) {
+	t.Parallel()
+
+	query := BirthdayTags()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testBirthdayTagsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayTag{}
+	if err = randomize.Struct(seed, o, birthdayTagDBTypes, true, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BirthdayTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBirthdayTagsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayTag{}
+	if err = randomize.Struct(seed, o, birthdayTagDBTypes, true, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := BirthdayTags().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BirthdayTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBirthdayTagsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayTag{}
+	if err = randomize.Struct(seed, o, birthdayTagDBTypes, true, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BirthdayTagSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BirthdayTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBirthdayTagsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayTag{}
+	if err = randomize.Struct(seed, o, birthdayTagDBTypes, true, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := BirthdayTagExists(ctx, tx, o.BirthdayID, o.Tag)
+	if err != nil {
+		t.Errorf("Unable to check if BirthdayTag exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected BirthdayTagExists to return true, but got false.")
+	}
+}
+
+func testBirthdayTagsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayTag{}
+	if err = randomize.Struct(seed, o, birthdayTagDBTypes, true, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	birthdayTagFound, err := FindBirthdayTag(ctx, tx, o.BirthdayID, o.Tag)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if birthdayTagFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testBirthdayTagsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayTag{}
+	if err = randomize.Struct(seed, o, birthdayTagDBTypes, true, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = BirthdayTags().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBirthdayTagsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayTag{}
+	if err = randomize.Struct(seed, o, birthdayTagDBTypes, true, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := BirthdayTags().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testBirthdayTagsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	birthdayTagOne := &BirthdayTag{}
+	birthdayTagTwo := &BirthdayTag{}
+	if err = randomize.Struct(seed, birthdayTagOne, birthdayTagDBTypes, false, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+	if err = randomize.Struct(seed, birthdayTagTwo, birthdayTagDBTypes, false, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = birthdayTagOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = birthdayTagTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := BirthdayTags().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testBirthdayTagsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	birthdayTagOne := &BirthdayTag{}
+	birthdayTagTwo := &BirthdayTag{}
+	if err = randomize.Struct(seed, birthdayTagOne, birthdayTagDBTypes, false, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+	if err = randomize.Struct(seed, birthdayTagTwo, birthdayTagDBTypes, false, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = birthdayTagOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = birthdayTagTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BirthdayTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func birthdayTagBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayTag) error {
+	*o = BirthdayTag{}
+	return nil
+}
+
+func birthdayTagAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayTag) error {
+	*o = BirthdayTag{}
+	return nil
+}
+
+func birthdayTagAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayTag) error {
+	*o = BirthdayTag{}
+	return nil
+}
+
+func birthdayTagBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayTag) error {
+	*o = BirthdayTag{}
+	return nil
+}
+
+func birthdayTagAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayTag) error {
+	*o = BirthdayTag{}
+	return nil
+}
+
+func birthdayTagBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayTag) error {
+	*o = BirthdayTag{}
+	return nil
+}
+
+func birthdayTagAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayTag) error {
+	*o = BirthdayTag{}
+	return nil
+}
+
+func birthdayTagBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayTag) error {
+	*o = BirthdayTag{}
+	return nil
+}
+
+func birthdayTagAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *BirthdayTag) error {
+	*o = BirthdayTag{}
+	return nil
+}
+
+func testBirthdayTagsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &BirthdayTag{}
+	o := &BirthdayTag{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, birthdayTagDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag object: %s", err)
+	}
+
+	AddBirthdayTagHook(boil.BeforeInsertHook, birthdayTagBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	birthdayTagBeforeInsertHooks = []BirthdayTagHook{}
+
+	AddBirthdayTagHook(boil.AfterInsertHook, birthdayTagAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	birthdayTagAfterInsertHooks = []BirthdayTagHook{}
+
+	AddBirthdayTagHook(boil.AfterSelectHook, birthdayTagAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	birthdayTagAfterSelectHooks = []BirthdayTagHook{}
+
+	AddBirthdayTagHook(boil.BeforeUpdateHook, birthdayTagBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	birthdayTagBeforeUpdateHooks = []BirthdayTagHook{}
+
+	AddBirthdayTagHook(boil.AfterUpdateHook, birthdayTagAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	birthdayTagAfterUpdateHooks = []BirthdayTagHook{}
+
+	AddBirthdayTagHook(boil.BeforeDeleteHook, birthdayTagBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	birthdayTagBeforeDeleteHooks = []BirthdayTagHook{}
+
+	AddBirthdayTagHook(boil.AfterDeleteHook, birthdayTagAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	birthdayTagAfterDeleteHooks = []BirthdayTagHook{}
+
+	AddBirthdayTagHook(boil.BeforeUpsertHook, birthdayTagBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	birthdayTagBeforeUpsertHooks = []BirthdayTagHook{}
+
+	AddBirthdayTagHook(boil.AfterUpsertHook, birthdayTagAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	birthdayTagAfterUpsertHooks = []BirthdayTagHook{}
+}
+
+func testBirthdayTagsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayTag{}
+	if err = randomize.Struct(seed, o, birthdayTagDBTypes, true, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BirthdayTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBirthdayTagsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayTag{}
+	if err = randomize.Struct(seed, o, birthdayTagDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(birthdayTagColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BirthdayTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBirthdayTagToOneBirthdayUsingBirthday(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local BirthdayTag
+	var foreign Birthday
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, birthdayTagDBTypes, false, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, birthdayDBTypes, true, birthdayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Birthday struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.BirthdayID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Birthday().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddBirthdayHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Birthday) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := BirthdayTagSlice{&local}
+	if err = local.L.LoadBirthday(ctx, tx, false, (*[]*BirthdayTag)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Birthday == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Birthday = nil
+	if err = local.L.LoadBirthday(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Birthday == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testBirthdayTagToOneSetOpBirthdayUsingBirthday(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a BirthdayTag
+	var b, c Birthday
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayTagDBTypes, false, strmangle.SetComplement(birthdayTagPrimaryKeyColumns, birthdayTagColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, birthdayDBTypes, false, strmangle.SetComplement(birthdayPrimaryKeyColumns, birthdayColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, birthdayDBTypes, false, strmangle.SetComplement(birthdayPrimaryKeyColumns, birthdayColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Birthday{&b, &c} {
+		err = a.SetBirthday(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Birthday != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.BirthdayTags[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.BirthdayID, x.ID) {
+			t.Error("foreign key was wrong value", a.BirthdayID)
+		}
+
+		if exists, err := BirthdayTagExists(ctx, tx, a.BirthdayID, a.Tag); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testBirthdayTagsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayTag{}
+	if err = randomize.Struct(seed, o, birthdayTagDBTypes, true, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBirthdayTagsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayTag{}
+	if err = randomize.Struct(seed, o, birthdayTagDBTypes, true, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BirthdayTagSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBirthdayTagsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayTag{}
+	if err = randomize.Struct(seed, o, birthdayTagDBTypes, true, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := BirthdayTags().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	birthdayTagDBTypes = map[string]string{`BirthdayID`: `INTEGER`, `Tag`: `TEXT`}
+	_                  = bytes.MinRead
+)
+
+func testBirthdayTagsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(birthdayTagPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(birthdayTagAllColumns) == len(birthdayTagPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayTag{}
+	if err = randomize.Struct(seed, o, birthdayTagDBTypes, true, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BirthdayTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, birthdayTagDBTypes, true, birthdayTagPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testBirthdayTagsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(birthdayTagAllColumns) == len(birthdayTagPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BirthdayTag{}
+	if err = randomize.Struct(seed, o, birthdayTagDBTypes, true, birthdayTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BirthdayTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, birthdayTagDBTypes, true, birthdayTagPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(birthdayTagAllColumns, birthdayTagPrimaryKeyColumns) {
+		fields = birthdayTagAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			birthdayTagAllColumns,
+			birthdayTagPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := BirthdayTagSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testBirthdayTagsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(birthdayTagAllColumns) == len(birthdayTagPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := BirthdayTag{}
+	if err = randomize.Struct(seed, &o, birthdayTagDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert BirthdayTag: %s", err)
+	}
+
+	count, err := BirthdayTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, birthdayTagDBTypes, false, birthdayTagPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BirthdayTag struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert BirthdayTag: %s", err)
+	}
+
+	count, err = BirthdayTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -24,137 +24,106 @@ import (
 
 // Birthday is an object representing the database table.
 type Birthday struct {
-	ID        null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
-	UserID    int64      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	Name      string     `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Date      time.Time  `boil:"date" json:"date" toml:"date" yaml:"date"`
-	CreatedAt null.Time  `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
-	UpdatedAt null.Time  `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	ID               null.Int64  `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	UserID           int64       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Name             string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Date             time.Time   `boil:"date" json:"date" toml:"date" yaml:"date"`
+	CreatedAt        null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt        null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	YearKnown        bool        `boil:"year_known" json:"year_known" toml:"year_known" yaml:"year_known"`
+	EventType        string      `boil:"event_type" json:"event_type" toml:"event_type" yaml:"event_type"`
+	YearsLabel       null.String `boil:"years_label" json:"years_label,omitempty" toml:"years_label" yaml:"years_label,omitempty"`
+	Calendar         string      `boil:"calendar" json:"calendar" toml:"calendar" yaml:"calendar"`
+	Notes            null.String `boil:"notes" json:"notes,omitempty" toml:"notes" yaml:"notes,omitempty"`
+	TelegramUsername null.String `boil:"telegram_username" json:"telegram_username,omitempty" toml:"telegram_username" yaml:"telegram_username,omitempty"`
+	Phone            null.String `boil:"phone" json:"phone,omitempty" toml:"phone" yaml:"phone,omitempty"`
+	Email            null.String `boil:"email" json:"email,omitempty" toml:"email" yaml:"email,omitempty"`
+	DeletedAt        null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	Version          int64       `boil:"version" json:"version" toml:"version" yaml:"version"`
+	ListID           null.Int64  `boil:"list_id" json:"list_id,omitempty" toml:"list_id" yaml:"list_id,omitempty"`
 
 	R *birthdayR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L birthdayL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var BirthdayColumns = struct {
-	ID        string
-	UserID    string
-	Name      string
-	Date      string
-	CreatedAt string
-	UpdatedAt string
+	ID               string
+	UserID           string
+	Name             string
+	Date             string
+	CreatedAt        string
+	UpdatedAt        string
+	YearKnown        string
+	EventType        string
+	YearsLabel       string
+	Calendar         string
+	Notes            string
+	TelegramUsername string
+	Phone            string
+	Email            string
+	DeletedAt        string
+	Version          string
+	ListID           string
 }{
-	ID:        "id",
-	UserID:    "user_id",
-	Name:      "name",
-	Date:      "date",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
+	ID:               "id",
+	UserID:           "user_id",
+	Name:             "name",
+	Date:             "date",
+	CreatedAt:        "created_at",
+	UpdatedAt:        "updated_at",
+	YearKnown:        "year_known",
+	EventType:        "event_type",
+	YearsLabel:       "years_label",
+	Calendar:         "calendar",
+	Notes:            "notes",
+	TelegramUsername: "telegram_username",
+	Phone:            "phone",
+	Email:            "email",
+	DeletedAt:        "deleted_at",
+	Version:          "version",
+	ListID:           "list_id",
 }
 
 var BirthdayTableColumns = struct {
-	ID        string
-	UserID    string
-	Name      string
-	Date      string
-	CreatedAt string
-	UpdatedAt string
+	ID               string
+	UserID           string
+	Name             string
+	Date             string
+	CreatedAt        string
+	UpdatedAt        string
+	YearKnown        string
+	EventType        string
+	YearsLabel       string
+	Calendar         string
+	Notes            string
+	TelegramUsername string
+	Phone            string
+	Email            string
+	DeletedAt        string
+	Version          string
+	ListID           string
 }{
-	ID:        "birthdays.id",
-	UserID:    "birthdays.user_id",
-	Name:      "birthdays.name",
-	Date:      "birthdays.date",
-	CreatedAt: "birthdays.created_at",
-	UpdatedAt: "birthdays.updated_at",
+	ID:               "birthdays.id",
+	UserID:           "birthdays.user_id",
+	Name:             "birthdays.name",
+	Date:             "birthdays.date",
+	CreatedAt:        "birthdays.created_at",
+	UpdatedAt:        "birthdays.updated_at",
+	YearKnown:        "birthdays.year_known",
+	EventType:        "birthdays.event_type",
+	YearsLabel:       "birthdays.years_label",
+	Calendar:         "birthdays.calendar",
+	Notes:            "birthdays.notes",
+	TelegramUsername: "birthdays.telegram_username",
+	Phone:            "birthdays.phone",
+	Email:            "birthdays.email",
+	DeletedAt:        "birthdays.deleted_at",
+	Version:          "birthdays.version",
+	ListID:           "birthdays.list_id",
 }
 
 // Generated where
 
-type whereHelpernull_Int64 struct{ field string }
-
-func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Int64) NEQ(x null.Int64) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Int64) LT(x null.Int64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Int64) LTE(x null.Int64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Int64) GT(x null.Int64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_Int64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_Int64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
-type whereHelperint64 struct{ field string }
-
-func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod  { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelpertime_Time struct{ field string }
 
 func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
@@ -176,56 +145,75 @@ func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
-type whereHelpernull_Time struct{ field string }
+type whereHelperbool struct{ field string }
 
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
 var BirthdayWhere = struct {
-	ID        whereHelpernull_Int64
-	UserID    whereHelperint64
-	Name      whereHelperstring
-	Date      whereHelpertime_Time
-	CreatedAt whereHelpernull_Time
-	UpdatedAt whereHelpernull_Time
+	ID               whereHelpernull_Int64
+	UserID           whereHelperint64
+	Name             whereHelperstring
+	Date             whereHelpertime_Time
+	CreatedAt        whereHelpernull_Time
+	UpdatedAt        whereHelpernull_Time
+	YearKnown        whereHelperbool
+	EventType        whereHelperstring
+	YearsLabel       whereHelpernull_String
+	Calendar         whereHelperstring
+	Notes            whereHelpernull_String
+	TelegramUsername whereHelpernull_String
+	Phone            whereHelpernull_String
+	Email            whereHelpernull_String
+	DeletedAt        whereHelpernull_Time
+	Version          whereHelperint64
+	ListID           whereHelpernull_Int64
 }{
-	ID:        whereHelpernull_Int64{field: "\"birthdays\".\"id\""},
-	UserID:    whereHelperint64{field: "\"birthdays\".\"user_id\""},
-	Name:      whereHelperstring{field: "\"birthdays\".\"name\""},
-	Date:      whereHelpertime_Time{field: "\"birthdays\".\"date\""},
-	CreatedAt: whereHelpernull_Time{field: "\"birthdays\".\"created_at\""},
-	UpdatedAt: whereHelpernull_Time{field: "\"birthdays\".\"updated_at\""},
+	ID:               whereHelpernull_Int64{field: "\"birthdays\".\"id\""},
+	UserID:           whereHelperint64{field: "\"birthdays\".\"user_id\""},
+	Name:             whereHelperstring{field: "\"birthdays\".\"name\""},
+	Date:             whereHelpertime_Time{field: "\"birthdays\".\"date\""},
+	CreatedAt:        whereHelpernull_Time{field: "\"birthdays\".\"created_at\""},
+	UpdatedAt:        whereHelpernull_Time{field: "\"birthdays\".\"updated_at\""},
+	YearKnown:        whereHelperbool{field: "\"birthdays\".\"year_known\""},
+	EventType:        whereHelperstring{field: "\"birthdays\".\"event_type\""},
+	YearsLabel:       whereHelpernull_String{field: "\"birthdays\".\"years_label\""},
+	Calendar:         whereHelperstring{field: "\"birthdays\".\"calendar\""},
+	Notes:            whereHelpernull_String{field: "\"birthdays\".\"notes\""},
+	TelegramUsername: whereHelpernull_String{field: "\"birthdays\".\"telegram_username\""},
+	Phone:            whereHelpernull_String{field: "\"birthdays\".\"phone\""},
+	Email:            whereHelpernull_String{field: "\"birthdays\".\"email\""},
+	DeletedAt:        whereHelpernull_Time{field: "\"birthdays\".\"deleted_at\""},
+	Version:          whereHelperint64{field: "\"birthdays\".\"version\""},
+	ListID:           whereHelpernull_Int64{field: "\"birthdays\".\"list_id\""},
 }
 
 // BirthdayRels is where relationship names are stored.
 var BirthdayRels = struct {
-	User string
+	User                string
+	GroupBirthdayGroups string
+	BirthdayHistories   string
+	BirthdayTags        string
+	Gifts               string
 }{
-	User: "User",
+	User:                "User",
+	GroupBirthdayGroups: "GroupBirthdayGroups",
+	BirthdayHistories:   "BirthdayHistories",
+	BirthdayTags:        "BirthdayTags",
+	Gifts:               "Gifts",
 }
 
 // birthdayR is where relationships are stored.
 type birthdayR struct {
-	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+	User                *User                `boil:"User" json:"User" toml:"User" yaml:"User"`
+	GroupBirthdayGroups BirthdayGroupSlice   `boil:"GroupBirthdayGroups" json:"GroupBirthdayGroups" toml:"GroupBirthdayGroups" yaml:"GroupBirthdayGroups"`
+	BirthdayHistories   BirthdayHistorySlice `boil:"BirthdayHistories" json:"BirthdayHistories" toml:"BirthdayHistories" yaml:"BirthdayHistories"`
+	BirthdayTags        BirthdayTagSlice     `boil:"BirthdayTags" json:"BirthdayTags" toml:"BirthdayTags" yaml:"BirthdayTags"`
+	Gifts               GiftSlice            `boil:"Gifts" json:"Gifts" toml:"Gifts" yaml:"Gifts"`
 }
 
 // NewStruct creates a new relationship struct
@@ -240,13 +228,41 @@ func (r *birthdayR) GetUser() *User {
 	return r.User
 }
 
+func (r *birthdayR) GetGroupBirthdayGroups() BirthdayGroupSlice {
+	if r == nil {
+		return nil
+	}
+	return r.GroupBirthdayGroups
+}
+
+func (r *birthdayR) GetBirthdayHistories() BirthdayHistorySlice {
+	if r == nil {
+		return nil
+	}
+	return r.BirthdayHistories
+}
+
+func (r *birthdayR) GetBirthdayTags() BirthdayTagSlice {
+	if r == nil {
+		return nil
+	}
+	return r.BirthdayTags
+}
+
+func (r *birthdayR) GetGifts() GiftSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Gifts
+}
+
 // birthdayL is where Load methods for each relationship are stored.
 type birthdayL struct{}
 
 var (
-	birthdayAllColumns            = []string{"id", "user_id", "name", "date", "created_at", "updated_at"}
+	birthdayAllColumns            = []string{"id", "user_id", "name", "date", "created_at", "updated_at", "year_known", "event_type", "years_label", "calendar", "notes", "telegram_username", "phone", "email", "deleted_at", "version", "list_id"}
 	birthdayColumnsWithoutDefault = []string{"user_id", "name", "date"}
-	birthdayColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	birthdayColumnsWithDefault    = []string{"id", "created_at", "updated_at", "year_known", "event_type", "years_label", "calendar", "notes", "telegram_username", "phone", "email", "deleted_at", "version", "list_id"}
 	birthdayPrimaryKeyColumns     = []string{"id"}
 	birthdayGeneratedColumns      = []string{"id"}
 )
@@ -537,39 +553,576 @@ func (q birthdayQuery) Count(ctx context.Context, exec boil.ContextExecutor) (in
 		return 0, errors.Wrap(err, "models: failed to count birthdays rows")
 	}
 
-	return count, nil
-}
-
-// Exists checks if the row exists in the table.
-func (q birthdayQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
-	var count int64
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q birthdayQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if birthdays exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *Birthday) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// GroupBirthdayGroups retrieves all the birthday_group's BirthdayGroups with an executor via id column.
+func (o *Birthday) GroupBirthdayGroups(mods ...qm.QueryMod) birthdayGroupQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"birthday_group_members\" on \"birthday_groups\".\"id\" = \"birthday_group_members\".\"group_id\""),
+		qm.Where("\"birthday_group_members\".\"birthday_id\"=?", o.ID),
+	)
+
+	return BirthdayGroups(queryMods...)
+}
+
+// BirthdayHistories retrieves all the birthday_history's BirthdayHistories with an executor.
+func (o *Birthday) BirthdayHistories(mods ...qm.QueryMod) birthdayHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"birthday_history\".\"birthday_id\"=?", o.ID),
+	)
+
+	return BirthdayHistories(queryMods...)
+}
+
+// BirthdayTags retrieves all the birthday_tag's BirthdayTags with an executor.
+func (o *Birthday) BirthdayTags(mods ...qm.QueryMod) birthdayTagQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"birthday_tags\".\"birthday_id\"=?", o.ID),
+	)
+
+	return BirthdayTags(queryMods...)
+}
+
+// Gifts retrieves all the gift's Gifts with an executor.
+func (o *Birthday) Gifts(mods ...qm.QueryMod) giftQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"gifts\".\"birthday_id\"=?", o.ID),
+	)
+
+	return Gifts(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (birthdayL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBirthday interface{}, mods queries.Applicator) error {
+	var slice []*Birthday
+	var object *Birthday
+
+	if singular {
+		var ok bool
+		object, ok = maybeBirthday.(*Birthday)
+		if !ok {
+			object = new(Birthday)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBirthday)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBirthday))
+			}
+		}
+	} else {
+		s, ok := maybeBirthday.(*[]*Birthday)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBirthday)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBirthday))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &birthdayR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args[object.UserID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &birthdayR{}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args[obj.UserID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.Birthdays = append(foreign.R.Birthdays, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.Birthdays = append(foreign.R.Birthdays, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadGroupBirthdayGroups allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (birthdayL) LoadGroupBirthdayGroups(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBirthday interface{}, mods queries.Applicator) error {
+	var slice []*Birthday
+	var object *Birthday
+
+	if singular {
+		var ok bool
+		object, ok = maybeBirthday.(*Birthday)
+		if !ok {
+			object = new(Birthday)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBirthday)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBirthday))
+			}
+		}
+	} else {
+		s, ok := maybeBirthday.(*[]*Birthday)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBirthday)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBirthday))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &birthdayR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &birthdayR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"birthday_groups\".\"id\", \"birthday_groups\".\"user_id\", \"birthday_groups\".\"name\", \"birthday_groups\".\"lead_days\", \"birthday_groups\".\"destination\", \"birthday_groups\".\"template\", \"birthday_groups\".\"created_at\", \"birthday_groups\".\"updated_at\", \"a\".\"birthday_id\""),
+		qm.From("\"birthday_groups\""),
+		qm.InnerJoin("\"birthday_group_members\" as \"a\" on \"birthday_groups\".\"id\" = \"a\".\"group_id\""),
+		qm.WhereIn("\"a\".\"birthday_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load birthday_groups")
+	}
+
+	var resultSlice []*BirthdayGroup
+
+	var localJoinCols []int64
+	for results.Next() {
+		one := new(BirthdayGroup)
+		var localJoinCol int64
+
+		err = results.Scan(&one.ID, &one.UserID, &one.Name, &one.LeadDays, &one.Destination, &one.Template, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for birthday_groups")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice birthday_groups")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on birthday_groups")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for birthday_groups")
+	}
+
+	if len(birthdayGroupAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.GroupBirthdayGroups = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &birthdayGroupR{}
+			}
+			foreign.R.Birthdays = append(foreign.R.Birthdays, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if queries.Equal(local.ID, localJoinCol) {
+				local.R.GroupBirthdayGroups = append(local.R.GroupBirthdayGroups, foreign)
+				if foreign.R == nil {
+					foreign.R = &birthdayGroupR{}
+				}
+				foreign.R.Birthdays = append(foreign.R.Birthdays, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBirthdayHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (birthdayL) LoadBirthdayHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBirthday interface{}, mods queries.Applicator) error {
+	var slice []*Birthday
+	var object *Birthday
+
+	if singular {
+		var ok bool
+		object, ok = maybeBirthday.(*Birthday)
+		if !ok {
+			object = new(Birthday)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBirthday)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBirthday))
+			}
+		}
+	} else {
+		s, ok := maybeBirthday.(*[]*Birthday)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBirthday)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBirthday))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &birthdayR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &birthdayR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`birthday_history`),
+		qm.WhereIn(`birthday_history.birthday_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load birthday_history")
+	}
+
+	var resultSlice []*BirthdayHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice birthday_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on birthday_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for birthday_history")
+	}
+
+	if len(birthdayHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BirthdayHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &birthdayHistoryR{}
+			}
+			foreign.R.Birthday = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.BirthdayID) {
+				local.R.BirthdayHistories = append(local.R.BirthdayHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &birthdayHistoryR{}
+				}
+				foreign.R.Birthday = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBirthdayTags allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (birthdayL) LoadBirthdayTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBirthday interface{}, mods queries.Applicator) error {
+	var slice []*Birthday
+	var object *Birthday
+
+	if singular {
+		var ok bool
+		object, ok = maybeBirthday.(*Birthday)
+		if !ok {
+			object = new(Birthday)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBirthday)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBirthday))
+			}
+		}
+	} else {
+		s, ok := maybeBirthday.(*[]*Birthday)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBirthday)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBirthday))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &birthdayR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &birthdayR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
 
-	queries.SetSelect(q.Query, nil)
-	queries.SetCount(q.Query)
-	queries.SetLimit(q.Query, 1)
+	query := NewQuery(
+		qm.From(`birthday_tags`),
+		qm.WhereIn(`birthday_tags.birthday_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return false, errors.Wrap(err, "models: failed to check if birthdays exists")
+		return errors.Wrap(err, "failed to eager load birthday_tags")
 	}
 
-	return count > 0, nil
-}
+	var resultSlice []*BirthdayTag
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice birthday_tags")
+	}
 
-// User pointed to by the foreign key.
-func (o *Birthday) User(mods ...qm.QueryMod) userQuery {
-	queryMods := []qm.QueryMod{
-		qm.Where("\"id\" = ?", o.UserID),
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on birthday_tags")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for birthday_tags")
 	}
 
-	queryMods = append(queryMods, mods...)
+	if len(birthdayTagAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BirthdayTags = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &birthdayTagR{}
+			}
+			foreign.R.Birthday = object
+		}
+		return nil
+	}
 
-	return Users(queryMods...)
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.BirthdayID) {
+				local.R.BirthdayTags = append(local.R.BirthdayTags, foreign)
+				if foreign.R == nil {
+					foreign.R = &birthdayTagR{}
+				}
+				foreign.R.Birthday = local
+				break
+			}
+		}
+	}
+
+	return nil
 }
 
-// LoadUser allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for an N-1 relationship.
-func (birthdayL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBirthday interface{}, mods queries.Applicator) error {
+// LoadGifts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (birthdayL) LoadGifts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBirthday interface{}, mods queries.Applicator) error {
 	var slice []*Birthday
 	var object *Birthday
 
@@ -600,20 +1153,13 @@ func (birthdayL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular
 		if object.R == nil {
 			object.R = &birthdayR{}
 		}
-		if !queries.IsNil(object.UserID) {
-			args[object.UserID] = struct{}{}
-		}
-
+		args[object.ID] = struct{}{}
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &birthdayR{}
 			}
-
-			if !queries.IsNil(obj.UserID) {
-				args[obj.UserID] = struct{}{}
-			}
-
+			args[obj.ID] = struct{}{}
 		}
 	}
 
@@ -629,8 +1175,8 @@ func (birthdayL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`users`),
-		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qm.From(`gifts`),
+		qm.WhereIn(`gifts.birthday_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -638,51 +1184,47 @@ func (birthdayL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load User")
+		return errors.Wrap(err, "failed to eager load gifts")
 	}
 
-	var resultSlice []*User
+	var resultSlice []*Gift
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice User")
+		return errors.Wrap(err, "failed to bind eager loaded slice gifts")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for users")
+		return errors.Wrap(err, "failed to close results in eager load on gifts")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for gifts")
 	}
 
-	if len(userAfterSelectHooks) != 0 {
+	if len(giftAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
-
-	if len(resultSlice) == 0 {
-		return nil
-	}
-
 	if singular {
-		foreign := resultSlice[0]
-		object.R.User = foreign
-		if foreign.R == nil {
-			foreign.R = &userR{}
+		object.R.Gifts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &giftR{}
+			}
+			foreign.R.Birthday = object
 		}
-		foreign.R.Birthdays = append(foreign.R.Birthdays, object)
 		return nil
 	}
 
-	for _, local := range slice {
-		for _, foreign := range resultSlice {
-			if queries.Equal(local.UserID, foreign.ID) {
-				local.R.User = foreign
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.BirthdayID) {
+				local.R.Gifts = append(local.R.Gifts, foreign)
 				if foreign.R == nil {
-					foreign.R = &userR{}
+					foreign.R = &giftR{}
 				}
-				foreign.R.Birthdays = append(foreign.R.Birthdays, local)
+				foreign.R.Birthday = local
 				break
 			}
 		}
@@ -738,9 +1280,313 @@ func (o *Birthday) SetUser(ctx context.Context, exec boil.ContextExecutor, inser
 	return nil
 }
 
+// AddGroupBirthdayGroups adds the given related objects to the existing relationships
+// of the birthday, optionally inserting them as new records.
+// Appends related to o.R.GroupBirthdayGroups.
+// Sets related.R.Birthdays appropriately.
+func (o *Birthday) AddGroupBirthdayGroups(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BirthdayGroup) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"birthday_group_members\" (\"birthday_id\", \"group_id\") values (?, ?)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &birthdayR{
+			GroupBirthdayGroups: related,
+		}
+	} else {
+		o.R.GroupBirthdayGroups = append(o.R.GroupBirthdayGroups, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &birthdayGroupR{
+				Birthdays: BirthdaySlice{o},
+			}
+		} else {
+			rel.R.Birthdays = append(rel.R.Birthdays, o)
+		}
+	}
+	return nil
+}
+
+// SetGroupBirthdayGroups removes all previously related items of the
+// birthday replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Birthdays's GroupBirthdayGroups accordingly.
+// Replaces o.R.GroupBirthdayGroups with related.
+// Sets related.R.Birthdays's GroupBirthdayGroups accordingly.
+func (o *Birthday) SetGroupBirthdayGroups(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BirthdayGroup) error {
+	query := "delete from \"birthday_group_members\" where \"birthday_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeGroupBirthdayGroupsFromBirthdaysSlice(o, related)
+	if o.R != nil {
+		o.R.GroupBirthdayGroups = nil
+	}
+
+	return o.AddGroupBirthdayGroups(ctx, exec, insert, related...)
+}
+
+// RemoveGroupBirthdayGroups relationships from objects passed in.
+// Removes related items from R.GroupBirthdayGroups (uses pointer comparison, removal does not keep order)
+// Sets related.R.Birthdays.
+func (o *Birthday) RemoveGroupBirthdayGroups(ctx context.Context, exec boil.ContextExecutor, related ...*BirthdayGroup) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"birthday_group_members\" where \"birthday_id\" = ? and \"group_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeGroupBirthdayGroupsFromBirthdaysSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.GroupBirthdayGroups {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.GroupBirthdayGroups)
+			if ln > 1 && i < ln-1 {
+				o.R.GroupBirthdayGroups[i] = o.R.GroupBirthdayGroups[ln-1]
+			}
+			o.R.GroupBirthdayGroups = o.R.GroupBirthdayGroups[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeGroupBirthdayGroupsFromBirthdaysSlice(o *Birthday, related []*BirthdayGroup) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Birthdays {
+			if !queries.Equal(o.ID, ri.ID) {
+				continue
+			}
+
+			ln := len(rel.R.Birthdays)
+			if ln > 1 && i < ln-1 {
+				rel.R.Birthdays[i] = rel.R.Birthdays[ln-1]
+			}
+			rel.R.Birthdays = rel.R.Birthdays[:ln-1]
+			break
+		}
+	}
+}
+
+// AddBirthdayHistories adds the given related objects to the existing relationships
+// of the birthday, optionally inserting them as new records.
+// Appends related to o.R.BirthdayHistories.
+// Sets related.R.Birthday appropriately.
+func (o *Birthday) AddBirthdayHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BirthdayHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.BirthdayID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"birthday_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"birthday_id"}),
+				strmangle.WhereClause("\"", "\"", 0, birthdayHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.BirthdayID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &birthdayR{
+			BirthdayHistories: related,
+		}
+	} else {
+		o.R.BirthdayHistories = append(o.R.BirthdayHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &birthdayHistoryR{
+				Birthday: o,
+			}
+		} else {
+			rel.R.Birthday = o
+		}
+	}
+	return nil
+}
+
+// AddBirthdayTags adds the given related objects to the existing relationships
+// of the birthday, optionally inserting them as new records.
+// Appends related to o.R.BirthdayTags.
+// Sets related.R.Birthday appropriately.
+func (o *Birthday) AddBirthdayTags(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BirthdayTag) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.BirthdayID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"birthday_tags\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"birthday_id"}),
+				strmangle.WhereClause("\"", "\"", 0, birthdayTagPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.BirthdayID, rel.Tag}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.BirthdayID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &birthdayR{
+			BirthdayTags: related,
+		}
+	} else {
+		o.R.BirthdayTags = append(o.R.BirthdayTags, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &birthdayTagR{
+				Birthday: o,
+			}
+		} else {
+			rel.R.Birthday = o
+		}
+	}
+	return nil
+}
+
+// AddGifts adds the given related objects to the existing relationships
+// of the birthday, optionally inserting them as new records.
+// Appends related to o.R.Gifts.
+// Sets related.R.Birthday appropriately.
+func (o *Birthday) AddGifts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Gift) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.BirthdayID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"gifts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"birthday_id"}),
+				strmangle.WhereClause("\"", "\"", 0, giftPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.BirthdayID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &birthdayR{
+			Gifts: related,
+		}
+	} else {
+		o.R.Gifts = append(o.R.Gifts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &giftR{
+				Birthday: o,
+			}
+		} else {
+			rel.R.Birthday = o
+		}
+	}
+	return nil
+}
+
 // Birthdays retrieves all the records using an executor.
 func Birthdays(mods ...qm.QueryMod) birthdayQuery {
-	mods = append(mods, qm.From("\"birthdays\""))
+	mods = append(mods, qm.From("\"birthdays\""), qmhelper.WhereIsNull("\"birthdays\".\"deleted_at\""))
 	q := NewQuery(mods...)
 	if len(queries.GetSelect(q)) == 0 {
 		queries.SetSelect(q, []string{"\"birthdays\".*"})
@@ -759,7 +1605,7 @@ func FindBirthday(ctx context.Context, exec boil.ContextExecutor, iD null.Int64,
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from \"birthdays\" where \"id\"=?", sel,
+		"select %s from \"birthdays\" where \"id\"=? and \"deleted_at\" is null", sel,
 	)
 
 	q := queries.Raw(query, iD)
@@ -1131,7 +1977,7 @@ func (o *Birthday) Upsert(ctx context.Context, exec boil.ContextExecutor, update
 
 // Delete deletes a single Birthday record with an executor.
 // Delete will match against the primary key column to find the record to delete.
-func (o *Birthday) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o *Birthday) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if o == nil {
 		return 0, errors.New("models: no Birthday provided for delete")
 	}
@@ -1140,8 +1986,26 @@ func (o *Birthday) Delete(ctx context.Context, exec boil.ContextExecutor) (int64
 		return 0, err
 	}
 
-	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), birthdayPrimaryKeyMapping)
-	sql := "DELETE FROM \"birthdays\" WHERE \"id\"=?"
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), birthdayPrimaryKeyMapping)
+		sql = "DELETE FROM \"birthdays\" WHERE \"id\"=?"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"birthdays\" SET %s WHERE \"id\"=?",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+		)
+		valueMapping, err := queries.BindMapping(birthdayType, birthdayMapping, append(wl, birthdayPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1166,12 +2030,17 @@ func (o *Birthday) Delete(ctx context.Context, exec boil.ContextExecutor) (int64
 }
 
 // DeleteAll deletes all matching rows.
-func (q birthdayQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (q birthdayQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if q.Query == nil {
 		return 0, errors.New("models: no birthdayQuery provided for delete all")
 	}
 
-	queries.SetDelete(q.Query)
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
 
 	result, err := q.Query.ExecContext(ctx, exec)
 	if err != nil {
@@ -1187,7 +2056,7 @@ func (q birthdayQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor)
 }
 
 // DeleteAll deletes all rows in the slice, using an executor.
-func (o BirthdaySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o BirthdaySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if len(o) == 0 {
 		return 0, nil
 	}
@@ -1200,15 +2069,32 @@ func (o BirthdaySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor)
 		}
 	}
 
-	var args []interface{}
-	for _, obj := range o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), birthdayPrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), birthdayPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"birthdays\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, birthdayPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), birthdayPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"birthdays\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, birthdayPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
 	}
 
-	sql := "DELETE FROM \"birthdays\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, birthdayPrimaryKeyColumns, len(o))
-
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
@@ -1262,7 +2148,8 @@ func (o *BirthdaySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor
 	}
 
 	sql := "SELECT \"birthdays\".* FROM \"birthdays\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, birthdayPrimaryKeyColumns, len(*o))
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, birthdayPrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
 
 	q := queries.Raw(sql, args...)
 
@@ -1279,7 +2166,7 @@ func (o *BirthdaySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor
 // BirthdayExists checks if the Birthday row exists.
 func BirthdayExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from \"birthdays\" where \"id\"=? limit 1)"
+	sql := "select exists(select 1 from \"birthdays\" where \"id\"=? and \"deleted_at\" is null limit 1)"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
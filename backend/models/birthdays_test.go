@@ -31,6 +31,107 @@ func testBirthdays(t *testing.T) {
 	}
 }
 
+func testBirthdaysSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Birthday{}
+	if err = randomize.Struct(seed, o, birthdayDBTypes, true, birthdayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Birthday struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Birthdays().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBirthdaysQuerySoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Birthday{}
+	if err = randomize.Struct(seed, o, birthdayDBTypes, true, birthdayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Birthday struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Birthdays().DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Birthdays().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBirthdaysSliceSoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Birthday{}
+	if err = randomize.Struct(seed, o, birthdayDBTypes, true, birthdayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Birthday struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BirthdaySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Birthdays().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
 func testBirthdaysDelete(t *testing.T) {
 	t.Parallel()
 
@@ -48,7 +149,7 @@ func testBirthdaysDelete(t *testing.T) {
 		t.Error(err)
 	}
 
-	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+	if rowsAff, err := o.Delete(ctx, tx, true); err != nil {
 		t.Error(err)
 	} else if rowsAff != 1 {
 		t.Error("should only have deleted one row, but affected:", rowsAff)
@@ -81,7 +182,7 @@ func testBirthdaysQueryDeleteAll(t *testing.T) {
 		t.Error(err)
 	}
 
-	if rowsAff, err := Birthdays().DeleteAll(ctx, tx); err != nil {
+	if rowsAff, err := Birthdays().DeleteAll(ctx, tx, true); err != nil {
 		t.Error(err)
 	} else if rowsAff != 1 {
 		t.Error("should only have deleted one row, but affected:", rowsAff)
@@ -116,7 +217,7 @@ func testBirthdaysSliceDeleteAll(t *testing.T) {
 
 	slice := BirthdaySlice{o}
 
-	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+	if rowsAff, err := slice.DeleteAll(ctx, tx, true); err != nil {
 		t.Error(err)
 	} else if rowsAff != 1 {
 		t.Error("should only have deleted one row, but affected:", rowsAff)
@@ -494,6 +595,774 @@ func testBirthdaysInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testBirthdayToManyGroupBirthdayGroups(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Birthday
+	var b, c BirthdayGroup
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayDBTypes, true, birthdayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Birthday struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, birthdayGroupDBTypes, false, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, birthdayGroupDBTypes, false, birthdayGroupColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = tx.Exec("insert into \"birthday_group_members\" (\"birthday_id\", \"group_id\") values (?, ?)", a.ID, b.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = tx.Exec("insert into \"birthday_group_members\" (\"birthday_id\", \"group_id\") values (?, ?)", a.ID, c.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.GroupBirthdayGroups().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.ID, b.ID) {
+			bFound = true
+		}
+		if queries.Equal(v.ID, c.ID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := BirthdaySlice{&a}
+	if err = a.L.LoadGroupBirthdayGroups(ctx, tx, false, (*[]*Birthday)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.GroupBirthdayGroups); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.GroupBirthdayGroups = nil
+	if err = a.L.LoadGroupBirthdayGroups(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.GroupBirthdayGroups); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testBirthdayToManyBirthdayHistories(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Birthday
+	var b, c BirthdayHistory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayDBTypes, true, birthdayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Birthday struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, birthdayHistoryDBTypes, false, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, birthdayHistoryDBTypes, false, birthdayHistoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.BirthdayID, a.ID)
+	queries.Assign(&c.BirthdayID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.BirthdayHistories().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.BirthdayID, b.BirthdayID) {
+			bFound = true
+		}
+		if queries.Equal(v.BirthdayID, c.BirthdayID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := BirthdaySlice{&a}
+	if err = a.L.LoadBirthdayHistories(ctx, tx, false, (*[]*Birthday)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.BirthdayHistories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.BirthdayHistories = nil
+	if err = a.L.LoadBirthdayHistories(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.BirthdayHistories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testBirthdayToManyBirthdayTags(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Birthday
+	var b, c BirthdayTag
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayDBTypes, true, birthdayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Birthday struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, birthdayTagDBTypes, false, birthdayTagColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, birthdayTagDBTypes, false, birthdayTagColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.BirthdayID, a.ID)
+	queries.Assign(&c.BirthdayID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.BirthdayTags().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.BirthdayID, b.BirthdayID) {
+			bFound = true
+		}
+		if queries.Equal(v.BirthdayID, c.BirthdayID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := BirthdaySlice{&a}
+	if err = a.L.LoadBirthdayTags(ctx, tx, false, (*[]*Birthday)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.BirthdayTags); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.BirthdayTags = nil
+	if err = a.L.LoadBirthdayTags(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.BirthdayTags); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testBirthdayToManyGifts(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Birthday
+	var b, c Gift
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayDBTypes, true, birthdayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Birthday struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, giftDBTypes, false, giftColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, giftDBTypes, false, giftColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.BirthdayID, a.ID)
+	queries.Assign(&c.BirthdayID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Gifts().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.BirthdayID, b.BirthdayID) {
+			bFound = true
+		}
+		if queries.Equal(v.BirthdayID, c.BirthdayID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := BirthdaySlice{&a}
+	if err = a.L.LoadGifts(ctx, tx, false, (*[]*Birthday)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Gifts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Gifts = nil
+	if err = a.L.LoadGifts(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Gifts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testBirthdayToManyAddOpGroupBirthdayGroups(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Birthday
+	var b, c, d, e BirthdayGroup
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayDBTypes, false, strmangle.SetComplement(birthdayPrimaryKeyColumns, birthdayColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*BirthdayGroup{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, birthdayGroupDBTypes, false, strmangle.SetComplement(birthdayGroupPrimaryKeyColumns, birthdayGroupColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*BirthdayGroup{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddGroupBirthdayGroups(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if first.R.Birthdays[0] != &a {
+			t.Error("relationship was not added properly to the slice")
+		}
+		if second.R.Birthdays[0] != &a {
+			t.Error("relationship was not added properly to the slice")
+		}
+
+		if a.R.GroupBirthdayGroups[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.GroupBirthdayGroups[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.GroupBirthdayGroups().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testBirthdayToManySetOpGroupBirthdayGroups(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Birthday
+	var b, c, d, e BirthdayGroup
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayDBTypes, false, strmangle.SetComplement(birthdayPrimaryKeyColumns, birthdayColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*BirthdayGroup{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, birthdayGroupDBTypes, false, strmangle.SetComplement(birthdayGroupPrimaryKeyColumns, birthdayGroupColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetGroupBirthdayGroups(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.GroupBirthdayGroups().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetGroupBirthdayGroups(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.GroupBirthdayGroups().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	// The following checks cannot be implemented since we have no handle
+	// to these when we call Set(). Leaving them here as wishful thinking
+	// and to let people know there's dragons.
+	//
+	// if len(b.R.Birthdays) != 0 {
+	// 	t.Error("relationship was not removed properly from the slice")
+	// }
+	// if len(c.R.Birthdays) != 0 {
+	// 	t.Error("relationship was not removed properly from the slice")
+	// }
+	if d.R.Birthdays[0] != &a {
+		t.Error("relationship was not added properly to the slice")
+	}
+	if e.R.Birthdays[0] != &a {
+		t.Error("relationship was not added properly to the slice")
+	}
+
+	if a.R.GroupBirthdayGroups[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.GroupBirthdayGroups[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testBirthdayToManyRemoveOpGroupBirthdayGroups(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Birthday
+	var b, c, d, e BirthdayGroup
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayDBTypes, false, strmangle.SetComplement(birthdayPrimaryKeyColumns, birthdayColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*BirthdayGroup{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, birthdayGroupDBTypes, false, strmangle.SetComplement(birthdayGroupPrimaryKeyColumns, birthdayGroupColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddGroupBirthdayGroups(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.GroupBirthdayGroups().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveGroupBirthdayGroups(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.GroupBirthdayGroups().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if len(b.R.Birthdays) != 0 {
+		t.Error("relationship was not removed properly from the slice")
+	}
+	if len(c.R.Birthdays) != 0 {
+		t.Error("relationship was not removed properly from the slice")
+	}
+	if d.R.Birthdays[0] != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Birthdays[0] != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if len(a.R.GroupBirthdayGroups) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.GroupBirthdayGroups[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.GroupBirthdayGroups[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testBirthdayToManyAddOpBirthdayHistories(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Birthday
+	var b, c, d, e BirthdayHistory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayDBTypes, false, strmangle.SetComplement(birthdayPrimaryKeyColumns, birthdayColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*BirthdayHistory{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, birthdayHistoryDBTypes, false, strmangle.SetComplement(birthdayHistoryPrimaryKeyColumns, birthdayHistoryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*BirthdayHistory{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddBirthdayHistories(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.BirthdayID) {
+			t.Error("foreign key was wrong value", a.ID, first.BirthdayID)
+		}
+		if !queries.Equal(a.ID, second.BirthdayID) {
+			t.Error("foreign key was wrong value", a.ID, second.BirthdayID)
+		}
+
+		if first.R.Birthday != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Birthday != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.BirthdayHistories[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.BirthdayHistories[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.BirthdayHistories().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testBirthdayToManyAddOpBirthdayTags(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Birthday
+	var b, c, d, e BirthdayTag
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayDBTypes, false, strmangle.SetComplement(birthdayPrimaryKeyColumns, birthdayColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*BirthdayTag{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, birthdayTagDBTypes, false, strmangle.SetComplement(birthdayTagPrimaryKeyColumns, birthdayTagColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*BirthdayTag{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddBirthdayTags(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.BirthdayID) {
+			t.Error("foreign key was wrong value", a.ID, first.BirthdayID)
+		}
+		if !queries.Equal(a.ID, second.BirthdayID) {
+			t.Error("foreign key was wrong value", a.ID, second.BirthdayID)
+		}
+
+		if first.R.Birthday != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Birthday != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.BirthdayTags[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.BirthdayTags[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.BirthdayTags().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testBirthdayToManyAddOpGifts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Birthday
+	var b, c, d, e Gift
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, birthdayDBTypes, false, strmangle.SetComplement(birthdayPrimaryKeyColumns, birthdayColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Gift{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, giftDBTypes, false, strmangle.SetComplement(giftPrimaryKeyColumns, giftColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Gift{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddGifts(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.BirthdayID) {
+			t.Error("foreign key was wrong value", a.ID, first.BirthdayID)
+		}
+		if !queries.Equal(a.ID, second.BirthdayID) {
+			t.Error("foreign key was wrong value", a.ID, second.BirthdayID)
+		}
+
+		if first.R.Birthday != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Birthday != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Gifts[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Gifts[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Gifts().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testBirthdayToOneUserUsingUser(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -687,7 +1556,7 @@ func testBirthdaysSelect(t *testing.T) {
 }
 
 var (
-	birthdayDBTypes = map[string]string{`ID`: `INTEGER`, `UserID`: `INTEGER`, `Name`: `TEXT`, `Date`: `DATE`, `CreatedAt`: `DATETIME`, `UpdatedAt`: `DATETIME`}
+	birthdayDBTypes = map[string]string{`ID`: `INTEGER`, `UserID`: `INTEGER`, `Name`: `TEXT`, `Date`: `DATE`, `CreatedAt`: `DATETIME`, `UpdatedAt`: `DATETIME`, `YearKnown`: `BOOLEAN`, `EventType`: `TEXT`, `YearsLabel`: `TEXT`, `Calendar`: `TEXT`, `Notes`: `TEXT`, `TelegramUsername`: `TEXT`, `Phone`: `TEXT`, `Email`: `TEXT`, `DeletedAt`: `DATETIME`, `Version`: `INTEGER`, `ListID`: `INTEGER`}
 	_               = bytes.MinRead
 )
 
@@ -8,7 +8,26 @@ import "testing"
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("APITokenToUserUsingUser", testAPITokenToOneUserUsingUser)
+	t.Run("BirthdayGroupToUserUsingUser", testBirthdayGroupToOneUserUsingUser)
+	t.Run("BirthdayHistoryToUserUsingUser", testBirthdayHistoryToOneUserUsingUser)
+	t.Run("BirthdayHistoryToBirthdayUsingBirthday", testBirthdayHistoryToOneBirthdayUsingBirthday)
+	t.Run("BirthdayTagToBirthdayUsingBirthday", testBirthdayTagToOneBirthdayUsingBirthday)
 	t.Run("BirthdayToUserUsingUser", testBirthdayToOneUserUsingUser)
+	t.Run("GiftToBirthdayUsingBirthday", testGiftToOneBirthdayUsingBirthday)
+	t.Run("InvitationToUserUsingUser", testInvitationToOneUserUsingUser)
+	t.Run("InvitationToOrganizationUsingOrganization", testInvitationToOneOrganizationUsingOrganization)
+	t.Run("InvitationToListUsingList", testInvitationToOneListUsingList)
+	t.Run("ListMemberToUserUsingUser", testListMemberToOneUserUsingUser)
+	t.Run("ListMemberToListUsingList", testListMemberToOneListUsingList)
+	t.Run("ListShareToUserUsingUser", testListShareToOneUserUsingUser)
+	t.Run("ListShareToListUsingList", testListShareToOneListUsingList)
+	t.Run("OidcIdentityToUserUsingUser", testOidcIdentityToOneUserUsingUser)
+	t.Run("OrganizationChannelToOrganizationUsingOrganization", testOrganizationChannelToOneOrganizationUsingOrganization)
+	t.Run("OrganizationMemberToUserUsingUser", testOrganizationMemberToOneUserUsingUser)
+	t.Run("OrganizationMemberToOrganizationUsingOrganization", testOrganizationMemberToOneOrganizationUsingOrganization)
+	t.Run("RecoveryCodeToUserUsingUser", testRecoveryCodeToOneUserUsingUser)
+	t.Run("SessionToUserUsingUser", testSessionToOneUserUsingUser)
 }
 
 // TestOneToOne tests cannot be run in parallel
@@ -18,18 +37,62 @@ func TestOneToOne(t *testing.T) {}
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("BirthdayGroupToBirthdays", testBirthdayGroupToManyBirthdays)
+	t.Run("BirthdayToGroupBirthdayGroups", testBirthdayToManyGroupBirthdayGroups)
+	t.Run("BirthdayToBirthdayHistories", testBirthdayToManyBirthdayHistories)
+	t.Run("BirthdayToBirthdayTags", testBirthdayToManyBirthdayTags)
+	t.Run("BirthdayToGifts", testBirthdayToManyGifts)
+	t.Run("ListToInvitations", testListToManyInvitations)
+	t.Run("ListToListMembers", testListToManyListMembers)
+	t.Run("ListToListShares", testListToManyListShares)
+	t.Run("OrganizationToInvitations", testOrganizationToManyInvitations)
+	t.Run("OrganizationToOrganizationChannels", testOrganizationToManyOrganizationChannels)
+	t.Run("OrganizationToOrganizationMembers", testOrganizationToManyOrganizationMembers)
+	t.Run("UserToAPITokens", testUserToManyAPITokens)
+	t.Run("UserToBirthdayGroups", testUserToManyBirthdayGroups)
+	t.Run("UserToBirthdayHistories", testUserToManyBirthdayHistories)
 	t.Run("UserToBirthdays", testUserToManyBirthdays)
+	t.Run("UserToInvitations", testUserToManyInvitations)
+	t.Run("UserToListMembers", testUserToManyListMembers)
+	t.Run("UserToListShares", testUserToManyListShares)
+	t.Run("UserToOidcIdentities", testUserToManyOidcIdentities)
+	t.Run("UserToOrganizationMembers", testUserToManyOrganizationMembers)
+	t.Run("UserToRecoveryCodes", testUserToManyRecoveryCodes)
+	t.Run("UserToSessions", testUserToManySessions)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("APITokenToUserUsingAPITokens", testAPITokenToOneSetOpUserUsingUser)
+	t.Run("BirthdayGroupToUserUsingBirthdayGroups", testBirthdayGroupToOneSetOpUserUsingUser)
+	t.Run("BirthdayHistoryToUserUsingBirthdayHistories", testBirthdayHistoryToOneSetOpUserUsingUser)
+	t.Run("BirthdayHistoryToBirthdayUsingBirthdayHistories", testBirthdayHistoryToOneSetOpBirthdayUsingBirthday)
+	t.Run("BirthdayTagToBirthdayUsingBirthdayTags", testBirthdayTagToOneSetOpBirthdayUsingBirthday)
 	t.Run("BirthdayToUserUsingBirthdays", testBirthdayToOneSetOpUserUsingUser)
+	t.Run("GiftToBirthdayUsingGifts", testGiftToOneSetOpBirthdayUsingBirthday)
+	t.Run("InvitationToUserUsingInvitations", testInvitationToOneSetOpUserUsingUser)
+	t.Run("InvitationToOrganizationUsingInvitations", testInvitationToOneSetOpOrganizationUsingOrganization)
+	t.Run("InvitationToListUsingInvitations", testInvitationToOneSetOpListUsingList)
+	t.Run("ListMemberToUserUsingListMembers", testListMemberToOneSetOpUserUsingUser)
+	t.Run("ListMemberToListUsingListMembers", testListMemberToOneSetOpListUsingList)
+	t.Run("ListShareToUserUsingListShares", testListShareToOneSetOpUserUsingUser)
+	t.Run("ListShareToListUsingListShares", testListShareToOneSetOpListUsingList)
+	t.Run("OidcIdentityToUserUsingOidcIdentities", testOidcIdentityToOneSetOpUserUsingUser)
+	t.Run("OrganizationChannelToOrganizationUsingOrganizationChannels", testOrganizationChannelToOneSetOpOrganizationUsingOrganization)
+	t.Run("OrganizationMemberToUserUsingOrganizationMembers", testOrganizationMemberToOneSetOpUserUsingUser)
+	t.Run("OrganizationMemberToOrganizationUsingOrganizationMembers", testOrganizationMemberToOneSetOpOrganizationUsingOrganization)
+	t.Run("RecoveryCodeToUserUsingRecoveryCodes", testRecoveryCodeToOneSetOpUserUsingUser)
+	t.Run("SessionToUserUsingSessions", testSessionToOneSetOpUserUsingUser)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("BirthdayHistoryToUserUsingBirthdayHistories", testBirthdayHistoryToOneRemoveOpUserUsingUser)
+	t.Run("InvitationToOrganizationUsingInvitations", testInvitationToOneRemoveOpOrganizationUsingOrganization)
+	t.Run("InvitationToListUsingInvitations", testInvitationToOneRemoveOpListUsingList)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
@@ -42,13 +105,46 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("BirthdayGroupToBirthdays", testBirthdayGroupToManyAddOpBirthdays)
+	t.Run("BirthdayToGroupBirthdayGroups", testBirthdayToManyAddOpGroupBirthdayGroups)
+	t.Run("BirthdayToBirthdayHistories", testBirthdayToManyAddOpBirthdayHistories)
+	t.Run("BirthdayToBirthdayTags", testBirthdayToManyAddOpBirthdayTags)
+	t.Run("BirthdayToGifts", testBirthdayToManyAddOpGifts)
+	t.Run("ListToInvitations", testListToManyAddOpInvitations)
+	t.Run("ListToListMembers", testListToManyAddOpListMembers)
+	t.Run("ListToListShares", testListToManyAddOpListShares)
+	t.Run("OrganizationToInvitations", testOrganizationToManyAddOpInvitations)
+	t.Run("OrganizationToOrganizationChannels", testOrganizationToManyAddOpOrganizationChannels)
+	t.Run("OrganizationToOrganizationMembers", testOrganizationToManyAddOpOrganizationMembers)
+	t.Run("UserToAPITokens", testUserToManyAddOpAPITokens)
+	t.Run("UserToBirthdayGroups", testUserToManyAddOpBirthdayGroups)
+	t.Run("UserToBirthdayHistories", testUserToManyAddOpBirthdayHistories)
 	t.Run("UserToBirthdays", testUserToManyAddOpBirthdays)
+	t.Run("UserToInvitations", testUserToManyAddOpInvitations)
+	t.Run("UserToListMembers", testUserToManyAddOpListMembers)
+	t.Run("UserToListShares", testUserToManyAddOpListShares)
+	t.Run("UserToOidcIdentities", testUserToManyAddOpOidcIdentities)
+	t.Run("UserToOrganizationMembers", testUserToManyAddOpOrganizationMembers)
+	t.Run("UserToRecoveryCodes", testUserToManyAddOpRecoveryCodes)
+	t.Run("UserToSessions", testUserToManyAddOpSessions)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManySet(t *testing.T) {}
+func TestToManySet(t *testing.T) {
+	t.Run("BirthdayGroupToBirthdays", testBirthdayGroupToManySetOpBirthdays)
+	t.Run("BirthdayToGroupBirthdayGroups", testBirthdayToManySetOpGroupBirthdayGroups)
+	t.Run("ListToInvitations", testListToManySetOpInvitations)
+	t.Run("OrganizationToInvitations", testOrganizationToManySetOpInvitations)
+	t.Run("UserToBirthdayHistories", testUserToManySetOpBirthdayHistories)
+}
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyRemove(t *testing.T) {}
+func TestToManyRemove(t *testing.T) {
+	t.Run("BirthdayGroupToBirthdays", testBirthdayGroupToManyRemoveOpBirthdays)
+	t.Run("BirthdayToGroupBirthdayGroups", testBirthdayToManyRemoveOpGroupBirthdayGroups)
+	t.Run("ListToInvitations", testListToManyRemoveOpInvitations)
+	t.Run("OrganizationToInvitations", testOrganizationToManyRemoveOpInvitations)
+	t.Run("UserToBirthdayHistories", testUserToManyRemoveOpBirthdayHistories)
+}
@@ -12,88 +12,370 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("APITokens", testAPITokens)
+	t.Run("BirthdayGroups", testBirthdayGroups)
+	t.Run("BirthdayHistories", testBirthdayHistories)
+	t.Run("BirthdayTags", testBirthdayTags)
 	t.Run("Birthdays", testBirthdays)
+	t.Run("Gifts", testGifts)
+	t.Run("Invitations", testInvitations)
+	t.Run("ListMembers", testListMembers)
+	t.Run("ListShares", testListShares)
+	t.Run("Lists", testLists)
+	t.Run("OidcIdentities", testOidcIdentities)
+	t.Run("OrganizationChannels", testOrganizationChannels)
+	t.Run("OrganizationMembers", testOrganizationMembers)
+	t.Run("Organizations", testOrganizations)
+	t.Run("RecoveryCodes", testRecoveryCodes)
+	t.Run("Sessions", testSessions)
 	t.Run("Users", testUsers)
 }
 
+func TestSoftDelete(t *testing.T) {
+	t.Run("Birthdays", testBirthdaysSoftDelete)
+}
+
+func TestQuerySoftDeleteAll(t *testing.T) {
+	t.Run("Birthdays", testBirthdaysQuerySoftDeleteAll)
+}
+
+func TestSliceSoftDeleteAll(t *testing.T) {
+	t.Run("Birthdays", testBirthdaysSliceSoftDeleteAll)
+}
+
 func TestDelete(t *testing.T) {
+	t.Run("APITokens", testAPITokensDelete)
+	t.Run("BirthdayGroups", testBirthdayGroupsDelete)
+	t.Run("BirthdayHistories", testBirthdayHistoriesDelete)
+	t.Run("BirthdayTags", testBirthdayTagsDelete)
 	t.Run("Birthdays", testBirthdaysDelete)
+	t.Run("Gifts", testGiftsDelete)
+	t.Run("Invitations", testInvitationsDelete)
+	t.Run("ListMembers", testListMembersDelete)
+	t.Run("ListShares", testListSharesDelete)
+	t.Run("Lists", testListsDelete)
+	t.Run("OidcIdentities", testOidcIdentitiesDelete)
+	t.Run("OrganizationChannels", testOrganizationChannelsDelete)
+	t.Run("OrganizationMembers", testOrganizationMembersDelete)
+	t.Run("Organizations", testOrganizationsDelete)
+	t.Run("RecoveryCodes", testRecoveryCodesDelete)
+	t.Run("Sessions", testSessionsDelete)
 	t.Run("Users", testUsersDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("APITokens", testAPITokensQueryDeleteAll)
+	t.Run("BirthdayGroups", testBirthdayGroupsQueryDeleteAll)
+	t.Run("BirthdayHistories", testBirthdayHistoriesQueryDeleteAll)
+	t.Run("BirthdayTags", testBirthdayTagsQueryDeleteAll)
 	t.Run("Birthdays", testBirthdaysQueryDeleteAll)
+	t.Run("Gifts", testGiftsQueryDeleteAll)
+	t.Run("Invitations", testInvitationsQueryDeleteAll)
+	t.Run("ListMembers", testListMembersQueryDeleteAll)
+	t.Run("ListShares", testListSharesQueryDeleteAll)
+	t.Run("Lists", testListsQueryDeleteAll)
+	t.Run("OidcIdentities", testOidcIdentitiesQueryDeleteAll)
+	t.Run("OrganizationChannels", testOrganizationChannelsQueryDeleteAll)
+	t.Run("OrganizationMembers", testOrganizationMembersQueryDeleteAll)
+	t.Run("Organizations", testOrganizationsQueryDeleteAll)
+	t.Run("RecoveryCodes", testRecoveryCodesQueryDeleteAll)
+	t.Run("Sessions", testSessionsQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("APITokens", testAPITokensSliceDeleteAll)
+	t.Run("BirthdayGroups", testBirthdayGroupsSliceDeleteAll)
+	t.Run("BirthdayHistories", testBirthdayHistoriesSliceDeleteAll)
+	t.Run("BirthdayTags", testBirthdayTagsSliceDeleteAll)
 	t.Run("Birthdays", testBirthdaysSliceDeleteAll)
+	t.Run("Gifts", testGiftsSliceDeleteAll)
+	t.Run("Invitations", testInvitationsSliceDeleteAll)
+	t.Run("ListMembers", testListMembersSliceDeleteAll)
+	t.Run("ListShares", testListSharesSliceDeleteAll)
+	t.Run("Lists", testListsSliceDeleteAll)
+	t.Run("OidcIdentities", testOidcIdentitiesSliceDeleteAll)
+	t.Run("OrganizationChannels", testOrganizationChannelsSliceDeleteAll)
+	t.Run("OrganizationMembers", testOrganizationMembersSliceDeleteAll)
+	t.Run("Organizations", testOrganizationsSliceDeleteAll)
+	t.Run("RecoveryCodes", testRecoveryCodesSliceDeleteAll)
+	t.Run("Sessions", testSessionsSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("APITokens", testAPITokensExists)
+	t.Run("BirthdayGroups", testBirthdayGroupsExists)
+	t.Run("BirthdayHistories", testBirthdayHistoriesExists)
+	t.Run("BirthdayTags", testBirthdayTagsExists)
 	t.Run("Birthdays", testBirthdaysExists)
+	t.Run("Gifts", testGiftsExists)
+	t.Run("Invitations", testInvitationsExists)
+	t.Run("ListMembers", testListMembersExists)
+	t.Run("ListShares", testListSharesExists)
+	t.Run("Lists", testListsExists)
+	t.Run("OidcIdentities", testOidcIdentitiesExists)
+	t.Run("OrganizationChannels", testOrganizationChannelsExists)
+	t.Run("OrganizationMembers", testOrganizationMembersExists)
+	t.Run("Organizations", testOrganizationsExists)
+	t.Run("RecoveryCodes", testRecoveryCodesExists)
+	t.Run("Sessions", testSessionsExists)
 	t.Run("Users", testUsersExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("APITokens", testAPITokensFind)
+	t.Run("BirthdayGroups", testBirthdayGroupsFind)
+	t.Run("BirthdayHistories", testBirthdayHistoriesFind)
+	t.Run("BirthdayTags", testBirthdayTagsFind)
 	t.Run("Birthdays", testBirthdaysFind)
+	t.Run("Gifts", testGiftsFind)
+	t.Run("Invitations", testInvitationsFind)
+	t.Run("ListMembers", testListMembersFind)
+	t.Run("ListShares", testListSharesFind)
+	t.Run("Lists", testListsFind)
+	t.Run("OidcIdentities", testOidcIdentitiesFind)
+	t.Run("OrganizationChannels", testOrganizationChannelsFind)
+	t.Run("OrganizationMembers", testOrganizationMembersFind)
+	t.Run("Organizations", testOrganizationsFind)
+	t.Run("RecoveryCodes", testRecoveryCodesFind)
+	t.Run("Sessions", testSessionsFind)
 	t.Run("Users", testUsersFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("APITokens", testAPITokensBind)
+	t.Run("BirthdayGroups", testBirthdayGroupsBind)
+	t.Run("BirthdayHistories", testBirthdayHistoriesBind)
+	t.Run("BirthdayTags", testBirthdayTagsBind)
 	t.Run("Birthdays", testBirthdaysBind)
+	t.Run("Gifts", testGiftsBind)
+	t.Run("Invitations", testInvitationsBind)
+	t.Run("ListMembers", testListMembersBind)
+	t.Run("ListShares", testListSharesBind)
+	t.Run("Lists", testListsBind)
+	t.Run("OidcIdentities", testOidcIdentitiesBind)
+	t.Run("OrganizationChannels", testOrganizationChannelsBind)
+	t.Run("OrganizationMembers", testOrganizationMembersBind)
+	t.Run("Organizations", testOrganizationsBind)
+	t.Run("RecoveryCodes", testRecoveryCodesBind)
+	t.Run("Sessions", testSessionsBind)
 	t.Run("Users", testUsersBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("APITokens", testAPITokensOne)
+	t.Run("BirthdayGroups", testBirthdayGroupsOne)
+	t.Run("BirthdayHistories", testBirthdayHistoriesOne)
+	t.Run("BirthdayTags", testBirthdayTagsOne)
 	t.Run("Birthdays", testBirthdaysOne)
+	t.Run("Gifts", testGiftsOne)
+	t.Run("Invitations", testInvitationsOne)
+	t.Run("ListMembers", testListMembersOne)
+	t.Run("ListShares", testListSharesOne)
+	t.Run("Lists", testListsOne)
+	t.Run("OidcIdentities", testOidcIdentitiesOne)
+	t.Run("OrganizationChannels", testOrganizationChannelsOne)
+	t.Run("OrganizationMembers", testOrganizationMembersOne)
+	t.Run("Organizations", testOrganizationsOne)
+	t.Run("RecoveryCodes", testRecoveryCodesOne)
+	t.Run("Sessions", testSessionsOne)
 	t.Run("Users", testUsersOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("APITokens", testAPITokensAll)
+	t.Run("BirthdayGroups", testBirthdayGroupsAll)
+	t.Run("BirthdayHistories", testBirthdayHistoriesAll)
+	t.Run("BirthdayTags", testBirthdayTagsAll)
 	t.Run("Birthdays", testBirthdaysAll)
+	t.Run("Gifts", testGiftsAll)
+	t.Run("Invitations", testInvitationsAll)
+	t.Run("ListMembers", testListMembersAll)
+	t.Run("ListShares", testListSharesAll)
+	t.Run("Lists", testListsAll)
+	t.Run("OidcIdentities", testOidcIdentitiesAll)
+	t.Run("OrganizationChannels", testOrganizationChannelsAll)
+	t.Run("OrganizationMembers", testOrganizationMembersAll)
+	t.Run("Organizations", testOrganizationsAll)
+	t.Run("RecoveryCodes", testRecoveryCodesAll)
+	t.Run("Sessions", testSessionsAll)
 	t.Run("Users", testUsersAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("APITokens", testAPITokensCount)
+	t.Run("BirthdayGroups", testBirthdayGroupsCount)
+	t.Run("BirthdayHistories", testBirthdayHistoriesCount)
+	t.Run("BirthdayTags", testBirthdayTagsCount)
 	t.Run("Birthdays", testBirthdaysCount)
+	t.Run("Gifts", testGiftsCount)
+	t.Run("Invitations", testInvitationsCount)
+	t.Run("ListMembers", testListMembersCount)
+	t.Run("ListShares", testListSharesCount)
+	t.Run("Lists", testListsCount)
+	t.Run("OidcIdentities", testOidcIdentitiesCount)
+	t.Run("OrganizationChannels", testOrganizationChannelsCount)
+	t.Run("OrganizationMembers", testOrganizationMembersCount)
+	t.Run("Organizations", testOrganizationsCount)
+	t.Run("RecoveryCodes", testRecoveryCodesCount)
+	t.Run("Sessions", testSessionsCount)
 	t.Run("Users", testUsersCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("APITokens", testAPITokensHooks)
+	t.Run("BirthdayGroups", testBirthdayGroupsHooks)
+	t.Run("BirthdayHistories", testBirthdayHistoriesHooks)
+	t.Run("BirthdayTags", testBirthdayTagsHooks)
 	t.Run("Birthdays", testBirthdaysHooks)
+	t.Run("Gifts", testGiftsHooks)
+	t.Run("Invitations", testInvitationsHooks)
+	t.Run("ListMembers", testListMembersHooks)
+	t.Run("ListShares", testListSharesHooks)
+	t.Run("Lists", testListsHooks)
+	t.Run("OidcIdentities", testOidcIdentitiesHooks)
+	t.Run("OrganizationChannels", testOrganizationChannelsHooks)
+	t.Run("OrganizationMembers", testOrganizationMembersHooks)
+	t.Run("Organizations", testOrganizationsHooks)
+	t.Run("RecoveryCodes", testRecoveryCodesHooks)
+	t.Run("Sessions", testSessionsHooks)
 	t.Run("Users", testUsersHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("APITokens", testAPITokensInsert)
+	t.Run("APITokens", testAPITokensInsertWhitelist)
+	t.Run("BirthdayGroups", testBirthdayGroupsInsert)
+	t.Run("BirthdayGroups", testBirthdayGroupsInsertWhitelist)
+	t.Run("BirthdayHistories", testBirthdayHistoriesInsert)
+	t.Run("BirthdayHistories", testBirthdayHistoriesInsertWhitelist)
+	t.Run("BirthdayTags", testBirthdayTagsInsert)
+	t.Run("BirthdayTags", testBirthdayTagsInsertWhitelist)
 	t.Run("Birthdays", testBirthdaysInsert)
 	t.Run("Birthdays", testBirthdaysInsertWhitelist)
+	t.Run("Gifts", testGiftsInsert)
+	t.Run("Gifts", testGiftsInsertWhitelist)
+	t.Run("Invitations", testInvitationsInsert)
+	t.Run("Invitations", testInvitationsInsertWhitelist)
+	t.Run("ListMembers", testListMembersInsert)
+	t.Run("ListMembers", testListMembersInsertWhitelist)
+	t.Run("ListShares", testListSharesInsert)
+	t.Run("ListShares", testListSharesInsertWhitelist)
+	t.Run("Lists", testListsInsert)
+	t.Run("Lists", testListsInsertWhitelist)
+	t.Run("OidcIdentities", testOidcIdentitiesInsert)
+	t.Run("OidcIdentities", testOidcIdentitiesInsertWhitelist)
+	t.Run("OrganizationChannels", testOrganizationChannelsInsert)
+	t.Run("OrganizationChannels", testOrganizationChannelsInsertWhitelist)
+	t.Run("OrganizationMembers", testOrganizationMembersInsert)
+	t.Run("OrganizationMembers", testOrganizationMembersInsertWhitelist)
+	t.Run("Organizations", testOrganizationsInsert)
+	t.Run("Organizations", testOrganizationsInsertWhitelist)
+	t.Run("RecoveryCodes", testRecoveryCodesInsert)
+	t.Run("RecoveryCodes", testRecoveryCodesInsertWhitelist)
+	t.Run("Sessions", testSessionsInsert)
+	t.Run("Sessions", testSessionsInsertWhitelist)
 	t.Run("Users", testUsersInsert)
 	t.Run("Users", testUsersInsertWhitelist)
 }
 
 func TestReload(t *testing.T) {
+	t.Run("APITokens", testAPITokensReload)
+	t.Run("BirthdayGroups", testBirthdayGroupsReload)
+	t.Run("BirthdayHistories", testBirthdayHistoriesReload)
+	t.Run("BirthdayTags", testBirthdayTagsReload)
 	t.Run("Birthdays", testBirthdaysReload)
+	t.Run("Gifts", testGiftsReload)
+	t.Run("Invitations", testInvitationsReload)
+	t.Run("ListMembers", testListMembersReload)
+	t.Run("ListShares", testListSharesReload)
+	t.Run("Lists", testListsReload)
+	t.Run("OidcIdentities", testOidcIdentitiesReload)
+	t.Run("OrganizationChannels", testOrganizationChannelsReload)
+	t.Run("OrganizationMembers", testOrganizationMembersReload)
+	t.Run("Organizations", testOrganizationsReload)
+	t.Run("RecoveryCodes", testRecoveryCodesReload)
+	t.Run("Sessions", testSessionsReload)
 	t.Run("Users", testUsersReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("APITokens", testAPITokensReloadAll)
+	t.Run("BirthdayGroups", testBirthdayGroupsReloadAll)
+	t.Run("BirthdayHistories", testBirthdayHistoriesReloadAll)
+	t.Run("BirthdayTags", testBirthdayTagsReloadAll)
 	t.Run("Birthdays", testBirthdaysReloadAll)
+	t.Run("Gifts", testGiftsReloadAll)
+	t.Run("Invitations", testInvitationsReloadAll)
+	t.Run("ListMembers", testListMembersReloadAll)
+	t.Run("ListShares", testListSharesReloadAll)
+	t.Run("Lists", testListsReloadAll)
+	t.Run("OidcIdentities", testOidcIdentitiesReloadAll)
+	t.Run("OrganizationChannels", testOrganizationChannelsReloadAll)
+	t.Run("OrganizationMembers", testOrganizationMembersReloadAll)
+	t.Run("Organizations", testOrganizationsReloadAll)
+	t.Run("RecoveryCodes", testRecoveryCodesReloadAll)
+	t.Run("Sessions", testSessionsReloadAll)
 	t.Run("Users", testUsersReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("APITokens", testAPITokensSelect)
+	t.Run("BirthdayGroups", testBirthdayGroupsSelect)
+	t.Run("BirthdayHistories", testBirthdayHistoriesSelect)
+	t.Run("BirthdayTags", testBirthdayTagsSelect)
 	t.Run("Birthdays", testBirthdaysSelect)
+	t.Run("Gifts", testGiftsSelect)
+	t.Run("Invitations", testInvitationsSelect)
+	t.Run("ListMembers", testListMembersSelect)
+	t.Run("ListShares", testListSharesSelect)
+	t.Run("Lists", testListsSelect)
+	t.Run("OidcIdentities", testOidcIdentitiesSelect)
+	t.Run("OrganizationChannels", testOrganizationChannelsSelect)
+	t.Run("OrganizationMembers", testOrganizationMembersSelect)
+	t.Run("Organizations", testOrganizationsSelect)
+	t.Run("RecoveryCodes", testRecoveryCodesSelect)
+	t.Run("Sessions", testSessionsSelect)
 	t.Run("Users", testUsersSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("APITokens", testAPITokensUpdate)
+	t.Run("BirthdayGroups", testBirthdayGroupsUpdate)
+	t.Run("BirthdayHistories", testBirthdayHistoriesUpdate)
+	t.Run("BirthdayTags", testBirthdayTagsUpdate)
 	t.Run("Birthdays", testBirthdaysUpdate)
+	t.Run("Gifts", testGiftsUpdate)
+	t.Run("Invitations", testInvitationsUpdate)
+	t.Run("ListMembers", testListMembersUpdate)
+	t.Run("ListShares", testListSharesUpdate)
+	t.Run("Lists", testListsUpdate)
+	t.Run("OidcIdentities", testOidcIdentitiesUpdate)
+	t.Run("OrganizationChannels", testOrganizationChannelsUpdate)
+	t.Run("OrganizationMembers", testOrganizationMembersUpdate)
+	t.Run("Organizations", testOrganizationsUpdate)
+	t.Run("RecoveryCodes", testRecoveryCodesUpdate)
+	t.Run("Sessions", testSessionsUpdate)
 	t.Run("Users", testUsersUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("APITokens", testAPITokensSliceUpdateAll)
+	t.Run("BirthdayGroups", testBirthdayGroupsSliceUpdateAll)
+	t.Run("BirthdayHistories", testBirthdayHistoriesSliceUpdateAll)
+	t.Run("BirthdayTags", testBirthdayTagsSliceUpdateAll)
 	t.Run("Birthdays", testBirthdaysSliceUpdateAll)
+	t.Run("Gifts", testGiftsSliceUpdateAll)
+	t.Run("Invitations", testInvitationsSliceUpdateAll)
+	t.Run("ListMembers", testListMembersSliceUpdateAll)
+	t.Run("ListShares", testListSharesSliceUpdateAll)
+	t.Run("Lists", testListsSliceUpdateAll)
+	t.Run("OidcIdentities", testOidcIdentitiesSliceUpdateAll)
+	t.Run("OrganizationChannels", testOrganizationChannelsSliceUpdateAll)
+	t.Run("OrganizationMembers", testOrganizationMembersSliceUpdateAll)
+	t.Run("Organizations", testOrganizationsSliceUpdateAll)
+	t.Run("RecoveryCodes", testRecoveryCodesSliceUpdateAll)
+	t.Run("Sessions", testSessionsSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
 }
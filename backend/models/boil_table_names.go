@@ -4,9 +4,41 @@
 package models
 
 var TableNames = struct {
-	Birthdays string
-	Users     string
+	APITokens            string
+	BirthdayGroupMembers string
+	BirthdayGroups       string
+	BirthdayHistory      string
+	BirthdayTags         string
+	Birthdays            string
+	Gifts                string
+	Invitations          string
+	ListMembers          string
+	ListShares           string
+	Lists                string
+	OidcIdentities       string
+	OrganizationChannels string
+	OrganizationMembers  string
+	Organizations        string
+	RecoveryCodes        string
+	Sessions             string
+	Users                string
 }{
-	Birthdays: "birthdays",
-	Users:     "users",
+	APITokens:            "api_tokens",
+	BirthdayGroupMembers: "birthday_group_members",
+	BirthdayGroups:       "birthday_groups",
+	BirthdayHistory:      "birthday_history",
+	BirthdayTags:         "birthday_tags",
+	Birthdays:            "birthdays",
+	Gifts:                "gifts",
+	Invitations:          "invitations",
+	ListMembers:          "list_members",
+	ListShares:           "list_shares",
+	Lists:                "lists",
+	OidcIdentities:       "oidc_identities",
+	OrganizationChannels: "organization_channels",
+	OrganizationMembers:  "organization_members",
+	Organizations:        "organizations",
+	RecoveryCodes:        "recovery_codes",
+	Sessions:             "sessions",
+	Users:                "users",
 }
@@ -0,0 +1,1238 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Gift is an object representing the database table.
+type Gift struct {
+	ID         null.Int64   `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	BirthdayID int64        `boil:"birthday_id" json:"birthday_id" toml:"birthday_id" yaml:"birthday_id"`
+	Name       string       `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Status     string       `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Price      null.Float64 `boil:"price" json:"price,omitempty" toml:"price" yaml:"price,omitempty"`
+	Link       null.String  `boil:"link" json:"link,omitempty" toml:"link" yaml:"link,omitempty"`
+	YearGiven  null.Int64   `boil:"year_given" json:"year_given,omitempty" toml:"year_given" yaml:"year_given,omitempty"`
+	Notes      null.String  `boil:"notes" json:"notes,omitempty" toml:"notes" yaml:"notes,omitempty"`
+	CreatedAt  null.Time    `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt  null.Time    `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *giftR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L giftL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var GiftColumns = struct {
+	ID         string
+	BirthdayID string
+	Name       string
+	Status     string
+	Price      string
+	Link       string
+	YearGiven  string
+	Notes      string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "id",
+	BirthdayID: "birthday_id",
+	Name:       "name",
+	Status:     "status",
+	Price:      "price",
+	Link:       "link",
+	YearGiven:  "year_given",
+	Notes:      "notes",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+}
+
+var GiftTableColumns = struct {
+	ID         string
+	BirthdayID string
+	Name       string
+	Status     string
+	Price      string
+	Link       string
+	YearGiven  string
+	Notes      string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "gifts.id",
+	BirthdayID: "gifts.birthday_id",
+	Name:       "gifts.name",
+	Status:     "gifts.status",
+	Price:      "gifts.price",
+	Link:       "gifts.link",
+	YearGiven:  "gifts.year_given",
+	Notes:      "gifts.notes",
+	CreatedAt:  "gifts.created_at",
+	UpdatedAt:  "gifts.updated_at",
+}
+
+// Generated where
+
+type whereHelpernull_Float64 struct{ field string }
+
+func (w whereHelpernull_Float64) EQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Float64) NEQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Float64) LT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Float64) LTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Float64) GT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Float64) GTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Float64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Float64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Float64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Float64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var GiftWhere = struct {
+	ID         whereHelpernull_Int64
+	BirthdayID whereHelperint64
+	Name       whereHelperstring
+	Status     whereHelperstring
+	Price      whereHelpernull_Float64
+	Link       whereHelpernull_String
+	YearGiven  whereHelpernull_Int64
+	Notes      whereHelpernull_String
+	CreatedAt  whereHelpernull_Time
+	UpdatedAt  whereHelpernull_Time
+}{
+	ID:         whereHelpernull_Int64{field: "\"gifts\".\"id\""},
+	BirthdayID: whereHelperint64{field: "\"gifts\".\"birthday_id\""},
+	Name:       whereHelperstring{field: "\"gifts\".\"name\""},
+	Status:     whereHelperstring{field: "\"gifts\".\"status\""},
+	Price:      whereHelpernull_Float64{field: "\"gifts\".\"price\""},
+	Link:       whereHelpernull_String{field: "\"gifts\".\"link\""},
+	YearGiven:  whereHelpernull_Int64{field: "\"gifts\".\"year_given\""},
+	Notes:      whereHelpernull_String{field: "\"gifts\".\"notes\""},
+	CreatedAt:  whereHelpernull_Time{field: "\"gifts\".\"created_at\""},
+	UpdatedAt:  whereHelpernull_Time{field: "\"gifts\".\"updated_at\""},
+}
+
+// GiftRels is where relationship names are stored.
+var GiftRels = struct {
+	Birthday string
+}{
+	Birthday: "Birthday",
+}
+
+// giftR is where relationships are stored.
+type giftR struct {
+	Birthday *Birthday `boil:"Birthday" json:"Birthday" toml:"Birthday" yaml:"Birthday"`
+}
+
+// NewStruct creates a new relationship struct
+func (*giftR) NewStruct() *giftR {
+	return &giftR{}
+}
+
+func (r *giftR) GetBirthday() *Birthday {
+	if r == nil {
+		return nil
+	}
+	return r.Birthday
+}
+
+// giftL is where Load methods for each relationship are stored.
+type giftL struct{}
+
+var (
+	giftAllColumns            = []string{"id", "birthday_id", "name", "status", "price", "link", "year_given", "notes", "created_at", "updated_at"}
+	giftColumnsWithoutDefault = []string{"birthday_id", "name"}
+	giftColumnsWithDefault    = []string{"id", "status", "price", "link", "year_given", "notes", "created_at", "updated_at"}
+	giftPrimaryKeyColumns     = []string{"id"}
+	giftGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// GiftSlice is an alias for a slice of pointers to Gift.
+	// This should almost always be used instead of []Gift.
+	GiftSlice []*Gift
+	// GiftHook is the signature for custom Gift hook methods
+	GiftHook func(context.Context, boil.ContextExecutor, *Gift) error
+
+	giftQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	giftType                 = reflect.TypeOf(&Gift{})
+	giftMapping              = queries.MakeStructMapping(giftType)
+	giftPrimaryKeyMapping, _ = queries.BindMapping(giftType, giftMapping, giftPrimaryKeyColumns)
+	giftInsertCacheMut       sync.RWMutex
+	giftInsertCache          = make(map[string]insertCache)
+	giftUpdateCacheMut       sync.RWMutex
+	giftUpdateCache          = make(map[string]updateCache)
+	giftUpsertCacheMut       sync.RWMutex
+	giftUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var giftAfterSelectMu sync.Mutex
+var giftAfterSelectHooks []GiftHook
+
+var giftBeforeInsertMu sync.Mutex
+var giftBeforeInsertHooks []GiftHook
+var giftAfterInsertMu sync.Mutex
+var giftAfterInsertHooks []GiftHook
+
+var giftBeforeUpdateMu sync.Mutex
+var giftBeforeUpdateHooks []GiftHook
+var giftAfterUpdateMu sync.Mutex
+var giftAfterUpdateHooks []GiftHook
+
+var giftBeforeDeleteMu sync.Mutex
+var giftBeforeDeleteHooks []GiftHook
+var giftAfterDeleteMu sync.Mutex
+var giftAfterDeleteHooks []GiftHook
+
+var giftBeforeUpsertMu sync.Mutex
+var giftBeforeUpsertHooks []GiftHook
+var giftAfterUpsertMu sync.Mutex
+var giftAfterUpsertHooks []GiftHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Gift) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range giftAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Gift) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range giftBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Gift) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range giftAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Gift) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range giftBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Gift) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range giftAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Gift) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range giftBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Gift) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range giftAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Gift) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range giftBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Gift) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range giftAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddGiftHook registers your hook function for all future operations.
+func AddGiftHook(hookPoint boil.HookPoint, giftHook GiftHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		giftAfterSelectMu.Lock()
+		giftAfterSelectHooks = append(giftAfterSelectHooks, giftHook)
+		giftAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		giftBeforeInsertMu.Lock()
+		giftBeforeInsertHooks = append(giftBeforeInsertHooks, giftHook)
+		giftBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		giftAfterInsertMu.Lock()
+		giftAfterInsertHooks = append(giftAfterInsertHooks, giftHook)
+		giftAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		giftBeforeUpdateMu.Lock()
+		giftBeforeUpdateHooks = append(giftBeforeUpdateHooks, giftHook)
+		giftBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		giftAfterUpdateMu.Lock()
+		giftAfterUpdateHooks = append(giftAfterUpdateHooks, giftHook)
+		giftAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		giftBeforeDeleteMu.Lock()
+		giftBeforeDeleteHooks = append(giftBeforeDeleteHooks, giftHook)
+		giftBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		giftAfterDeleteMu.Lock()
+		giftAfterDeleteHooks = append(giftAfterDeleteHooks, giftHook)
+		giftAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		giftBeforeUpsertMu.Lock()
+		giftBeforeUpsertHooks = append(giftBeforeUpsertHooks, giftHook)
+		giftBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		giftAfterUpsertMu.Lock()
+		giftAfterUpsertHooks = append(giftAfterUpsertHooks, giftHook)
+		giftAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single gift record from the query.
+func (q giftQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Gift, error) {
+	o := &Gift{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for gifts")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Gift records from the query.
+func (q giftQuery) All(ctx context.Context, exec boil.ContextExecutor) (GiftSlice, error) {
+	var o []*Gift
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Gift slice")
+	}
+
+	if len(giftAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Gift records in the query.
+func (q giftQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count gifts rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q giftQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if gifts exists")
+	}
+
+	return count > 0, nil
+}
+
+// Birthday pointed to by the foreign key.
+func (o *Gift) Birthday(mods ...qm.QueryMod) birthdayQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.BirthdayID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Birthdays(queryMods...)
+}
+
+// LoadBirthday allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (giftL) LoadBirthday(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGift interface{}, mods queries.Applicator) error {
+	var slice []*Gift
+	var object *Gift
+
+	if singular {
+		var ok bool
+		object, ok = maybeGift.(*Gift)
+		if !ok {
+			object = new(Gift)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGift)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGift))
+			}
+		}
+	} else {
+		s, ok := maybeGift.(*[]*Gift)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGift)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGift))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &giftR{}
+		}
+		if !queries.IsNil(object.BirthdayID) {
+			args[object.BirthdayID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &giftR{}
+			}
+
+			if !queries.IsNil(obj.BirthdayID) {
+				args[obj.BirthdayID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`birthdays`),
+		qm.WhereIn(`birthdays.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`birthdays.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Birthday")
+	}
+
+	var resultSlice []*Birthday
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Birthday")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for birthdays")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for birthdays")
+	}
+
+	if len(birthdayAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Birthday = foreign
+		if foreign.R == nil {
+			foreign.R = &birthdayR{}
+		}
+		foreign.R.Gifts = append(foreign.R.Gifts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.BirthdayID, foreign.ID) {
+				local.R.Birthday = foreign
+				if foreign.R == nil {
+					foreign.R = &birthdayR{}
+				}
+				foreign.R.Gifts = append(foreign.R.Gifts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBirthday of the gift to the related item.
+// Sets o.R.Birthday to related.
+// Adds o to related.R.Gifts.
+func (o *Gift) SetBirthday(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Birthday) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"gifts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"birthday_id"}),
+		strmangle.WhereClause("\"", "\"", 0, giftPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.BirthdayID, related.ID)
+	if o.R == nil {
+		o.R = &giftR{
+			Birthday: related,
+		}
+	} else {
+		o.R.Birthday = related
+	}
+
+	if related.R == nil {
+		related.R = &birthdayR{
+			Gifts: GiftSlice{o},
+		}
+	} else {
+		related.R.Gifts = append(related.R.Gifts, o)
+	}
+
+	return nil
+}
+
+// Gifts retrieves all the records using an executor.
+func Gifts(mods ...qm.QueryMod) giftQuery {
+	mods = append(mods, qm.From("\"gifts\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"gifts\".*"})
+	}
+
+	return giftQuery{q}
+}
+
+// FindGift retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindGift(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*Gift, error) {
+	giftObj := &Gift{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"gifts\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, giftObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from gifts")
+	}
+
+	if err = giftObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return giftObj, err
+	}
+
+	return giftObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Gift) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no gifts provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(giftColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	giftInsertCacheMut.RLock()
+	cache, cached := giftInsertCache[key]
+	giftInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			giftAllColumns,
+			giftColumnsWithDefault,
+			giftColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, giftGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(giftType, giftMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(giftType, giftMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"gifts\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"gifts\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into gifts")
+	}
+
+	if !cached {
+		giftInsertCacheMut.Lock()
+		giftInsertCache[key] = cache
+		giftInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Gift.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Gift) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	giftUpdateCacheMut.RLock()
+	cache, cached := giftUpdateCache[key]
+	giftUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			giftAllColumns,
+			giftPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, giftGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update gifts, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"gifts\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, giftPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(giftType, giftMapping, append(wl, giftPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update gifts row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for gifts")
+	}
+
+	if !cached {
+		giftUpdateCacheMut.Lock()
+		giftUpdateCache[key] = cache
+		giftUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q giftQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for gifts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for gifts")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o GiftSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), giftPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"gifts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, giftPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in gift slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all gift")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Gift) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no gifts provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(giftColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	giftUpsertCacheMut.RLock()
+	cache, cached := giftUpsertCache[key]
+	giftUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			giftAllColumns,
+			giftColumnsWithDefault,
+			giftColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			giftAllColumns,
+			giftPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert gifts, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(giftAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(giftPrimaryKeyColumns))
+			copy(conflict, giftPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"gifts\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(giftType, giftMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(giftType, giftMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert gifts")
+	}
+
+	if !cached {
+		giftUpsertCacheMut.Lock()
+		giftUpsertCache[key] = cache
+		giftUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Gift record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Gift) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Gift provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), giftPrimaryKeyMapping)
+	sql := "DELETE FROM \"gifts\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from gifts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for gifts")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q giftQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no giftQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from gifts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for gifts")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o GiftSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(giftBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), giftPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"gifts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, giftPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from gift slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for gifts")
+	}
+
+	if len(giftAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Gift) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindGift(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GiftSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := GiftSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), giftPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"gifts\".* FROM \"gifts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, giftPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in GiftSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// GiftExists checks if the Gift row exists.
+func GiftExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"gifts\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if gifts exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Gift row exists.
+func (o *Gift) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return GiftExists(ctx, exec, o.ID)
+}
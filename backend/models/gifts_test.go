@@ -0,0 +1,851 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testGifts(t *testing.T) {
+	t.Parallel()
+
+	query := Gifts()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testGiftsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gift{}
+	if err = randomize.Struct(seed, o, giftDBTypes, true, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Gifts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testGiftsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gift{}
+	if err = randomize.Struct(seed, o, giftDBTypes, true, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Gifts().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Gifts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testGiftsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gift{}
+	if err = randomize.Struct(seed, o, giftDBTypes, true, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := GiftSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Gifts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testGiftsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gift{}
+	if err = randomize.Struct(seed, o, giftDBTypes, true, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := GiftExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Gift exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected GiftExists to return true, but got false.")
+	}
+}
+
+func testGiftsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gift{}
+	if err = randomize.Struct(seed, o, giftDBTypes, true, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	giftFound, err := FindGift(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if giftFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testGiftsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gift{}
+	if err = randomize.Struct(seed, o, giftDBTypes, true, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Gifts().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testGiftsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gift{}
+	if err = randomize.Struct(seed, o, giftDBTypes, true, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Gifts().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testGiftsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	giftOne := &Gift{}
+	giftTwo := &Gift{}
+	if err = randomize.Struct(seed, giftOne, giftDBTypes, false, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+	if err = randomize.Struct(seed, giftTwo, giftDBTypes, false, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = giftOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = giftTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Gifts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testGiftsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	giftOne := &Gift{}
+	giftTwo := &Gift{}
+	if err = randomize.Struct(seed, giftOne, giftDBTypes, false, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+	if err = randomize.Struct(seed, giftTwo, giftDBTypes, false, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = giftOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = giftTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Gifts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func giftBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Gift) error {
+	*o = Gift{}
+	return nil
+}
+
+func giftAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Gift) error {
+	*o = Gift{}
+	return nil
+}
+
+func giftAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Gift) error {
+	*o = Gift{}
+	return nil
+}
+
+func giftBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Gift) error {
+	*o = Gift{}
+	return nil
+}
+
+func giftAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Gift) error {
+	*o = Gift{}
+	return nil
+}
+
+func giftBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Gift) error {
+	*o = Gift{}
+	return nil
+}
+
+func giftAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Gift) error {
+	*o = Gift{}
+	return nil
+}
+
+func giftBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Gift) error {
+	*o = Gift{}
+	return nil
+}
+
+func giftAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Gift) error {
+	*o = Gift{}
+	return nil
+}
+
+func testGiftsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Gift{}
+	o := &Gift{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, giftDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Gift object: %s", err)
+	}
+
+	AddGiftHook(boil.BeforeInsertHook, giftBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	giftBeforeInsertHooks = []GiftHook{}
+
+	AddGiftHook(boil.AfterInsertHook, giftAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	giftAfterInsertHooks = []GiftHook{}
+
+	AddGiftHook(boil.AfterSelectHook, giftAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	giftAfterSelectHooks = []GiftHook{}
+
+	AddGiftHook(boil.BeforeUpdateHook, giftBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	giftBeforeUpdateHooks = []GiftHook{}
+
+	AddGiftHook(boil.AfterUpdateHook, giftAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	giftAfterUpdateHooks = []GiftHook{}
+
+	AddGiftHook(boil.BeforeDeleteHook, giftBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	giftBeforeDeleteHooks = []GiftHook{}
+
+	AddGiftHook(boil.AfterDeleteHook, giftAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	giftAfterDeleteHooks = []GiftHook{}
+
+	AddGiftHook(boil.BeforeUpsertHook, giftBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	giftBeforeUpsertHooks = []GiftHook{}
+
+	AddGiftHook(boil.AfterUpsertHook, giftAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	giftAfterUpsertHooks = []GiftHook{}
+}
+
+func testGiftsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gift{}
+	if err = randomize.Struct(seed, o, giftDBTypes, true, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Gifts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testGiftsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gift{}
+	if err = randomize.Struct(seed, o, giftDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(giftColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Gifts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testGiftToOneBirthdayUsingBirthday(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Gift
+	var foreign Birthday
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, giftDBTypes, false, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, birthdayDBTypes, true, birthdayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Birthday struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.BirthdayID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Birthday().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddBirthdayHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Birthday) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := GiftSlice{&local}
+	if err = local.L.LoadBirthday(ctx, tx, false, (*[]*Gift)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Birthday == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Birthday = nil
+	if err = local.L.LoadBirthday(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Birthday == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testGiftToOneSetOpBirthdayUsingBirthday(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Gift
+	var b, c Birthday
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, giftDBTypes, false, strmangle.SetComplement(giftPrimaryKeyColumns, giftColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, birthdayDBTypes, false, strmangle.SetComplement(birthdayPrimaryKeyColumns, birthdayColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, birthdayDBTypes, false, strmangle.SetComplement(birthdayPrimaryKeyColumns, birthdayColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Birthday{&b, &c} {
+		err = a.SetBirthday(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Birthday != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Gifts[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.BirthdayID, x.ID) {
+			t.Error("foreign key was wrong value", a.BirthdayID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.BirthdayID))
+		reflect.Indirect(reflect.ValueOf(&a.BirthdayID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.BirthdayID, x.ID) {
+			t.Error("foreign key was wrong value", a.BirthdayID, x.ID)
+		}
+	}
+}
+
+func testGiftsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gift{}
+	if err = randomize.Struct(seed, o, giftDBTypes, true, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testGiftsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gift{}
+	if err = randomize.Struct(seed, o, giftDBTypes, true, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := GiftSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testGiftsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gift{}
+	if err = randomize.Struct(seed, o, giftDBTypes, true, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Gifts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	giftDBTypes = map[string]string{`ID`: `INTEGER`, `BirthdayID`: `INTEGER`, `Name`: `TEXT`, `Status`: `TEXT`, `Price`: `REAL`, `Link`: `TEXT`, `YearGiven`: `INTEGER`, `Notes`: `TEXT`, `CreatedAt`: `DATETIME`, `UpdatedAt`: `DATETIME`}
+	_           = bytes.MinRead
+)
+
+func testGiftsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(giftPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(giftAllColumns) == len(giftPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gift{}
+	if err = randomize.Struct(seed, o, giftDBTypes, true, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Gifts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, giftDBTypes, true, giftPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testGiftsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(giftAllColumns) == len(giftPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gift{}
+	if err = randomize.Struct(seed, o, giftDBTypes, true, giftColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Gifts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, giftDBTypes, true, giftPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(giftAllColumns, giftPrimaryKeyColumns) {
+		fields = giftAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			giftAllColumns,
+			giftPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, giftGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := GiftSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testGiftsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(giftAllColumns) == len(giftPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Gift{}
+	if err = randomize.Struct(seed, &o, giftDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Gift: %s", err)
+	}
+
+	count, err := Gifts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, giftDBTypes, false, giftPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Gift struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Gift: %s", err)
+	}
+
+	count, err = Gifts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1646 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Invitation is an object representing the database table.
+type Invitation struct {
+	ID             null.Int64  `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	ListID         null.Int64  `boil:"list_id" json:"list_id,omitempty" toml:"list_id" yaml:"list_id,omitempty"`
+	OrganizationID null.Int64  `boil:"organization_id" json:"organization_id,omitempty" toml:"organization_id" yaml:"organization_id,omitempty"`
+	UserID         int64       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Role           string      `boil:"role" json:"role" toml:"role" yaml:"role"`
+	EmailHash      null.String `boil:"email_hash" json:"email_hash,omitempty" toml:"email_hash" yaml:"email_hash,omitempty"`
+	TokenHash      string      `boil:"token_hash" json:"token_hash" toml:"token_hash" yaml:"token_hash"`
+	ExpiresAt      time.Time   `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	AcceptedBy     null.Int64  `boil:"accepted_by" json:"accepted_by,omitempty" toml:"accepted_by" yaml:"accepted_by,omitempty"`
+	AcceptedAt     null.Time   `boil:"accepted_at" json:"accepted_at,omitempty" toml:"accepted_at" yaml:"accepted_at,omitempty"`
+	CreatedAt      null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *invitationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L invitationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var InvitationColumns = struct {
+	ID             string
+	ListID         string
+	OrganizationID string
+	UserID         string
+	Role           string
+	EmailHash      string
+	TokenHash      string
+	ExpiresAt      string
+	AcceptedBy     string
+	AcceptedAt     string
+	CreatedAt      string
+}{
+	ID:             "id",
+	ListID:         "list_id",
+	OrganizationID: "organization_id",
+	UserID:         "user_id",
+	Role:           "role",
+	EmailHash:      "email_hash",
+	TokenHash:      "token_hash",
+	ExpiresAt:      "expires_at",
+	AcceptedBy:     "accepted_by",
+	AcceptedAt:     "accepted_at",
+	CreatedAt:      "created_at",
+}
+
+var InvitationTableColumns = struct {
+	ID             string
+	ListID         string
+	OrganizationID string
+	UserID         string
+	Role           string
+	EmailHash      string
+	TokenHash      string
+	ExpiresAt      string
+	AcceptedBy     string
+	AcceptedAt     string
+	CreatedAt      string
+}{
+	ID:             "invitations.id",
+	ListID:         "invitations.list_id",
+	OrganizationID: "invitations.organization_id",
+	UserID:         "invitations.user_id",
+	Role:           "invitations.role",
+	EmailHash:      "invitations.email_hash",
+	TokenHash:      "invitations.token_hash",
+	ExpiresAt:      "invitations.expires_at",
+	AcceptedBy:     "invitations.accepted_by",
+	AcceptedAt:     "invitations.accepted_at",
+	CreatedAt:      "invitations.created_at",
+}
+
+// Generated where
+
+var InvitationWhere = struct {
+	ID             whereHelpernull_Int64
+	ListID         whereHelpernull_Int64
+	OrganizationID whereHelpernull_Int64
+	UserID         whereHelperint64
+	Role           whereHelperstring
+	EmailHash      whereHelpernull_String
+	TokenHash      whereHelperstring
+	ExpiresAt      whereHelpertime_Time
+	AcceptedBy     whereHelpernull_Int64
+	AcceptedAt     whereHelpernull_Time
+	CreatedAt      whereHelpernull_Time
+}{
+	ID:             whereHelpernull_Int64{field: "\"invitations\".\"id\""},
+	ListID:         whereHelpernull_Int64{field: "\"invitations\".\"list_id\""},
+	OrganizationID: whereHelpernull_Int64{field: "\"invitations\".\"organization_id\""},
+	UserID:         whereHelperint64{field: "\"invitations\".\"user_id\""},
+	Role:           whereHelperstring{field: "\"invitations\".\"role\""},
+	EmailHash:      whereHelpernull_String{field: "\"invitations\".\"email_hash\""},
+	TokenHash:      whereHelperstring{field: "\"invitations\".\"token_hash\""},
+	ExpiresAt:      whereHelpertime_Time{field: "\"invitations\".\"expires_at\""},
+	AcceptedBy:     whereHelpernull_Int64{field: "\"invitations\".\"accepted_by\""},
+	AcceptedAt:     whereHelpernull_Time{field: "\"invitations\".\"accepted_at\""},
+	CreatedAt:      whereHelpernull_Time{field: "\"invitations\".\"created_at\""},
+}
+
+// InvitationRels is where relationship names are stored.
+var InvitationRels = struct {
+	User         string
+	Organization string
+	List         string
+}{
+	User:         "User",
+	Organization: "Organization",
+	List:         "List",
+}
+
+// invitationR is where relationships are stored.
+type invitationR struct {
+	User         *User         `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Organization *Organization `boil:"Organization" json:"Organization" toml:"Organization" yaml:"Organization"`
+	List         *List         `boil:"List" json:"List" toml:"List" yaml:"List"`
+}
+
+// NewStruct creates a new relationship struct
+func (*invitationR) NewStruct() *invitationR {
+	return &invitationR{}
+}
+
+func (r *invitationR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *invitationR) GetOrganization() *Organization {
+	if r == nil {
+		return nil
+	}
+	return r.Organization
+}
+
+func (r *invitationR) GetList() *List {
+	if r == nil {
+		return nil
+	}
+	return r.List
+}
+
+// invitationL is where Load methods for each relationship are stored.
+type invitationL struct{}
+
+var (
+	invitationAllColumns            = []string{"id", "list_id", "organization_id", "user_id", "role", "email_hash", "token_hash", "expires_at", "accepted_by", "accepted_at", "created_at"}
+	invitationColumnsWithoutDefault = []string{"user_id", "role", "token_hash", "expires_at"}
+	invitationColumnsWithDefault    = []string{"id", "list_id", "organization_id", "email_hash", "accepted_by", "accepted_at", "created_at"}
+	invitationPrimaryKeyColumns     = []string{"id"}
+	invitationGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// InvitationSlice is an alias for a slice of pointers to Invitation.
+	// This should almost always be used instead of []Invitation.
+	InvitationSlice []*Invitation
+	// InvitationHook is the signature for custom Invitation hook methods
+	InvitationHook func(context.Context, boil.ContextExecutor, *Invitation) error
+
+	invitationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	invitationType                 = reflect.TypeOf(&Invitation{})
+	invitationMapping              = queries.MakeStructMapping(invitationType)
+	invitationPrimaryKeyMapping, _ = queries.BindMapping(invitationType, invitationMapping, invitationPrimaryKeyColumns)
+	invitationInsertCacheMut       sync.RWMutex
+	invitationInsertCache          = make(map[string]insertCache)
+	invitationUpdateCacheMut       sync.RWMutex
+	invitationUpdateCache          = make(map[string]updateCache)
+	invitationUpsertCacheMut       sync.RWMutex
+	invitationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var invitationAfterSelectMu sync.Mutex
+var invitationAfterSelectHooks []InvitationHook
+
+var invitationBeforeInsertMu sync.Mutex
+var invitationBeforeInsertHooks []InvitationHook
+var invitationAfterInsertMu sync.Mutex
+var invitationAfterInsertHooks []InvitationHook
+
+var invitationBeforeUpdateMu sync.Mutex
+var invitationBeforeUpdateHooks []InvitationHook
+var invitationAfterUpdateMu sync.Mutex
+var invitationAfterUpdateHooks []InvitationHook
+
+var invitationBeforeDeleteMu sync.Mutex
+var invitationBeforeDeleteHooks []InvitationHook
+var invitationAfterDeleteMu sync.Mutex
+var invitationAfterDeleteHooks []InvitationHook
+
+var invitationBeforeUpsertMu sync.Mutex
+var invitationBeforeUpsertHooks []InvitationHook
+var invitationAfterUpsertMu sync.Mutex
+var invitationAfterUpsertHooks []InvitationHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Invitation) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range invitationAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Invitation) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range invitationBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Invitation) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range invitationAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Invitation) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range invitationBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Invitation) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range invitationAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Invitation) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range invitationBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Invitation) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range invitationAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Invitation) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range invitationBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Invitation) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range invitationAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddInvitationHook registers your hook function for all future operations.
+func AddInvitationHook(hookPoint boil.HookPoint, invitationHook InvitationHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		invitationAfterSelectMu.Lock()
+		invitationAfterSelectHooks = append(invitationAfterSelectHooks, invitationHook)
+		invitationAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		invitationBeforeInsertMu.Lock()
+		invitationBeforeInsertHooks = append(invitationBeforeInsertHooks, invitationHook)
+		invitationBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		invitationAfterInsertMu.Lock()
+		invitationAfterInsertHooks = append(invitationAfterInsertHooks, invitationHook)
+		invitationAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		invitationBeforeUpdateMu.Lock()
+		invitationBeforeUpdateHooks = append(invitationBeforeUpdateHooks, invitationHook)
+		invitationBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		invitationAfterUpdateMu.Lock()
+		invitationAfterUpdateHooks = append(invitationAfterUpdateHooks, invitationHook)
+		invitationAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		invitationBeforeDeleteMu.Lock()
+		invitationBeforeDeleteHooks = append(invitationBeforeDeleteHooks, invitationHook)
+		invitationBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		invitationAfterDeleteMu.Lock()
+		invitationAfterDeleteHooks = append(invitationAfterDeleteHooks, invitationHook)
+		invitationAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		invitationBeforeUpsertMu.Lock()
+		invitationBeforeUpsertHooks = append(invitationBeforeUpsertHooks, invitationHook)
+		invitationBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		invitationAfterUpsertMu.Lock()
+		invitationAfterUpsertHooks = append(invitationAfterUpsertHooks, invitationHook)
+		invitationAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single invitation record from the query.
+func (q invitationQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Invitation, error) {
+	o := &Invitation{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for invitations")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Invitation records from the query.
+func (q invitationQuery) All(ctx context.Context, exec boil.ContextExecutor) (InvitationSlice, error) {
+	var o []*Invitation
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Invitation slice")
+	}
+
+	if len(invitationAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Invitation records in the query.
+func (q invitationQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count invitations rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q invitationQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if invitations exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *Invitation) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Organization pointed to by the foreign key.
+func (o *Invitation) Organization(mods ...qm.QueryMod) organizationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OrganizationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Organizations(queryMods...)
+}
+
+// List pointed to by the foreign key.
+func (o *Invitation) List(mods ...qm.QueryMod) listQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ListID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Lists(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (invitationL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeInvitation interface{}, mods queries.Applicator) error {
+	var slice []*Invitation
+	var object *Invitation
+
+	if singular {
+		var ok bool
+		object, ok = maybeInvitation.(*Invitation)
+		if !ok {
+			object = new(Invitation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeInvitation))
+			}
+		}
+	} else {
+		s, ok := maybeInvitation.(*[]*Invitation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeInvitation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &invitationR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args[object.UserID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &invitationR{}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args[obj.UserID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.Invitations = append(foreign.R.Invitations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.Invitations = append(foreign.R.Invitations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOrganization allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (invitationL) LoadOrganization(ctx context.Context, e boil.ContextExecutor, singular bool, maybeInvitation interface{}, mods queries.Applicator) error {
+	var slice []*Invitation
+	var object *Invitation
+
+	if singular {
+		var ok bool
+		object, ok = maybeInvitation.(*Invitation)
+		if !ok {
+			object = new(Invitation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeInvitation))
+			}
+		}
+	} else {
+		s, ok := maybeInvitation.(*[]*Invitation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeInvitation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &invitationR{}
+		}
+		if !queries.IsNil(object.OrganizationID) {
+			args[object.OrganizationID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &invitationR{}
+			}
+
+			if !queries.IsNil(obj.OrganizationID) {
+				args[obj.OrganizationID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organizations`),
+		qm.WhereIn(`organizations.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Organization")
+	}
+
+	var resultSlice []*Organization
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Organization")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for organizations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organizations")
+	}
+
+	if len(organizationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Organization = foreign
+		if foreign.R == nil {
+			foreign.R = &organizationR{}
+		}
+		foreign.R.Invitations = append(foreign.R.Invitations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.OrganizationID, foreign.ID) {
+				local.R.Organization = foreign
+				if foreign.R == nil {
+					foreign.R = &organizationR{}
+				}
+				foreign.R.Invitations = append(foreign.R.Invitations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadList allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (invitationL) LoadList(ctx context.Context, e boil.ContextExecutor, singular bool, maybeInvitation interface{}, mods queries.Applicator) error {
+	var slice []*Invitation
+	var object *Invitation
+
+	if singular {
+		var ok bool
+		object, ok = maybeInvitation.(*Invitation)
+		if !ok {
+			object = new(Invitation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeInvitation))
+			}
+		}
+	} else {
+		s, ok := maybeInvitation.(*[]*Invitation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeInvitation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &invitationR{}
+		}
+		if !queries.IsNil(object.ListID) {
+			args[object.ListID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &invitationR{}
+			}
+
+			if !queries.IsNil(obj.ListID) {
+				args[obj.ListID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`lists`),
+		qm.WhereIn(`lists.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load List")
+	}
+
+	var resultSlice []*List
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice List")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for lists")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for lists")
+	}
+
+	if len(listAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.List = foreign
+		if foreign.R == nil {
+			foreign.R = &listR{}
+		}
+		foreign.R.Invitations = append(foreign.R.Invitations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ListID, foreign.ID) {
+				local.R.List = foreign
+				if foreign.R == nil {
+					foreign.R = &listR{}
+				}
+				foreign.R.Invitations = append(foreign.R.Invitations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the invitation to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Invitations.
+func (o *Invitation) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 0, invitationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserID, related.ID)
+	if o.R == nil {
+		o.R = &invitationR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			Invitations: InvitationSlice{o},
+		}
+	} else {
+		related.R.Invitations = append(related.R.Invitations, o)
+	}
+
+	return nil
+}
+
+// SetOrganization of the invitation to the related item.
+// Sets o.R.Organization to related.
+// Adds o to related.R.Invitations.
+func (o *Invitation) SetOrganization(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Organization) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"organization_id"}),
+		strmangle.WhereClause("\"", "\"", 0, invitationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.OrganizationID, related.ID)
+	if o.R == nil {
+		o.R = &invitationR{
+			Organization: related,
+		}
+	} else {
+		o.R.Organization = related
+	}
+
+	if related.R == nil {
+		related.R = &organizationR{
+			Invitations: InvitationSlice{o},
+		}
+	} else {
+		related.R.Invitations = append(related.R.Invitations, o)
+	}
+
+	return nil
+}
+
+// RemoveOrganization relationship.
+// Sets o.R.Organization to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Invitation) RemoveOrganization(ctx context.Context, exec boil.ContextExecutor, related *Organization) error {
+	var err error
+
+	queries.SetScanner(&o.OrganizationID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("organization_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Organization = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Invitations {
+		if queries.Equal(o.OrganizationID, ri.OrganizationID) {
+			continue
+		}
+
+		ln := len(related.R.Invitations)
+		if ln > 1 && i < ln-1 {
+			related.R.Invitations[i] = related.R.Invitations[ln-1]
+		}
+		related.R.Invitations = related.R.Invitations[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetList of the invitation to the related item.
+// Sets o.R.List to related.
+// Adds o to related.R.Invitations.
+func (o *Invitation) SetList(ctx context.Context, exec boil.ContextExecutor, insert bool, related *List) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"list_id"}),
+		strmangle.WhereClause("\"", "\"", 0, invitationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ListID, related.ID)
+	if o.R == nil {
+		o.R = &invitationR{
+			List: related,
+		}
+	} else {
+		o.R.List = related
+	}
+
+	if related.R == nil {
+		related.R = &listR{
+			Invitations: InvitationSlice{o},
+		}
+	} else {
+		related.R.Invitations = append(related.R.Invitations, o)
+	}
+
+	return nil
+}
+
+// RemoveList relationship.
+// Sets o.R.List to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Invitation) RemoveList(ctx context.Context, exec boil.ContextExecutor, related *List) error {
+	var err error
+
+	queries.SetScanner(&o.ListID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("list_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.List = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Invitations {
+		if queries.Equal(o.ListID, ri.ListID) {
+			continue
+		}
+
+		ln := len(related.R.Invitations)
+		if ln > 1 && i < ln-1 {
+			related.R.Invitations[i] = related.R.Invitations[ln-1]
+		}
+		related.R.Invitations = related.R.Invitations[:ln-1]
+		break
+	}
+	return nil
+}
+
+// Invitations retrieves all the records using an executor.
+func Invitations(mods ...qm.QueryMod) invitationQuery {
+	mods = append(mods, qm.From("\"invitations\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"invitations\".*"})
+	}
+
+	return invitationQuery{q}
+}
+
+// FindInvitation retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindInvitation(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*Invitation, error) {
+	invitationObj := &Invitation{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"invitations\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, invitationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from invitations")
+	}
+
+	if err = invitationObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return invitationObj, err
+	}
+
+	return invitationObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Invitation) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no invitations provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(invitationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	invitationInsertCacheMut.RLock()
+	cache, cached := invitationInsertCache[key]
+	invitationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			invitationAllColumns,
+			invitationColumnsWithDefault,
+			invitationColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, invitationGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(invitationType, invitationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(invitationType, invitationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"invitations\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"invitations\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into invitations")
+	}
+
+	if !cached {
+		invitationInsertCacheMut.Lock()
+		invitationInsertCache[key] = cache
+		invitationInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Invitation.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Invitation) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	invitationUpdateCacheMut.RLock()
+	cache, cached := invitationUpdateCache[key]
+	invitationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			invitationAllColumns,
+			invitationPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, invitationGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update invitations, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"invitations\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, invitationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(invitationType, invitationMapping, append(wl, invitationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update invitations row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for invitations")
+	}
+
+	if !cached {
+		invitationUpdateCacheMut.Lock()
+		invitationUpdateCache[key] = cache
+		invitationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q invitationQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for invitations")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o InvitationSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), invitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, invitationPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in invitation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all invitation")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Invitation) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no invitations provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(invitationColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	invitationUpsertCacheMut.RLock()
+	cache, cached := invitationUpsertCache[key]
+	invitationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			invitationAllColumns,
+			invitationColumnsWithDefault,
+			invitationColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			invitationAllColumns,
+			invitationPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert invitations, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(invitationAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(invitationPrimaryKeyColumns))
+			copy(conflict, invitationPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"invitations\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(invitationType, invitationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(invitationType, invitationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert invitations")
+	}
+
+	if !cached {
+		invitationUpsertCacheMut.Lock()
+		invitationUpsertCache[key] = cache
+		invitationUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Invitation record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Invitation) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Invitation provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), invitationPrimaryKeyMapping)
+	sql := "DELETE FROM \"invitations\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for invitations")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q invitationQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no invitationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for invitations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o InvitationSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(invitationBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), invitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"invitations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, invitationPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from invitation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for invitations")
+	}
+
+	if len(invitationAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Invitation) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindInvitation(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *InvitationSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := InvitationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), invitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"invitations\".* FROM \"invitations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, invitationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in InvitationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// InvitationExists checks if the Invitation row exists.
+func InvitationExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"invitations\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if invitations exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Invitation row exists.
+func (o *Invitation) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return InvitationExists(ctx, exec, o.ID)
+}
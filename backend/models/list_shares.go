@@ -0,0 +1,1360 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ListShare is an object representing the database table.
+type ListShare struct {
+	ID        null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	ListID    int64      `boil:"list_id" json:"list_id" toml:"list_id" yaml:"list_id"`
+	UserID    int64      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	TokenHash string     `boil:"token_hash" json:"token_hash" toml:"token_hash" yaml:"token_hash"`
+	HideYears bool       `boil:"hide_years" json:"hide_years" toml:"hide_years" yaml:"hide_years"`
+	ExpiresAt null.Time  `boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
+	CreatedAt null.Time  `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *listShareR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L listShareL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ListShareColumns = struct {
+	ID        string
+	ListID    string
+	UserID    string
+	TokenHash string
+	HideYears string
+	ExpiresAt string
+	CreatedAt string
+}{
+	ID:        "id",
+	ListID:    "list_id",
+	UserID:    "user_id",
+	TokenHash: "token_hash",
+	HideYears: "hide_years",
+	ExpiresAt: "expires_at",
+	CreatedAt: "created_at",
+}
+
+var ListShareTableColumns = struct {
+	ID        string
+	ListID    string
+	UserID    string
+	TokenHash string
+	HideYears string
+	ExpiresAt string
+	CreatedAt string
+}{
+	ID:        "list_shares.id",
+	ListID:    "list_shares.list_id",
+	UserID:    "list_shares.user_id",
+	TokenHash: "list_shares.token_hash",
+	HideYears: "list_shares.hide_years",
+	ExpiresAt: "list_shares.expires_at",
+	CreatedAt: "list_shares.created_at",
+}
+
+// Generated where
+
+var ListShareWhere = struct {
+	ID        whereHelpernull_Int64
+	ListID    whereHelperint64
+	UserID    whereHelperint64
+	TokenHash whereHelperstring
+	HideYears whereHelperbool
+	ExpiresAt whereHelpernull_Time
+	CreatedAt whereHelpernull_Time
+}{
+	ID:        whereHelpernull_Int64{field: "\"list_shares\".\"id\""},
+	ListID:    whereHelperint64{field: "\"list_shares\".\"list_id\""},
+	UserID:    whereHelperint64{field: "\"list_shares\".\"user_id\""},
+	TokenHash: whereHelperstring{field: "\"list_shares\".\"token_hash\""},
+	HideYears: whereHelperbool{field: "\"list_shares\".\"hide_years\""},
+	ExpiresAt: whereHelpernull_Time{field: "\"list_shares\".\"expires_at\""},
+	CreatedAt: whereHelpernull_Time{field: "\"list_shares\".\"created_at\""},
+}
+
+// ListShareRels is where relationship names are stored.
+var ListShareRels = struct {
+	User string
+	List string
+}{
+	User: "User",
+	List: "List",
+}
+
+// listShareR is where relationships are stored.
+type listShareR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+	List *List `boil:"List" json:"List" toml:"List" yaml:"List"`
+}
+
+// NewStruct creates a new relationship struct
+func (*listShareR) NewStruct() *listShareR {
+	return &listShareR{}
+}
+
+func (r *listShareR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *listShareR) GetList() *List {
+	if r == nil {
+		return nil
+	}
+	return r.List
+}
+
+// listShareL is where Load methods for each relationship are stored.
+type listShareL struct{}
+
+var (
+	listShareAllColumns            = []string{"id", "list_id", "user_id", "token_hash", "hide_years", "expires_at", "created_at"}
+	listShareColumnsWithoutDefault = []string{"list_id", "user_id", "token_hash"}
+	listShareColumnsWithDefault    = []string{"id", "hide_years", "expires_at", "created_at"}
+	listSharePrimaryKeyColumns     = []string{"id"}
+	listShareGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// ListShareSlice is an alias for a slice of pointers to ListShare.
+	// This should almost always be used instead of []ListShare.
+	ListShareSlice []*ListShare
+	// ListShareHook is the signature for custom ListShare hook methods
+	ListShareHook func(context.Context, boil.ContextExecutor, *ListShare) error
+
+	listShareQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	listShareType                 = reflect.TypeOf(&ListShare{})
+	listShareMapping              = queries.MakeStructMapping(listShareType)
+	listSharePrimaryKeyMapping, _ = queries.BindMapping(listShareType, listShareMapping, listSharePrimaryKeyColumns)
+	listShareInsertCacheMut       sync.RWMutex
+	listShareInsertCache          = make(map[string]insertCache)
+	listShareUpdateCacheMut       sync.RWMutex
+	listShareUpdateCache          = make(map[string]updateCache)
+	listShareUpsertCacheMut       sync.RWMutex
+	listShareUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var listShareAfterSelectMu sync.Mutex
+var listShareAfterSelectHooks []ListShareHook
+
+var listShareBeforeInsertMu sync.Mutex
+var listShareBeforeInsertHooks []ListShareHook
+var listShareAfterInsertMu sync.Mutex
+var listShareAfterInsertHooks []ListShareHook
+
+var listShareBeforeUpdateMu sync.Mutex
+var listShareBeforeUpdateHooks []ListShareHook
+var listShareAfterUpdateMu sync.Mutex
+var listShareAfterUpdateHooks []ListShareHook
+
+var listShareBeforeDeleteMu sync.Mutex
+var listShareBeforeDeleteHooks []ListShareHook
+var listShareAfterDeleteMu sync.Mutex
+var listShareAfterDeleteHooks []ListShareHook
+
+var listShareBeforeUpsertMu sync.Mutex
+var listShareBeforeUpsertHooks []ListShareHook
+var listShareAfterUpsertMu sync.Mutex
+var listShareAfterUpsertHooks []ListShareHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ListShare) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listShareAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ListShare) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listShareBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ListShare) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listShareAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ListShare) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listShareBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ListShare) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listShareAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ListShare) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listShareBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ListShare) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listShareAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ListShare) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listShareBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ListShare) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listShareAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddListShareHook registers your hook function for all future operations.
+func AddListShareHook(hookPoint boil.HookPoint, listShareHook ListShareHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		listShareAfterSelectMu.Lock()
+		listShareAfterSelectHooks = append(listShareAfterSelectHooks, listShareHook)
+		listShareAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		listShareBeforeInsertMu.Lock()
+		listShareBeforeInsertHooks = append(listShareBeforeInsertHooks, listShareHook)
+		listShareBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		listShareAfterInsertMu.Lock()
+		listShareAfterInsertHooks = append(listShareAfterInsertHooks, listShareHook)
+		listShareAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		listShareBeforeUpdateMu.Lock()
+		listShareBeforeUpdateHooks = append(listShareBeforeUpdateHooks, listShareHook)
+		listShareBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		listShareAfterUpdateMu.Lock()
+		listShareAfterUpdateHooks = append(listShareAfterUpdateHooks, listShareHook)
+		listShareAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		listShareBeforeDeleteMu.Lock()
+		listShareBeforeDeleteHooks = append(listShareBeforeDeleteHooks, listShareHook)
+		listShareBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		listShareAfterDeleteMu.Lock()
+		listShareAfterDeleteHooks = append(listShareAfterDeleteHooks, listShareHook)
+		listShareAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		listShareBeforeUpsertMu.Lock()
+		listShareBeforeUpsertHooks = append(listShareBeforeUpsertHooks, listShareHook)
+		listShareBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		listShareAfterUpsertMu.Lock()
+		listShareAfterUpsertHooks = append(listShareAfterUpsertHooks, listShareHook)
+		listShareAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single listShare record from the query.
+func (q listShareQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ListShare, error) {
+	o := &ListShare{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for list_shares")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ListShare records from the query.
+func (q listShareQuery) All(ctx context.Context, exec boil.ContextExecutor) (ListShareSlice, error) {
+	var o []*ListShare
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ListShare slice")
+	}
+
+	if len(listShareAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ListShare records in the query.
+func (q listShareQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count list_shares rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q listShareQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if list_shares exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *ListShare) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// List pointed to by the foreign key.
+func (o *ListShare) List(mods ...qm.QueryMod) listQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ListID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Lists(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listShareL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListShare interface{}, mods queries.Applicator) error {
+	var slice []*ListShare
+	var object *ListShare
+
+	if singular {
+		var ok bool
+		object, ok = maybeListShare.(*ListShare)
+		if !ok {
+			object = new(ListShare)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeListShare)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeListShare))
+			}
+		}
+	} else {
+		s, ok := maybeListShare.(*[]*ListShare)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeListShare)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeListShare))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &listShareR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args[object.UserID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listShareR{}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args[obj.UserID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ListShares = append(foreign.R.ListShares, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ListShares = append(foreign.R.ListShares, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadList allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listShareL) LoadList(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListShare interface{}, mods queries.Applicator) error {
+	var slice []*ListShare
+	var object *ListShare
+
+	if singular {
+		var ok bool
+		object, ok = maybeListShare.(*ListShare)
+		if !ok {
+			object = new(ListShare)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeListShare)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeListShare))
+			}
+		}
+	} else {
+		s, ok := maybeListShare.(*[]*ListShare)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeListShare)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeListShare))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &listShareR{}
+		}
+		if !queries.IsNil(object.ListID) {
+			args[object.ListID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listShareR{}
+			}
+
+			if !queries.IsNil(obj.ListID) {
+				args[obj.ListID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`lists`),
+		qm.WhereIn(`lists.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load List")
+	}
+
+	var resultSlice []*List
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice List")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for lists")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for lists")
+	}
+
+	if len(listAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.List = foreign
+		if foreign.R == nil {
+			foreign.R = &listR{}
+		}
+		foreign.R.ListShares = append(foreign.R.ListShares, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ListID, foreign.ID) {
+				local.R.List = foreign
+				if foreign.R == nil {
+					foreign.R = &listR{}
+				}
+				foreign.R.ListShares = append(foreign.R.ListShares, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the listShare to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ListShares.
+func (o *ListShare) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"list_shares\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 0, listSharePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserID, related.ID)
+	if o.R == nil {
+		o.R = &listShareR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ListShares: ListShareSlice{o},
+		}
+	} else {
+		related.R.ListShares = append(related.R.ListShares, o)
+	}
+
+	return nil
+}
+
+// SetList of the listShare to the related item.
+// Sets o.R.List to related.
+// Adds o to related.R.ListShares.
+func (o *ListShare) SetList(ctx context.Context, exec boil.ContextExecutor, insert bool, related *List) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"list_shares\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"list_id"}),
+		strmangle.WhereClause("\"", "\"", 0, listSharePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ListID, related.ID)
+	if o.R == nil {
+		o.R = &listShareR{
+			List: related,
+		}
+	} else {
+		o.R.List = related
+	}
+
+	if related.R == nil {
+		related.R = &listR{
+			ListShares: ListShareSlice{o},
+		}
+	} else {
+		related.R.ListShares = append(related.R.ListShares, o)
+	}
+
+	return nil
+}
+
+// ListShares retrieves all the records using an executor.
+func ListShares(mods ...qm.QueryMod) listShareQuery {
+	mods = append(mods, qm.From("\"list_shares\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"list_shares\".*"})
+	}
+
+	return listShareQuery{q}
+}
+
+// FindListShare retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindListShare(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*ListShare, error) {
+	listShareObj := &ListShare{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"list_shares\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, listShareObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from list_shares")
+	}
+
+	if err = listShareObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return listShareObj, err
+	}
+
+	return listShareObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ListShare) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no list_shares provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(listShareColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	listShareInsertCacheMut.RLock()
+	cache, cached := listShareInsertCache[key]
+	listShareInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			listShareAllColumns,
+			listShareColumnsWithDefault,
+			listShareColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, listShareGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(listShareType, listShareMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(listShareType, listShareMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"list_shares\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"list_shares\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into list_shares")
+	}
+
+	if !cached {
+		listShareInsertCacheMut.Lock()
+		listShareInsertCache[key] = cache
+		listShareInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ListShare.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ListShare) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	listShareUpdateCacheMut.RLock()
+	cache, cached := listShareUpdateCache[key]
+	listShareUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			listShareAllColumns,
+			listSharePrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, listShareGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update list_shares, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"list_shares\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, listSharePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(listShareType, listShareMapping, append(wl, listSharePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update list_shares row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for list_shares")
+	}
+
+	if !cached {
+		listShareUpdateCacheMut.Lock()
+		listShareUpdateCache[key] = cache
+		listShareUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q listShareQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for list_shares")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for list_shares")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ListShareSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listSharePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"list_shares\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, listSharePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in listShare slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all listShare")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ListShare) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no list_shares provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(listShareColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	listShareUpsertCacheMut.RLock()
+	cache, cached := listShareUpsertCache[key]
+	listShareUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			listShareAllColumns,
+			listShareColumnsWithDefault,
+			listShareColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			listShareAllColumns,
+			listSharePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert list_shares, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(listShareAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(listSharePrimaryKeyColumns))
+			copy(conflict, listSharePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"list_shares\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(listShareType, listShareMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(listShareType, listShareMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert list_shares")
+	}
+
+	if !cached {
+		listShareUpsertCacheMut.Lock()
+		listShareUpsertCache[key] = cache
+		listShareUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ListShare record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ListShare) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ListShare provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), listSharePrimaryKeyMapping)
+	sql := "DELETE FROM \"list_shares\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from list_shares")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for list_shares")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q listShareQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no listShareQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from list_shares")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for list_shares")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ListShareSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(listShareBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listSharePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"list_shares\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, listSharePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from listShare slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for list_shares")
+	}
+
+	if len(listShareAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ListShare) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindListShare(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ListShareSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ListShareSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listSharePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"list_shares\".* FROM \"list_shares\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, listSharePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ListShareSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ListShareExists checks if the ListShare row exists.
+func ListShareExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"list_shares\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if list_shares exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ListShare row exists.
+func (o *ListShare) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ListShareExists(ctx, exec, o.ID)
+}
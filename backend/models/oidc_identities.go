@@ -0,0 +1,1168 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// OidcIdentity is an object representing the database table.
+type OidcIdentity struct {
+	ID          null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	UserID      int64      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Issuer      string     `boil:"issuer" json:"issuer" toml:"issuer" yaml:"issuer"`
+	Subject     string     `boil:"subject" json:"subject" toml:"subject" yaml:"subject"`
+	Email       string     `boil:"email" json:"email" toml:"email" yaml:"email"`
+	LastLoginAt null.Time  `boil:"last_login_at" json:"last_login_at,omitempty" toml:"last_login_at" yaml:"last_login_at,omitempty"`
+	CreatedAt   null.Time  `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *oidcIdentityR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L oidcIdentityL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OidcIdentityColumns = struct {
+	ID          string
+	UserID      string
+	Issuer      string
+	Subject     string
+	Email       string
+	LastLoginAt string
+	CreatedAt   string
+}{
+	ID:          "id",
+	UserID:      "user_id",
+	Issuer:      "issuer",
+	Subject:     "subject",
+	Email:       "email",
+	LastLoginAt: "last_login_at",
+	CreatedAt:   "created_at",
+}
+
+var OidcIdentityTableColumns = struct {
+	ID          string
+	UserID      string
+	Issuer      string
+	Subject     string
+	Email       string
+	LastLoginAt string
+	CreatedAt   string
+}{
+	ID:          "oidc_identities.id",
+	UserID:      "oidc_identities.user_id",
+	Issuer:      "oidc_identities.issuer",
+	Subject:     "oidc_identities.subject",
+	Email:       "oidc_identities.email",
+	LastLoginAt: "oidc_identities.last_login_at",
+	CreatedAt:   "oidc_identities.created_at",
+}
+
+// Generated where
+
+var OidcIdentityWhere = struct {
+	ID          whereHelpernull_Int64
+	UserID      whereHelperint64
+	Issuer      whereHelperstring
+	Subject     whereHelperstring
+	Email       whereHelperstring
+	LastLoginAt whereHelpernull_Time
+	CreatedAt   whereHelpernull_Time
+}{
+	ID:          whereHelpernull_Int64{field: "\"oidc_identities\".\"id\""},
+	UserID:      whereHelperint64{field: "\"oidc_identities\".\"user_id\""},
+	Issuer:      whereHelperstring{field: "\"oidc_identities\".\"issuer\""},
+	Subject:     whereHelperstring{field: "\"oidc_identities\".\"subject\""},
+	Email:       whereHelperstring{field: "\"oidc_identities\".\"email\""},
+	LastLoginAt: whereHelpernull_Time{field: "\"oidc_identities\".\"last_login_at\""},
+	CreatedAt:   whereHelpernull_Time{field: "\"oidc_identities\".\"created_at\""},
+}
+
+// OidcIdentityRels is where relationship names are stored.
+var OidcIdentityRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// oidcIdentityR is where relationships are stored.
+type oidcIdentityR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*oidcIdentityR) NewStruct() *oidcIdentityR {
+	return &oidcIdentityR{}
+}
+
+func (r *oidcIdentityR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// oidcIdentityL is where Load methods for each relationship are stored.
+type oidcIdentityL struct{}
+
+var (
+	oidcIdentityAllColumns            = []string{"id", "user_id", "issuer", "subject", "email", "last_login_at", "created_at"}
+	oidcIdentityColumnsWithoutDefault = []string{"user_id", "issuer", "subject", "email"}
+	oidcIdentityColumnsWithDefault    = []string{"id", "last_login_at", "created_at"}
+	oidcIdentityPrimaryKeyColumns     = []string{"id"}
+	oidcIdentityGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// OidcIdentitySlice is an alias for a slice of pointers to OidcIdentity.
+	// This should almost always be used instead of []OidcIdentity.
+	OidcIdentitySlice []*OidcIdentity
+	// OidcIdentityHook is the signature for custom OidcIdentity hook methods
+	OidcIdentityHook func(context.Context, boil.ContextExecutor, *OidcIdentity) error
+
+	oidcIdentityQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	oidcIdentityType                 = reflect.TypeOf(&OidcIdentity{})
+	oidcIdentityMapping              = queries.MakeStructMapping(oidcIdentityType)
+	oidcIdentityPrimaryKeyMapping, _ = queries.BindMapping(oidcIdentityType, oidcIdentityMapping, oidcIdentityPrimaryKeyColumns)
+	oidcIdentityInsertCacheMut       sync.RWMutex
+	oidcIdentityInsertCache          = make(map[string]insertCache)
+	oidcIdentityUpdateCacheMut       sync.RWMutex
+	oidcIdentityUpdateCache          = make(map[string]updateCache)
+	oidcIdentityUpsertCacheMut       sync.RWMutex
+	oidcIdentityUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var oidcIdentityAfterSelectMu sync.Mutex
+var oidcIdentityAfterSelectHooks []OidcIdentityHook
+
+var oidcIdentityBeforeInsertMu sync.Mutex
+var oidcIdentityBeforeInsertHooks []OidcIdentityHook
+var oidcIdentityAfterInsertMu sync.Mutex
+var oidcIdentityAfterInsertHooks []OidcIdentityHook
+
+var oidcIdentityBeforeUpdateMu sync.Mutex
+var oidcIdentityBeforeUpdateHooks []OidcIdentityHook
+var oidcIdentityAfterUpdateMu sync.Mutex
+var oidcIdentityAfterUpdateHooks []OidcIdentityHook
+
+var oidcIdentityBeforeDeleteMu sync.Mutex
+var oidcIdentityBeforeDeleteHooks []OidcIdentityHook
+var oidcIdentityAfterDeleteMu sync.Mutex
+var oidcIdentityAfterDeleteHooks []OidcIdentityHook
+
+var oidcIdentityBeforeUpsertMu sync.Mutex
+var oidcIdentityBeforeUpsertHooks []OidcIdentityHook
+var oidcIdentityAfterUpsertMu sync.Mutex
+var oidcIdentityAfterUpsertHooks []OidcIdentityHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OidcIdentity) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcIdentityAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OidcIdentity) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcIdentityBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OidcIdentity) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcIdentityAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OidcIdentity) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcIdentityBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OidcIdentity) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcIdentityAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OidcIdentity) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcIdentityBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OidcIdentity) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcIdentityAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OidcIdentity) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcIdentityBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OidcIdentity) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcIdentityAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOidcIdentityHook registers your hook function for all future operations.
+func AddOidcIdentityHook(hookPoint boil.HookPoint, oidcIdentityHook OidcIdentityHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		oidcIdentityAfterSelectMu.Lock()
+		oidcIdentityAfterSelectHooks = append(oidcIdentityAfterSelectHooks, oidcIdentityHook)
+		oidcIdentityAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		oidcIdentityBeforeInsertMu.Lock()
+		oidcIdentityBeforeInsertHooks = append(oidcIdentityBeforeInsertHooks, oidcIdentityHook)
+		oidcIdentityBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		oidcIdentityAfterInsertMu.Lock()
+		oidcIdentityAfterInsertHooks = append(oidcIdentityAfterInsertHooks, oidcIdentityHook)
+		oidcIdentityAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		oidcIdentityBeforeUpdateMu.Lock()
+		oidcIdentityBeforeUpdateHooks = append(oidcIdentityBeforeUpdateHooks, oidcIdentityHook)
+		oidcIdentityBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		oidcIdentityAfterUpdateMu.Lock()
+		oidcIdentityAfterUpdateHooks = append(oidcIdentityAfterUpdateHooks, oidcIdentityHook)
+		oidcIdentityAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		oidcIdentityBeforeDeleteMu.Lock()
+		oidcIdentityBeforeDeleteHooks = append(oidcIdentityBeforeDeleteHooks, oidcIdentityHook)
+		oidcIdentityBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		oidcIdentityAfterDeleteMu.Lock()
+		oidcIdentityAfterDeleteHooks = append(oidcIdentityAfterDeleteHooks, oidcIdentityHook)
+		oidcIdentityAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		oidcIdentityBeforeUpsertMu.Lock()
+		oidcIdentityBeforeUpsertHooks = append(oidcIdentityBeforeUpsertHooks, oidcIdentityHook)
+		oidcIdentityBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		oidcIdentityAfterUpsertMu.Lock()
+		oidcIdentityAfterUpsertHooks = append(oidcIdentityAfterUpsertHooks, oidcIdentityHook)
+		oidcIdentityAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single oidcIdentity record from the query.
+func (q oidcIdentityQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OidcIdentity, error) {
+	o := &OidcIdentity{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for oidc_identities")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OidcIdentity records from the query.
+func (q oidcIdentityQuery) All(ctx context.Context, exec boil.ContextExecutor) (OidcIdentitySlice, error) {
+	var o []*OidcIdentity
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to OidcIdentity slice")
+	}
+
+	if len(oidcIdentityAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OidcIdentity records in the query.
+func (q oidcIdentityQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count oidc_identities rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q oidcIdentityQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if oidc_identities exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *OidcIdentity) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oidcIdentityL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOidcIdentity interface{}, mods queries.Applicator) error {
+	var slice []*OidcIdentity
+	var object *OidcIdentity
+
+	if singular {
+		var ok bool
+		object, ok = maybeOidcIdentity.(*OidcIdentity)
+		if !ok {
+			object = new(OidcIdentity)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOidcIdentity)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOidcIdentity))
+			}
+		}
+	} else {
+		s, ok := maybeOidcIdentity.(*[]*OidcIdentity)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOidcIdentity)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOidcIdentity))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &oidcIdentityR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args[object.UserID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oidcIdentityR{}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args[obj.UserID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.OidcIdentities = append(foreign.R.OidcIdentities, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.OidcIdentities = append(foreign.R.OidcIdentities, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the oidcIdentity to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.OidcIdentities.
+func (o *OidcIdentity) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"oidc_identities\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 0, oidcIdentityPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserID, related.ID)
+	if o.R == nil {
+		o.R = &oidcIdentityR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OidcIdentities: OidcIdentitySlice{o},
+		}
+	} else {
+		related.R.OidcIdentities = append(related.R.OidcIdentities, o)
+	}
+
+	return nil
+}
+
+// OidcIdentities retrieves all the records using an executor.
+func OidcIdentities(mods ...qm.QueryMod) oidcIdentityQuery {
+	mods = append(mods, qm.From("\"oidc_identities\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"oidc_identities\".*"})
+	}
+
+	return oidcIdentityQuery{q}
+}
+
+// FindOidcIdentity retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOidcIdentity(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*OidcIdentity, error) {
+	oidcIdentityObj := &OidcIdentity{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"oidc_identities\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, oidcIdentityObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from oidc_identities")
+	}
+
+	if err = oidcIdentityObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return oidcIdentityObj, err
+	}
+
+	return oidcIdentityObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OidcIdentity) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no oidc_identities provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oidcIdentityColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	oidcIdentityInsertCacheMut.RLock()
+	cache, cached := oidcIdentityInsertCache[key]
+	oidcIdentityInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			oidcIdentityAllColumns,
+			oidcIdentityColumnsWithDefault,
+			oidcIdentityColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, oidcIdentityGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(oidcIdentityType, oidcIdentityMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(oidcIdentityType, oidcIdentityMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"oidc_identities\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"oidc_identities\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into oidc_identities")
+	}
+
+	if !cached {
+		oidcIdentityInsertCacheMut.Lock()
+		oidcIdentityInsertCache[key] = cache
+		oidcIdentityInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OidcIdentity.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OidcIdentity) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	oidcIdentityUpdateCacheMut.RLock()
+	cache, cached := oidcIdentityUpdateCache[key]
+	oidcIdentityUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			oidcIdentityAllColumns,
+			oidcIdentityPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, oidcIdentityGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update oidc_identities, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"oidc_identities\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, oidcIdentityPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(oidcIdentityType, oidcIdentityMapping, append(wl, oidcIdentityPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update oidc_identities row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for oidc_identities")
+	}
+
+	if !cached {
+		oidcIdentityUpdateCacheMut.Lock()
+		oidcIdentityUpdateCache[key] = cache
+		oidcIdentityUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q oidcIdentityQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for oidc_identities")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for oidc_identities")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OidcIdentitySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcIdentityPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"oidc_identities\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, oidcIdentityPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in oidcIdentity slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all oidcIdentity")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OidcIdentity) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no oidc_identities provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oidcIdentityColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	oidcIdentityUpsertCacheMut.RLock()
+	cache, cached := oidcIdentityUpsertCache[key]
+	oidcIdentityUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			oidcIdentityAllColumns,
+			oidcIdentityColumnsWithDefault,
+			oidcIdentityColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			oidcIdentityAllColumns,
+			oidcIdentityPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert oidc_identities, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(oidcIdentityAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(oidcIdentityPrimaryKeyColumns))
+			copy(conflict, oidcIdentityPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"oidc_identities\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(oidcIdentityType, oidcIdentityMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(oidcIdentityType, oidcIdentityMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert oidc_identities")
+	}
+
+	if !cached {
+		oidcIdentityUpsertCacheMut.Lock()
+		oidcIdentityUpsertCache[key] = cache
+		oidcIdentityUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single OidcIdentity record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OidcIdentity) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no OidcIdentity provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), oidcIdentityPrimaryKeyMapping)
+	sql := "DELETE FROM \"oidc_identities\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from oidc_identities")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for oidc_identities")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q oidcIdentityQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no oidcIdentityQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oidc_identities")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oidc_identities")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OidcIdentitySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(oidcIdentityBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcIdentityPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"oidc_identities\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, oidcIdentityPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oidcIdentity slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oidc_identities")
+	}
+
+	if len(oidcIdentityAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OidcIdentity) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOidcIdentity(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OidcIdentitySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OidcIdentitySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcIdentityPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"oidc_identities\".* FROM \"oidc_identities\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, oidcIdentityPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in OidcIdentitySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OidcIdentityExists checks if the OidcIdentity row exists.
+func OidcIdentityExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"oidc_identities\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if oidc_identities exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the OidcIdentity row exists.
+func (o *OidcIdentity) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return OidcIdentityExists(ctx, exec, o.ID)
+}
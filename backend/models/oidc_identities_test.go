@@ -0,0 +1,851 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOidcIdentities(t *testing.T) {
+	t.Parallel()
+
+	query := OidcIdentities()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOidcIdentitiesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcIdentity{}
+	if err = randomize.Struct(seed, o, oidcIdentityDBTypes, true, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OidcIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOidcIdentitiesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcIdentity{}
+	if err = randomize.Struct(seed, o, oidcIdentityDBTypes, true, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OidcIdentities().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OidcIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOidcIdentitiesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcIdentity{}
+	if err = randomize.Struct(seed, o, oidcIdentityDBTypes, true, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OidcIdentitySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OidcIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOidcIdentitiesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcIdentity{}
+	if err = randomize.Struct(seed, o, oidcIdentityDBTypes, true, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OidcIdentityExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if OidcIdentity exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OidcIdentityExists to return true, but got false.")
+	}
+}
+
+func testOidcIdentitiesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcIdentity{}
+	if err = randomize.Struct(seed, o, oidcIdentityDBTypes, true, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	oidcIdentityFound, err := FindOidcIdentity(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if oidcIdentityFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOidcIdentitiesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcIdentity{}
+	if err = randomize.Struct(seed, o, oidcIdentityDBTypes, true, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OidcIdentities().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOidcIdentitiesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcIdentity{}
+	if err = randomize.Struct(seed, o, oidcIdentityDBTypes, true, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OidcIdentities().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOidcIdentitiesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	oidcIdentityOne := &OidcIdentity{}
+	oidcIdentityTwo := &OidcIdentity{}
+	if err = randomize.Struct(seed, oidcIdentityOne, oidcIdentityDBTypes, false, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oidcIdentityTwo, oidcIdentityDBTypes, false, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oidcIdentityOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oidcIdentityTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OidcIdentities().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOidcIdentitiesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	oidcIdentityOne := &OidcIdentity{}
+	oidcIdentityTwo := &OidcIdentity{}
+	if err = randomize.Struct(seed, oidcIdentityOne, oidcIdentityDBTypes, false, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oidcIdentityTwo, oidcIdentityDBTypes, false, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oidcIdentityOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oidcIdentityTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func oidcIdentityBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *OidcIdentity) error {
+	*o = OidcIdentity{}
+	return nil
+}
+
+func oidcIdentityAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *OidcIdentity) error {
+	*o = OidcIdentity{}
+	return nil
+}
+
+func oidcIdentityAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *OidcIdentity) error {
+	*o = OidcIdentity{}
+	return nil
+}
+
+func oidcIdentityBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OidcIdentity) error {
+	*o = OidcIdentity{}
+	return nil
+}
+
+func oidcIdentityAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OidcIdentity) error {
+	*o = OidcIdentity{}
+	return nil
+}
+
+func oidcIdentityBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OidcIdentity) error {
+	*o = OidcIdentity{}
+	return nil
+}
+
+func oidcIdentityAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OidcIdentity) error {
+	*o = OidcIdentity{}
+	return nil
+}
+
+func oidcIdentityBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OidcIdentity) error {
+	*o = OidcIdentity{}
+	return nil
+}
+
+func oidcIdentityAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OidcIdentity) error {
+	*o = OidcIdentity{}
+	return nil
+}
+
+func testOidcIdentitiesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &OidcIdentity{}
+	o := &OidcIdentity{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, oidcIdentityDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity object: %s", err)
+	}
+
+	AddOidcIdentityHook(boil.BeforeInsertHook, oidcIdentityBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	oidcIdentityBeforeInsertHooks = []OidcIdentityHook{}
+
+	AddOidcIdentityHook(boil.AfterInsertHook, oidcIdentityAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	oidcIdentityAfterInsertHooks = []OidcIdentityHook{}
+
+	AddOidcIdentityHook(boil.AfterSelectHook, oidcIdentityAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	oidcIdentityAfterSelectHooks = []OidcIdentityHook{}
+
+	AddOidcIdentityHook(boil.BeforeUpdateHook, oidcIdentityBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	oidcIdentityBeforeUpdateHooks = []OidcIdentityHook{}
+
+	AddOidcIdentityHook(boil.AfterUpdateHook, oidcIdentityAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	oidcIdentityAfterUpdateHooks = []OidcIdentityHook{}
+
+	AddOidcIdentityHook(boil.BeforeDeleteHook, oidcIdentityBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	oidcIdentityBeforeDeleteHooks = []OidcIdentityHook{}
+
+	AddOidcIdentityHook(boil.AfterDeleteHook, oidcIdentityAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	oidcIdentityAfterDeleteHooks = []OidcIdentityHook{}
+
+	AddOidcIdentityHook(boil.BeforeUpsertHook, oidcIdentityBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	oidcIdentityBeforeUpsertHooks = []OidcIdentityHook{}
+
+	AddOidcIdentityHook(boil.AfterUpsertHook, oidcIdentityAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	oidcIdentityAfterUpsertHooks = []OidcIdentityHook{}
+}
+
+func testOidcIdentitiesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcIdentity{}
+	if err = randomize.Struct(seed, o, oidcIdentityDBTypes, true, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOidcIdentitiesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcIdentity{}
+	if err = randomize.Struct(seed, o, oidcIdentityDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(oidcIdentityColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOidcIdentityToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OidcIdentity
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, oidcIdentityDBTypes, false, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.UserID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := OidcIdentitySlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*OidcIdentity)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testOidcIdentityToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OidcIdentity
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oidcIdentityDBTypes, false, strmangle.SetComplement(oidcIdentityPrimaryKeyColumns, oidcIdentityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OidcIdentities[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testOidcIdentitiesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcIdentity{}
+	if err = randomize.Struct(seed, o, oidcIdentityDBTypes, true, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOidcIdentitiesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcIdentity{}
+	if err = randomize.Struct(seed, o, oidcIdentityDBTypes, true, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OidcIdentitySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOidcIdentitiesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcIdentity{}
+	if err = randomize.Struct(seed, o, oidcIdentityDBTypes, true, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OidcIdentities().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	oidcIdentityDBTypes = map[string]string{`ID`: `INTEGER`, `UserID`: `INTEGER`, `Issuer`: `TEXT`, `Subject`: `TEXT`, `Email`: `TEXT`, `LastLoginAt`: `DATETIME`, `CreatedAt`: `DATETIME`}
+	_                   = bytes.MinRead
+)
+
+func testOidcIdentitiesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(oidcIdentityPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(oidcIdentityAllColumns) == len(oidcIdentityPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcIdentity{}
+	if err = randomize.Struct(seed, o, oidcIdentityDBTypes, true, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oidcIdentityDBTypes, true, oidcIdentityPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOidcIdentitiesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(oidcIdentityAllColumns) == len(oidcIdentityPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcIdentity{}
+	if err = randomize.Struct(seed, o, oidcIdentityDBTypes, true, oidcIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oidcIdentityDBTypes, true, oidcIdentityPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(oidcIdentityAllColumns, oidcIdentityPrimaryKeyColumns) {
+		fields = oidcIdentityAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			oidcIdentityAllColumns,
+			oidcIdentityPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, oidcIdentityGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OidcIdentitySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOidcIdentitiesUpsert(t *testing.T) {
+	t.Parallel()
+	if len(oidcIdentityAllColumns) == len(oidcIdentityPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := OidcIdentity{}
+	if err = randomize.Struct(seed, &o, oidcIdentityDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OidcIdentity: %s", err)
+	}
+
+	count, err := OidcIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, oidcIdentityDBTypes, false, oidcIdentityPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OidcIdentity struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OidcIdentity: %s", err)
+	}
+
+	count, err = OidcIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1175 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// OrganizationChannel is an object representing the database table.
+type OrganizationChannel struct {
+	ID                null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	OrganizationID    int64      `boil:"organization_id" json:"organization_id" toml:"organization_id" yaml:"organization_id"`
+	Name              string     `boil:"name" json:"name" toml:"name" yaml:"name"`
+	TelegramBotAPIKey string     `boil:"telegram_bot_api_key" json:"telegram_bot_api_key" toml:"telegram_bot_api_key" yaml:"telegram_bot_api_key"`
+	TelegramChatID    string     `boil:"telegram_chat_id" json:"telegram_chat_id" toml:"telegram_chat_id" yaml:"telegram_chat_id"`
+	ReminderTime      string     `boil:"reminder_time" json:"reminder_time" toml:"reminder_time" yaml:"reminder_time"`
+	LeadDays          int64      `boil:"lead_days" json:"lead_days" toml:"lead_days" yaml:"lead_days"`
+	CreatedAt         null.Time  `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *organizationChannelR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L organizationChannelL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OrganizationChannelColumns = struct {
+	ID                string
+	OrganizationID    string
+	Name              string
+	TelegramBotAPIKey string
+	TelegramChatID    string
+	ReminderTime      string
+	LeadDays          string
+	CreatedAt         string
+}{
+	ID:                "id",
+	OrganizationID:    "organization_id",
+	Name:              "name",
+	TelegramBotAPIKey: "telegram_bot_api_key",
+	TelegramChatID:    "telegram_chat_id",
+	ReminderTime:      "reminder_time",
+	LeadDays:          "lead_days",
+	CreatedAt:         "created_at",
+}
+
+var OrganizationChannelTableColumns = struct {
+	ID                string
+	OrganizationID    string
+	Name              string
+	TelegramBotAPIKey string
+	TelegramChatID    string
+	ReminderTime      string
+	LeadDays          string
+	CreatedAt         string
+}{
+	ID:                "organization_channels.id",
+	OrganizationID:    "organization_channels.organization_id",
+	Name:              "organization_channels.name",
+	TelegramBotAPIKey: "organization_channels.telegram_bot_api_key",
+	TelegramChatID:    "organization_channels.telegram_chat_id",
+	ReminderTime:      "organization_channels.reminder_time",
+	LeadDays:          "organization_channels.lead_days",
+	CreatedAt:         "organization_channels.created_at",
+}
+
+// Generated where
+
+var OrganizationChannelWhere = struct {
+	ID                whereHelpernull_Int64
+	OrganizationID    whereHelperint64
+	Name              whereHelperstring
+	TelegramBotAPIKey whereHelperstring
+	TelegramChatID    whereHelperstring
+	ReminderTime      whereHelperstring
+	LeadDays          whereHelperint64
+	CreatedAt         whereHelpernull_Time
+}{
+	ID:                whereHelpernull_Int64{field: "\"organization_channels\".\"id\""},
+	OrganizationID:    whereHelperint64{field: "\"organization_channels\".\"organization_id\""},
+	Name:              whereHelperstring{field: "\"organization_channels\".\"name\""},
+	TelegramBotAPIKey: whereHelperstring{field: "\"organization_channels\".\"telegram_bot_api_key\""},
+	TelegramChatID:    whereHelperstring{field: "\"organization_channels\".\"telegram_chat_id\""},
+	ReminderTime:      whereHelperstring{field: "\"organization_channels\".\"reminder_time\""},
+	LeadDays:          whereHelperint64{field: "\"organization_channels\".\"lead_days\""},
+	CreatedAt:         whereHelpernull_Time{field: "\"organization_channels\".\"created_at\""},
+}
+
+// OrganizationChannelRels is where relationship names are stored.
+var OrganizationChannelRels = struct {
+	Organization string
+}{
+	Organization: "Organization",
+}
+
+// organizationChannelR is where relationships are stored.
+type organizationChannelR struct {
+	Organization *Organization `boil:"Organization" json:"Organization" toml:"Organization" yaml:"Organization"`
+}
+
+// NewStruct creates a new relationship struct
+func (*organizationChannelR) NewStruct() *organizationChannelR {
+	return &organizationChannelR{}
+}
+
+func (r *organizationChannelR) GetOrganization() *Organization {
+	if r == nil {
+		return nil
+	}
+	return r.Organization
+}
+
+// organizationChannelL is where Load methods for each relationship are stored.
+type organizationChannelL struct{}
+
+var (
+	organizationChannelAllColumns            = []string{"id", "organization_id", "name", "telegram_bot_api_key", "telegram_chat_id", "reminder_time", "lead_days", "created_at"}
+	organizationChannelColumnsWithoutDefault = []string{"organization_id", "name", "telegram_bot_api_key", "telegram_chat_id", "reminder_time"}
+	organizationChannelColumnsWithDefault    = []string{"id", "lead_days", "created_at"}
+	organizationChannelPrimaryKeyColumns     = []string{"id"}
+	organizationChannelGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// OrganizationChannelSlice is an alias for a slice of pointers to OrganizationChannel.
+	// This should almost always be used instead of []OrganizationChannel.
+	OrganizationChannelSlice []*OrganizationChannel
+	// OrganizationChannelHook is the signature for custom OrganizationChannel hook methods
+	OrganizationChannelHook func(context.Context, boil.ContextExecutor, *OrganizationChannel) error
+
+	organizationChannelQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	organizationChannelType                 = reflect.TypeOf(&OrganizationChannel{})
+	organizationChannelMapping              = queries.MakeStructMapping(organizationChannelType)
+	organizationChannelPrimaryKeyMapping, _ = queries.BindMapping(organizationChannelType, organizationChannelMapping, organizationChannelPrimaryKeyColumns)
+	organizationChannelInsertCacheMut       sync.RWMutex
+	organizationChannelInsertCache          = make(map[string]insertCache)
+	organizationChannelUpdateCacheMut       sync.RWMutex
+	organizationChannelUpdateCache          = make(map[string]updateCache)
+	organizationChannelUpsertCacheMut       sync.RWMutex
+	organizationChannelUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var organizationChannelAfterSelectMu sync.Mutex
+var organizationChannelAfterSelectHooks []OrganizationChannelHook
+
+var organizationChannelBeforeInsertMu sync.Mutex
+var organizationChannelBeforeInsertHooks []OrganizationChannelHook
+var organizationChannelAfterInsertMu sync.Mutex
+var organizationChannelAfterInsertHooks []OrganizationChannelHook
+
+var organizationChannelBeforeUpdateMu sync.Mutex
+var organizationChannelBeforeUpdateHooks []OrganizationChannelHook
+var organizationChannelAfterUpdateMu sync.Mutex
+var organizationChannelAfterUpdateHooks []OrganizationChannelHook
+
+var organizationChannelBeforeDeleteMu sync.Mutex
+var organizationChannelBeforeDeleteHooks []OrganizationChannelHook
+var organizationChannelAfterDeleteMu sync.Mutex
+var organizationChannelAfterDeleteHooks []OrganizationChannelHook
+
+var organizationChannelBeforeUpsertMu sync.Mutex
+var organizationChannelBeforeUpsertHooks []OrganizationChannelHook
+var organizationChannelAfterUpsertMu sync.Mutex
+var organizationChannelAfterUpsertHooks []OrganizationChannelHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OrganizationChannel) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range organizationChannelAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OrganizationChannel) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range organizationChannelBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OrganizationChannel) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range organizationChannelAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OrganizationChannel) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range organizationChannelBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OrganizationChannel) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range organizationChannelAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OrganizationChannel) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range organizationChannelBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OrganizationChannel) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range organizationChannelAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OrganizationChannel) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range organizationChannelBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OrganizationChannel) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range organizationChannelAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOrganizationChannelHook registers your hook function for all future operations.
+func AddOrganizationChannelHook(hookPoint boil.HookPoint, organizationChannelHook OrganizationChannelHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		organizationChannelAfterSelectMu.Lock()
+		organizationChannelAfterSelectHooks = append(organizationChannelAfterSelectHooks, organizationChannelHook)
+		organizationChannelAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		organizationChannelBeforeInsertMu.Lock()
+		organizationChannelBeforeInsertHooks = append(organizationChannelBeforeInsertHooks, organizationChannelHook)
+		organizationChannelBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		organizationChannelAfterInsertMu.Lock()
+		organizationChannelAfterInsertHooks = append(organizationChannelAfterInsertHooks, organizationChannelHook)
+		organizationChannelAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		organizationChannelBeforeUpdateMu.Lock()
+		organizationChannelBeforeUpdateHooks = append(organizationChannelBeforeUpdateHooks, organizationChannelHook)
+		organizationChannelBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		organizationChannelAfterUpdateMu.Lock()
+		organizationChannelAfterUpdateHooks = append(organizationChannelAfterUpdateHooks, organizationChannelHook)
+		organizationChannelAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		organizationChannelBeforeDeleteMu.Lock()
+		organizationChannelBeforeDeleteHooks = append(organizationChannelBeforeDeleteHooks, organizationChannelHook)
+		organizationChannelBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		organizationChannelAfterDeleteMu.Lock()
+		organizationChannelAfterDeleteHooks = append(organizationChannelAfterDeleteHooks, organizationChannelHook)
+		organizationChannelAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		organizationChannelBeforeUpsertMu.Lock()
+		organizationChannelBeforeUpsertHooks = append(organizationChannelBeforeUpsertHooks, organizationChannelHook)
+		organizationChannelBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		organizationChannelAfterUpsertMu.Lock()
+		organizationChannelAfterUpsertHooks = append(organizationChannelAfterUpsertHooks, organizationChannelHook)
+		organizationChannelAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single organizationChannel record from the query.
+func (q organizationChannelQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OrganizationChannel, error) {
+	o := &OrganizationChannel{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for organization_channels")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OrganizationChannel records from the query.
+func (q organizationChannelQuery) All(ctx context.Context, exec boil.ContextExecutor) (OrganizationChannelSlice, error) {
+	var o []*OrganizationChannel
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to OrganizationChannel slice")
+	}
+
+	if len(organizationChannelAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OrganizationChannel records in the query.
+func (q organizationChannelQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count organization_channels rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q organizationChannelQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if organization_channels exists")
+	}
+
+	return count > 0, nil
+}
+
+// Organization pointed to by the foreign key.
+func (o *OrganizationChannel) Organization(mods ...qm.QueryMod) organizationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OrganizationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Organizations(queryMods...)
+}
+
+// LoadOrganization allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (organizationChannelL) LoadOrganization(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganizationChannel interface{}, mods queries.Applicator) error {
+	var slice []*OrganizationChannel
+	var object *OrganizationChannel
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganizationChannel.(*OrganizationChannel)
+		if !ok {
+			object = new(OrganizationChannel)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganizationChannel)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganizationChannel))
+			}
+		}
+	} else {
+		s, ok := maybeOrganizationChannel.(*[]*OrganizationChannel)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganizationChannel)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganizationChannel))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationChannelR{}
+		}
+		if !queries.IsNil(object.OrganizationID) {
+			args[object.OrganizationID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationChannelR{}
+			}
+
+			if !queries.IsNil(obj.OrganizationID) {
+				args[obj.OrganizationID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organizations`),
+		qm.WhereIn(`organizations.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Organization")
+	}
+
+	var resultSlice []*Organization
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Organization")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for organizations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organizations")
+	}
+
+	if len(organizationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Organization = foreign
+		if foreign.R == nil {
+			foreign.R = &organizationR{}
+		}
+		foreign.R.OrganizationChannels = append(foreign.R.OrganizationChannels, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.OrganizationID, foreign.ID) {
+				local.R.Organization = foreign
+				if foreign.R == nil {
+					foreign.R = &organizationR{}
+				}
+				foreign.R.OrganizationChannels = append(foreign.R.OrganizationChannels, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOrganization of the organizationChannel to the related item.
+// Sets o.R.Organization to related.
+// Adds o to related.R.OrganizationChannels.
+func (o *OrganizationChannel) SetOrganization(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Organization) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"organization_channels\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"organization_id"}),
+		strmangle.WhereClause("\"", "\"", 0, organizationChannelPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.OrganizationID, related.ID)
+	if o.R == nil {
+		o.R = &organizationChannelR{
+			Organization: related,
+		}
+	} else {
+		o.R.Organization = related
+	}
+
+	if related.R == nil {
+		related.R = &organizationR{
+			OrganizationChannels: OrganizationChannelSlice{o},
+		}
+	} else {
+		related.R.OrganizationChannels = append(related.R.OrganizationChannels, o)
+	}
+
+	return nil
+}
+
+// OrganizationChannels retrieves all the records using an executor.
+func OrganizationChannels(mods ...qm.QueryMod) organizationChannelQuery {
+	mods = append(mods, qm.From("\"organization_channels\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"organization_channels\".*"})
+	}
+
+	return organizationChannelQuery{q}
+}
+
+// FindOrganizationChannel retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOrganizationChannel(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*OrganizationChannel, error) {
+	organizationChannelObj := &OrganizationChannel{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"organization_channels\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, organizationChannelObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from organization_channels")
+	}
+
+	if err = organizationChannelObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return organizationChannelObj, err
+	}
+
+	return organizationChannelObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OrganizationChannel) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no organization_channels provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(organizationChannelColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	organizationChannelInsertCacheMut.RLock()
+	cache, cached := organizationChannelInsertCache[key]
+	organizationChannelInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			organizationChannelAllColumns,
+			organizationChannelColumnsWithDefault,
+			organizationChannelColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, organizationChannelGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(organizationChannelType, organizationChannelMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(organizationChannelType, organizationChannelMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"organization_channels\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"organization_channels\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into organization_channels")
+	}
+
+	if !cached {
+		organizationChannelInsertCacheMut.Lock()
+		organizationChannelInsertCache[key] = cache
+		organizationChannelInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OrganizationChannel.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OrganizationChannel) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	organizationChannelUpdateCacheMut.RLock()
+	cache, cached := organizationChannelUpdateCache[key]
+	organizationChannelUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			organizationChannelAllColumns,
+			organizationChannelPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, organizationChannelGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update organization_channels, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"organization_channels\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, organizationChannelPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(organizationChannelType, organizationChannelMapping, append(wl, organizationChannelPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update organization_channels row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for organization_channels")
+	}
+
+	if !cached {
+		organizationChannelUpdateCacheMut.Lock()
+		organizationChannelUpdateCache[key] = cache
+		organizationChannelUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q organizationChannelQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for organization_channels")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for organization_channels")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OrganizationChannelSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), organizationChannelPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"organization_channels\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, organizationChannelPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in organizationChannel slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all organizationChannel")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OrganizationChannel) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no organization_channels provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(organizationChannelColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	organizationChannelUpsertCacheMut.RLock()
+	cache, cached := organizationChannelUpsertCache[key]
+	organizationChannelUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			organizationChannelAllColumns,
+			organizationChannelColumnsWithDefault,
+			organizationChannelColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			organizationChannelAllColumns,
+			organizationChannelPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert organization_channels, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(organizationChannelAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(organizationChannelPrimaryKeyColumns))
+			copy(conflict, organizationChannelPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"organization_channels\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(organizationChannelType, organizationChannelMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(organizationChannelType, organizationChannelMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert organization_channels")
+	}
+
+	if !cached {
+		organizationChannelUpsertCacheMut.Lock()
+		organizationChannelUpsertCache[key] = cache
+		organizationChannelUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single OrganizationChannel record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OrganizationChannel) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no OrganizationChannel provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), organizationChannelPrimaryKeyMapping)
+	sql := "DELETE FROM \"organization_channels\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from organization_channels")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for organization_channels")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q organizationChannelQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no organizationChannelQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from organization_channels")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for organization_channels")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OrganizationChannelSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(organizationChannelBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), organizationChannelPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"organization_channels\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, organizationChannelPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from organizationChannel slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for organization_channels")
+	}
+
+	if len(organizationChannelAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OrganizationChannel) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOrganizationChannel(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OrganizationChannelSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OrganizationChannelSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), organizationChannelPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"organization_channels\".* FROM \"organization_channels\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, organizationChannelPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in OrganizationChannelSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OrganizationChannelExists checks if the OrganizationChannel row exists.
+func OrganizationChannelExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"organization_channels\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if organization_channels exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the OrganizationChannel row exists.
+func (o *OrganizationChannel) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return OrganizationChannelExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,851 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOrganizationChannels(t *testing.T) {
+	t.Parallel()
+
+	query := OrganizationChannels()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOrganizationChannelsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationChannel{}
+	if err = randomize.Struct(seed, o, organizationChannelDBTypes, true, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrganizationChannels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrganizationChannelsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationChannel{}
+	if err = randomize.Struct(seed, o, organizationChannelDBTypes, true, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OrganizationChannels().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrganizationChannels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrganizationChannelsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationChannel{}
+	if err = randomize.Struct(seed, o, organizationChannelDBTypes, true, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrganizationChannelSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrganizationChannels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrganizationChannelsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationChannel{}
+	if err = randomize.Struct(seed, o, organizationChannelDBTypes, true, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OrganizationChannelExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if OrganizationChannel exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OrganizationChannelExists to return true, but got false.")
+	}
+}
+
+func testOrganizationChannelsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationChannel{}
+	if err = randomize.Struct(seed, o, organizationChannelDBTypes, true, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	organizationChannelFound, err := FindOrganizationChannel(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if organizationChannelFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOrganizationChannelsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationChannel{}
+	if err = randomize.Struct(seed, o, organizationChannelDBTypes, true, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OrganizationChannels().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrganizationChannelsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationChannel{}
+	if err = randomize.Struct(seed, o, organizationChannelDBTypes, true, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OrganizationChannels().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOrganizationChannelsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	organizationChannelOne := &OrganizationChannel{}
+	organizationChannelTwo := &OrganizationChannel{}
+	if err = randomize.Struct(seed, organizationChannelOne, organizationChannelDBTypes, false, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+	if err = randomize.Struct(seed, organizationChannelTwo, organizationChannelDBTypes, false, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = organizationChannelOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = organizationChannelTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OrganizationChannels().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOrganizationChannelsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	organizationChannelOne := &OrganizationChannel{}
+	organizationChannelTwo := &OrganizationChannel{}
+	if err = randomize.Struct(seed, organizationChannelOne, organizationChannelDBTypes, false, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+	if err = randomize.Struct(seed, organizationChannelTwo, organizationChannelDBTypes, false, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = organizationChannelOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = organizationChannelTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrganizationChannels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func organizationChannelBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *OrganizationChannel) error {
+	*o = OrganizationChannel{}
+	return nil
+}
+
+func organizationChannelAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *OrganizationChannel) error {
+	*o = OrganizationChannel{}
+	return nil
+}
+
+func organizationChannelAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *OrganizationChannel) error {
+	*o = OrganizationChannel{}
+	return nil
+}
+
+func organizationChannelBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OrganizationChannel) error {
+	*o = OrganizationChannel{}
+	return nil
+}
+
+func organizationChannelAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OrganizationChannel) error {
+	*o = OrganizationChannel{}
+	return nil
+}
+
+func organizationChannelBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OrganizationChannel) error {
+	*o = OrganizationChannel{}
+	return nil
+}
+
+func organizationChannelAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OrganizationChannel) error {
+	*o = OrganizationChannel{}
+	return nil
+}
+
+func organizationChannelBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OrganizationChannel) error {
+	*o = OrganizationChannel{}
+	return nil
+}
+
+func organizationChannelAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OrganizationChannel) error {
+	*o = OrganizationChannel{}
+	return nil
+}
+
+func testOrganizationChannelsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &OrganizationChannel{}
+	o := &OrganizationChannel{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, organizationChannelDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel object: %s", err)
+	}
+
+	AddOrganizationChannelHook(boil.BeforeInsertHook, organizationChannelBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	organizationChannelBeforeInsertHooks = []OrganizationChannelHook{}
+
+	AddOrganizationChannelHook(boil.AfterInsertHook, organizationChannelAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	organizationChannelAfterInsertHooks = []OrganizationChannelHook{}
+
+	AddOrganizationChannelHook(boil.AfterSelectHook, organizationChannelAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	organizationChannelAfterSelectHooks = []OrganizationChannelHook{}
+
+	AddOrganizationChannelHook(boil.BeforeUpdateHook, organizationChannelBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	organizationChannelBeforeUpdateHooks = []OrganizationChannelHook{}
+
+	AddOrganizationChannelHook(boil.AfterUpdateHook, organizationChannelAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	organizationChannelAfterUpdateHooks = []OrganizationChannelHook{}
+
+	AddOrganizationChannelHook(boil.BeforeDeleteHook, organizationChannelBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	organizationChannelBeforeDeleteHooks = []OrganizationChannelHook{}
+
+	AddOrganizationChannelHook(boil.AfterDeleteHook, organizationChannelAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	organizationChannelAfterDeleteHooks = []OrganizationChannelHook{}
+
+	AddOrganizationChannelHook(boil.BeforeUpsertHook, organizationChannelBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	organizationChannelBeforeUpsertHooks = []OrganizationChannelHook{}
+
+	AddOrganizationChannelHook(boil.AfterUpsertHook, organizationChannelAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	organizationChannelAfterUpsertHooks = []OrganizationChannelHook{}
+}
+
+func testOrganizationChannelsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationChannel{}
+	if err = randomize.Struct(seed, o, organizationChannelDBTypes, true, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrganizationChannels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrganizationChannelsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationChannel{}
+	if err = randomize.Struct(seed, o, organizationChannelDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(organizationChannelColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrganizationChannels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrganizationChannelToOneOrganizationUsingOrganization(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OrganizationChannel
+	var foreign Organization
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, organizationChannelDBTypes, false, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, organizationDBTypes, true, organizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Organization struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.OrganizationID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Organization().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddOrganizationHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Organization) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := OrganizationChannelSlice{&local}
+	if err = local.L.LoadOrganization(ctx, tx, false, (*[]*OrganizationChannel)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Organization == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Organization = nil
+	if err = local.L.LoadOrganization(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Organization == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testOrganizationChannelToOneSetOpOrganizationUsingOrganization(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OrganizationChannel
+	var b, c Organization
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationChannelDBTypes, false, strmangle.SetComplement(organizationChannelPrimaryKeyColumns, organizationChannelColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Organization{&b, &c} {
+		err = a.SetOrganization(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Organization != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OrganizationChannels[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.OrganizationID, x.ID) {
+			t.Error("foreign key was wrong value", a.OrganizationID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.OrganizationID))
+		reflect.Indirect(reflect.ValueOf(&a.OrganizationID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.OrganizationID, x.ID) {
+			t.Error("foreign key was wrong value", a.OrganizationID, x.ID)
+		}
+	}
+}
+
+func testOrganizationChannelsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationChannel{}
+	if err = randomize.Struct(seed, o, organizationChannelDBTypes, true, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrganizationChannelsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationChannel{}
+	if err = randomize.Struct(seed, o, organizationChannelDBTypes, true, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrganizationChannelSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrganizationChannelsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationChannel{}
+	if err = randomize.Struct(seed, o, organizationChannelDBTypes, true, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OrganizationChannels().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	organizationChannelDBTypes = map[string]string{`ID`: `INTEGER`, `OrganizationID`: `INTEGER`, `Name`: `TEXT`, `TelegramBotAPIKey`: `TEXT`, `TelegramChatID`: `TEXT`, `ReminderTime`: `TEXT`, `LeadDays`: `INTEGER`, `CreatedAt`: `DATETIME`}
+	_                          = bytes.MinRead
+)
+
+func testOrganizationChannelsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(organizationChannelPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(organizationChannelAllColumns) == len(organizationChannelPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationChannel{}
+	if err = randomize.Struct(seed, o, organizationChannelDBTypes, true, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrganizationChannels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, organizationChannelDBTypes, true, organizationChannelPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOrganizationChannelsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(organizationChannelAllColumns) == len(organizationChannelPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationChannel{}
+	if err = randomize.Struct(seed, o, organizationChannelDBTypes, true, organizationChannelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrganizationChannels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, organizationChannelDBTypes, true, organizationChannelPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(organizationChannelAllColumns, organizationChannelPrimaryKeyColumns) {
+		fields = organizationChannelAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			organizationChannelAllColumns,
+			organizationChannelPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, organizationChannelGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OrganizationChannelSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOrganizationChannelsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(organizationChannelAllColumns) == len(organizationChannelPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := OrganizationChannel{}
+	if err = randomize.Struct(seed, &o, organizationChannelDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OrganizationChannel: %s", err)
+	}
+
+	count, err := OrganizationChannels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, organizationChannelDBTypes, false, organizationChannelPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrganizationChannel struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OrganizationChannel: %s", err)
+	}
+
+	count, err = OrganizationChannels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
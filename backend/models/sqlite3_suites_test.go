@@ -6,7 +6,37 @@ package models
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("APITokens", testAPITokensUpsert)
+
+	t.Run("BirthdayGroups", testBirthdayGroupsUpsert)
+
+	t.Run("BirthdayHistories", testBirthdayHistoriesUpsert)
+
+	t.Run("BirthdayTags", testBirthdayTagsUpsert)
+
 	t.Run("Birthdays", testBirthdaysUpsert)
 
+	t.Run("Gifts", testGiftsUpsert)
+
+	t.Run("Invitations", testInvitationsUpsert)
+
+	t.Run("ListMembers", testListMembersUpsert)
+
+	t.Run("ListShares", testListSharesUpsert)
+
+	t.Run("Lists", testListsUpsert)
+
+	t.Run("OidcIdentities", testOidcIdentitiesUpsert)
+
+	t.Run("OrganizationChannels", testOrganizationChannelsUpsert)
+
+	t.Run("OrganizationMembers", testOrganizationMembersUpsert)
+
+	t.Run("Organizations", testOrganizationsUpsert)
+
+	t.Run("RecoveryCodes", testRecoveryCodesUpsert)
+
+	t.Run("Sessions", testSessionsUpsert)
+
 	t.Run("Users", testUsersUpsert)
 }
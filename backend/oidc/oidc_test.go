@@ -0,0 +1,241 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"hbd/oidc/oidctest"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testClientID     = oidctest.ClientID
+	testClientSecret = oidctest.ClientSecret
+	testRedirectURL  = "http://localhost:8417/api/oidc/callback"
+)
+
+// Fixed clock of the tests, the mock provider issues tokens at this time
+var testNow = oidctest.Now
+
+// provider discovers the mock provider with a client whose clock is the given function
+func provider(t *testing.T, m *oidctest.Provider, now func() time.Time, configure func(*Config)) *Provider {
+	config := Config{
+		Issuer:       m.Issuer,
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+		RedirectURL:  testRedirectURL,
+		Now:          now,
+	}
+	if configure != nil {
+		configure(&config)
+	}
+	p, err := NewProvider(context.Background(), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// login goes through the authorization endpoint and returns the code and state of the redirect back to the client
+func login(t *testing.T, m *oidctest.Provider, p *Provider, state, nonce, verifier string) (string, string) {
+	location := m.Authorize(p.AuthCodeURL(state, nonce, verifier))
+	if !strings.HasPrefix(location.String(), testRedirectURL+"?") {
+		t.Fatalf("redirected to %s, want %s", location, testRedirectURL)
+	}
+	return location.Query().Get("code"), location.Query().Get("state")
+}
+
+func fixedClock() time.Time { return testNow }
+
+func TestAuthorizationCodeFlow(t *testing.T) {
+	m := oidctest.NewProvider(t)
+	p := provider(t, m, fixedClock, nil)
+
+	state, nonce, verifier, err := NewFlow()
+	if err != nil {
+		t.Fatal(err)
+	}
+	authURL, _ := url.Parse(p.AuthCodeURL(state, nonce, verifier))
+	q := authURL.Query()
+	if q.Get("scope") != "openid email profile" || q.Get("client_id") != testClientID || q.Get("code_challenge") != CodeChallenge(verifier) {
+		t.Errorf("unexpected authorization parameters: %v", q)
+	}
+
+	code, returnedState := login(t, m, p, state, nonce, verifier)
+	if returnedState != state {
+		t.Errorf("state = %q, want %q", returnedState, state)
+	}
+	identity, err := p.Exchange(context.Background(), code, verifier, nonce)
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	want := Identity{Issuer: m.Issuer, Subject: "user-1", Email: "Staff@Example.com", EmailVerified: true}
+	if *identity != want {
+		t.Errorf("Exchange() = %+v, want %+v", *identity, want)
+	}
+
+	// Codes can only be redeemed once
+	if _, err = p.Exchange(context.Background(), code, verifier, nonce); err == nil {
+		t.Error("Exchange accepted a code that was already redeemed")
+	}
+}
+
+func TestExchangeRequiresPKCEVerifier(t *testing.T) {
+	m := oidctest.NewProvider(t)
+	p := provider(t, m, fixedClock, nil)
+
+	state, nonce, verifier, _ := NewFlow()
+	code, _ := login(t, m, p, state, nonce, verifier)
+	_, _, otherVerifier, _ := NewFlow()
+	if _, err := p.Exchange(context.Background(), code, otherVerifier, nonce); err == nil || !strings.Contains(err.Error(), "invalid_grant") {
+		t.Errorf("Exchange with the wrong verifier = %v, want an invalid_grant error", err)
+	}
+}
+
+func TestExchangeChecksClientCredentials(t *testing.T) {
+	m := oidctest.NewProvider(t)
+	p := provider(t, m, fixedClock, func(c *Config) { c.ClientSecret = "wrong" })
+
+	state, nonce, verifier, _ := NewFlow()
+	code, _ := login(t, m, p, state, nonce, verifier)
+	if _, err := p.Exchange(context.Background(), code, verifier, nonce); err == nil || !strings.Contains(err.Error(), "invalid_client") {
+		t.Errorf("Exchange with the wrong secret = %v, want an invalid_client error", err)
+	}
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	m := oidctest.NewProvider(t)
+	valid := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss": m.Issuer, "aud": testClientID, "sub": "user-1", "nonce": "n",
+			"iat": testNow.Unix(), "exp": testNow.Add(time.Hour).Unix(),
+			"email": "staff@example.com", "email_verified": true,
+		}
+	}
+	other, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	tests := []struct {
+		name   string
+		now    time.Time
+		token  func() string
+		nonce  string
+		wantOK bool
+	}{
+		{"valid", testNow, func() string { return m.Sign(valid()) }, "n", true},
+		{"clock skew within the leeway", testNow.Add(-30 * time.Second), func() string { return m.Sign(valid()) }, "n", true},
+		{"expired", testNow.Add(2 * time.Hour), func() string { return m.Sign(valid()) }, "n", false},
+		{"issued in the future", testNow.Add(-time.Hour), func() string { return m.Sign(valid()) }, "n", false},
+		{"wrong nonce", testNow, func() string { return m.Sign(valid()) }, "other", false},
+		{"missing nonce", testNow, func() string { c := valid(); delete(c, "nonce"); return m.Sign(c) }, "", false},
+		{"wrong audience", testNow, func() string { c := valid(); c["aud"] = "someone-else"; return m.Sign(c) }, "n", false},
+		{"wrong issuer", testNow, func() string { c := valid(); c["iss"] = "https://evil.example.com"; return m.Sign(c) }, "n", false},
+		{"missing subject", testNow, func() string { c := valid(); delete(c, "sub"); return m.Sign(c) }, "n", false},
+		{"several audiences without azp", testNow, func() string { c := valid(); c["aud"] = []string{testClientID, "other"}; return m.Sign(c) }, "n", false},
+		{"several audiences with azp", testNow, func() string {
+			c := valid()
+			c["aud"], c["azp"] = []string{testClientID, "other"}, testClientID
+			return m.Sign(c)
+		}, "n", true},
+		{"signed with an unknown key", testNow, func() string {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, valid())
+			token.Header["kid"] = m.KeyID()
+			signed, _ := token.SignedString(other)
+			return signed
+		}, "n", false},
+		{"unsigned", testNow, func() string {
+			signed, _ := jwt.NewWithClaims(jwt.SigningMethodNone, valid()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+			return signed
+		}, "n", false},
+		{"symmetric algorithm", testNow, func() string {
+			signed, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, valid()).SignedString([]byte(testClientSecret))
+			return signed
+		}, "n", false},
+	}
+	for _, tt := range tests {
+		now := tt.now
+		p := provider(t, m, func() time.Time { return now }, nil)
+		_, err := p.VerifyIDToken(context.Background(), tt.token(), tt.nonce)
+		if (err == nil) != tt.wantOK {
+			t.Errorf("%s: VerifyIDToken() error = %v, want ok %v", tt.name, err, tt.wantOK)
+		}
+	}
+}
+
+func TestClaimsMapping(t *testing.T) {
+	m := oidctest.NewProvider(t)
+	base := func(extra jwt.MapClaims) string {
+		c := jwt.MapClaims{"iss": m.Issuer, "aud": testClientID, "sub": "user-1", "nonce": "n", "iat": testNow.Unix(), "exp": testNow.Add(time.Hour).Unix()}
+		for k, v := range extra {
+			c[k] = v
+		}
+		return m.Sign(c)
+	}
+
+	tests := []struct {
+		name      string
+		configure func(*Config)
+		claims    jwt.MapClaims
+		email     string
+		verified  bool
+	}{
+		{"default claims", nil, jwt.MapClaims{"email": "a@example.com", "email_verified": true}, "a@example.com", true},
+		{"unverified email", nil, jwt.MapClaims{"email": "a@example.com", "email_verified": false}, "a@example.com", false},
+		{"missing verification", nil, jwt.MapClaims{"email": "a@example.com"}, "a@example.com", false},
+		{"verification as a string", nil, jwt.MapClaims{"email": "a@example.com", "email_verified": "true"}, "a@example.com", true},
+		{"custom claims", func(c *Config) { c.EmailClaim, c.EmailVerifiedClaim = "mail", "mail_confirmed" },
+			jwt.MapClaims{"mail": "B@Example.com", "mail_confirmed": true, "email": "ignored@example.com"}, "B@Example.com", true},
+		{"surrounding spaces", nil, jwt.MapClaims{"email": " a@example.com ", "email_verified": true}, "a@example.com", true},
+		{"trusted emails", func(c *Config) { c.TrustEmail = true }, jwt.MapClaims{"email": "a@example.com"}, "a@example.com", true},
+		{"no email", func(c *Config) { c.TrustEmail = true }, jwt.MapClaims{}, "", false},
+	}
+	for _, tt := range tests {
+		p := provider(t, m, fixedClock, tt.configure)
+		identity, err := p.VerifyIDToken(context.Background(), base(tt.claims), "n")
+		if err != nil {
+			t.Errorf("%s: VerifyIDToken: %v", tt.name, err)
+			continue
+		}
+		if identity.Email != tt.email || identity.EmailVerified != tt.verified {
+			t.Errorf("%s: got email %q verified %v, want %q %v", tt.name, identity.Email, identity.EmailVerified, tt.email, tt.verified)
+		}
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	m := oidctest.NewProvider(t)
+	now := testNow
+	p := provider(t, m, func() time.Time { return now }, nil)
+	claims := func() jwt.MapClaims {
+		return jwt.MapClaims{"iss": m.Issuer, "aud": testClientID, "sub": "user-1", "nonce": "n", "iat": now.Unix(), "exp": now.Add(time.Hour).Unix()}
+	}
+
+	if _, err := p.VerifyIDToken(context.Background(), m.Sign(claims()), "n"); err != nil {
+		t.Fatalf("VerifyIDToken with the first key: %v", err)
+	}
+
+	// Right after a fetch the keys aren't fetched again, so tokens can't make the client flood the provider
+	m.RotateKey("key-2")
+	if _, err := p.VerifyIDToken(context.Background(), m.Sign(claims()), "n"); err == nil {
+		t.Fatal("VerifyIDToken fetched the keys again right after fetching them")
+	}
+
+	// Later, the unknown key ID makes the client fetch the new keys
+	now = now.Add(keysRefreshInterval)
+	if _, err := p.VerifyIDToken(context.Background(), m.Sign(claims()), "n"); err != nil {
+		t.Errorf("VerifyIDToken after the key rotation: %v", err)
+	}
+}
+
+func TestDiscoveryChecksIssuer(t *testing.T) {
+	m := oidctest.NewProvider(t)
+	_, err := NewProvider(context.Background(), Config{Issuer: m.Issuer + "/", ClientID: testClientID})
+	if err == nil || !strings.Contains(err.Error(), "issuer") {
+		t.Errorf("NewProvider with another issuer = %v, want an issuer mismatch error", err)
+	}
+}
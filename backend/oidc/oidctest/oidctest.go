@@ -0,0 +1,196 @@
+// Package oidctest runs a local OpenID Connect provider for the tests of the packages that log in with one.
+//
+// It doesn't import oidc, so the tests of oidc can use it too.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Credentials of the only client of the provider
+const (
+	ClientID     = "hbd"
+	ClientSecret = "s3cr3t"
+)
+
+// Now is the time at which the provider issues ID tokens, clients verify them with a clock set to it
+var Now = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// authorization is a code issued by the provider, with the parameters of the authorization request
+type authorization struct {
+	clientID    string
+	redirectURI string
+	challenge   string
+	nonce       string
+}
+
+// Provider is a local OpenID Connect provider that logs in a user without asking, the user is described by the claims
+// of its ID tokens
+type Provider struct {
+	Issuer string
+
+	t      *testing.T
+	server *httptest.Server
+
+	mu     sync.Mutex
+	key    *rsa.PrivateKey
+	kid    string
+	codes  map[string]authorization
+	claims map[string]any
+}
+
+// NewProvider starts a provider that is stopped when the test finishes. It logs in user-1 with a verified email.
+func NewProvider(t *testing.T) *Provider {
+	m := &Provider{t: t, codes: map[string]authorization{}}
+	m.RotateKey("key-1")
+	m.claims = map[string]any{"sub": "user-1", "email": "Staff@Example.com", "email_verified": true}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 m.Issuer,
+			"authorization_endpoint": m.Issuer + "/authorize",
+			"token_endpoint":         m.Issuer + "/token",
+			"jwks_uri":               m.Issuer + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", m.jwks)
+	mux.HandleFunc("/authorize", m.authorize)
+	mux.HandleFunc("/token", m.token)
+	m.server = httptest.NewServer(mux)
+	m.Issuer = m.server.URL
+	t.Cleanup(m.server.Close)
+	return m
+}
+
+// RotateKey replaces the signing key of the provider with a new one
+func (m *Provider) RotateKey(kid string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.key, m.kid = key, kid
+}
+
+// KeyID returns the ID of the signing key
+func (m *Provider) KeyID() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.kid
+}
+
+// SetClaims replaces the claims of the user in the next ID tokens, the provider adds the issuer, audience, times and nonce
+func (m *Provider) SetClaims(claims map[string]any) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.claims = claims
+}
+
+// Sign signs an ID token with the claims as they are
+func (m *Provider) Sign(claims jwt.MapClaims) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = m.kid
+	signed, err := token.SignedString(m.key)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	return signed
+}
+
+// Authorize follows the authorization URL of a client and returns where the provider redirects back to, with the code
+// and state in its query
+func (m *Provider) Authorize(authURL string) *url.URL {
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := client.Get(authURL)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	resp.Body.Close()
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil || resp.StatusCode != http.StatusFound {
+		m.t.Fatalf("authorization returned %s, want a redirect", resp.Status)
+	}
+	return location
+}
+
+func (m *Provider) jwks(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{
+		// Encryption keys must be skipped
+		{"kty": "RSA", "kid": "enc", "use": "enc", "n": "AQAB", "e": "AQAB"},
+		{
+			"kty": "RSA", "kid": m.kid, "use": "sig", "alg": "RS256",
+			"n": base64.RawURLEncoding.EncodeToString(m.key.N.Bytes()),
+			"e": base64.RawURLEncoding.EncodeToString(big.NewInt(int64(m.key.E)).Bytes()),
+		},
+	}})
+}
+
+// authorize logs the user in right away and redirects back with a code, like a provider with an active session
+func (m *Provider) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("response_type") != "code" || q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+	b := make([]byte, 32)
+	rand.Read(b)
+	code := base64.RawURLEncoding.EncodeToString(b)
+	m.mu.Lock()
+	m.codes[code] = authorization{q.Get("client_id"), q.Get("redirect_uri"), q.Get("code_challenge"), q.Get("nonce")}
+	m.mu.Unlock()
+	http.Redirect(w, r, q.Get("redirect_uri")+"?code="+code+"&state="+url.QueryEscape(q.Get("state")), http.StatusFound)
+}
+
+// token redeems a code once, checking the client credentials, the redirect URI and the PKCE verifier
+func (m *Provider) token(w http.ResponseWriter, r *http.Request) {
+	fail := func(code string) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": code})
+	}
+	id, secret, ok := r.BasicAuth()
+	if !ok || id != ClientID || secret != ClientSecret {
+		fail("invalid_client")
+		return
+	}
+	r.ParseForm()
+	m.mu.Lock()
+	auth, ok := m.codes[r.PostForm.Get("code")]
+	delete(m.codes, r.PostForm.Get("code"))
+	m.mu.Unlock()
+	if r.PostForm.Get("grant_type") != "authorization_code" || !ok || auth.redirectURI != r.PostForm.Get("redirect_uri") {
+		fail("invalid_grant")
+		return
+	}
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(sum[:]) != auth.challenge {
+		fail("invalid_grant")
+		return
+	}
+
+	claims := jwt.MapClaims{"iss": m.Issuer, "aud": auth.clientID, "iat": Now.Unix(), "exp": Now.Add(time.Hour).Unix(), "nonce": auth.nonce}
+	m.mu.Lock()
+	for k, v := range m.claims {
+		claims[k] = v
+	}
+	m.mu.Unlock()
+	json.NewEncoder(w).Encode(map[string]string{"access_token": "at", "token_type": "Bearer", "id_token": m.Sign(claims)})
+}
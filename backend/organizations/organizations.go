@@ -0,0 +1,61 @@
+// Package organizations holds the roles of the members of organizations and the removal of members.
+package organizations
+
+import (
+	"context"
+
+	"hbd/models"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Roles of the members of an organization, admins manage the members and channels of the organization
+const (
+	Admin  = "admin"
+	Member = "member"
+)
+
+// RemoveMember removes the user from the organization, along with their directory entry. If the organization
+// is left without an admin the earliest remaining member becomes its admin, and the organization is deleted along with
+// its channels and invitations when the last member leaves.
+func RemoveMember(ctx context.Context, exec boil.ContextExecutor, orgID, userID int64) error {
+	if _, err := models.OrganizationMembers(
+		models.OrganizationMemberWhere.OrganizationID.EQ(orgID),
+		models.OrganizationMemberWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	// Get the remaining members, earliest first
+	members, err := models.OrganizationMembers(
+		models.OrganizationMemberWhere.OrganizationID.EQ(orgID),
+		qm.OrderBy("created_at, user_id"),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	// Delete the organization if nobody is left
+	if len(members) == 0 {
+		if _, err := models.OrganizationChannels(models.OrganizationChannelWhere.OrganizationID.EQ(orgID)).DeleteAll(ctx, exec); err != nil {
+			return err
+		}
+		if _, err := models.Invitations(models.InvitationWhere.OrganizationID.EQ(null.Int64From(orgID))).DeleteAll(ctx, exec); err != nil {
+			return err
+		}
+		_, err := models.Organizations(models.OrganizationWhere.ID.EQ(null.Int64From(orgID))).DeleteAll(ctx, exec)
+		return err
+	}
+
+	// Make sure the organization still has an admin
+	for _, member := range members {
+		if member.Role == Admin {
+			return nil
+		}
+	}
+	members[0].Role = Admin
+	_, err = members[0].Update(ctx, exec, boil.Whitelist(models.OrganizationMemberColumns.Role))
+	return err
+}
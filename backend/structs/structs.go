@@ -3,8 +3,10 @@ package structs
 import "github.com/golang-jwt/jwt/v5"
 
 // REQUESTS
+// The session ID ties each access token to the session it was issued for, so revoking the session revokes the token
 type Claims struct {
-	Email string `json:"email"`
+	Email     string `json:"email"`
+	SessionID int64  `json:"sid"`
 	jwt.RegisteredClaims
 }
 
@@ -15,11 +17,49 @@ type RegisterRequest struct {
 	Timezone          string `json:"timezone" binding:"required" example:"America/New_York"`
 	TelegramBotAPIKey string `json:"telegram_bot_api_key" binding:"required" example:"270485614:AAHfiqksKZ8WmR2zSjiQ7jd8Eud81ggE3e-3"`
 	TelegramUserID    string `json:"telegram_user_id" binding:"required" example:"123456789"`
+	InviteToken       string `json:"invite_token" example:"q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"`
 }
 
+// The TOTP code, or one of the recovery codes, is only needed when the user has two-factor authentication enabled
 type LoginRequest struct {
 	Email    string `json:"email" binding:"required" example:"example@lotiguere.com"`
 	Password string `json:"password" binding:"required" example:"9cc76406913372c2b3a3474e8ebb8dc917bdb9c4a7c5e98c639ed20f5bcf4da1"`
+	TOTPCode string `json:"totp_code" example:"123456"`
+}
+
+type TOTPCode struct {
+	Code string `json:"code" binding:"required" example:"123456"`
+}
+
+// Disabling two-factor authentication and regenerating the recovery codes need the password and a TOTP or recovery code
+type TOTPReauth struct {
+	Password string `json:"password" binding:"required" example:"9cc76406913372c2b3a3474e8ebb8dc917bdb9c4a7c5e98c639ed20f5bcf4da1"`
+	Code     string `json:"code" binding:"required" example:"123456"`
+}
+
+// Each refresh token can only be used once, the response has the new one
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required" example:"q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"`
+}
+
+type SessionID struct {
+	ID int64 `json:"id" binding:"required" example:"1"`
+}
+
+type APITokenAdd struct {
+	Name      string   `json:"name" binding:"required" example:"hbd-cli"`
+	Scopes    []string `json:"scopes" binding:"required" example:"birthdays:read,birthdays:write"`
+	ExpiresAt string   `json:"expires_at" example:"2025-12-31T23:59:59Z"`
+}
+
+type APITokenModify struct {
+	ID     int64    `json:"id" binding:"required" example:"1"`
+	Name   string   `json:"name" binding:"required" example:"hbd-cli"`
+	Scopes []string `json:"scopes" binding:"required" example:"birthdays:read"`
+}
+
+type APITokenID struct {
+	ID int64 `json:"id" binding:"required" example:"1"`
 }
 
 type ModifyUserRequest struct {
@@ -31,25 +71,272 @@ type ModifyUserRequest struct {
 	NewTelegramUserID    string `json:"new_telegram_user_id" binding:"required" example:"123456789"`
 }
 
+// Dates are either full dates (YYYY-MM-DD) or dates with an unknown year (--MM-DD)
+// Event types are birthday (default), wedding_anniversary, work_anniversary, name_day, memorial and other
+// The years label, if set, overrides the phrasing of the event type, {years} is replaced by the years since the event
+// Calendars are gregorian (default), hebrew, islamic and chinese, dates in calendars other than gregorian need a known year
+// Tags and contact details are optional, the telegram username links to t.me in reminders
+// The list ID adds a new birthday to a shared list the user can edit, otherwise it belongs only to the user
+// When modifying, the optional fields are kept if omitted
 type BirthdayNameDateModify struct {
-	ID   int64  `json:"id" binding:"required" example:"1"`
-	Name string `json:"name" binding:"required" example:"John Doe"`
-	Date string `json:"date" binding:"required" example:"2021-01-01"`
+	ID               int64     `json:"id" binding:"required" example:"1"`
+	Name             string    `json:"name" binding:"required" example:"John Doe"`
+	Date             string    `json:"date" binding:"required" example:"2021-01-01"`
+	EventType        *string   `json:"event_type" example:"birthday"`
+	YearsLabel       *string   `json:"years_label" example:"Turns {years}"`
+	Calendar         *string   `json:"calendar" example:"gregorian"`
+	Notes            *string   `json:"notes" example:"Likes chess and dark chocolate"`
+	Tags             *[]string `json:"tags" example:"family,football club"`
+	TelegramUsername *string   `json:"telegram_username" example:"johndoe"`
+	Phone            *string   `json:"phone" example:"+1 555 0100"`
+	Email            *string   `json:"email" example:"john@example.com"`
+}
+
+// Only the provided fields are changed
+type BirthdayPatch struct {
+	Name             *string   `json:"name" example:"John Doe"`
+	Date             *string   `json:"date" example:"2021-01-01"`
+	EventType        *string   `json:"event_type" example:"birthday"`
+	YearsLabel       *string   `json:"years_label" example:"Turns {years}"`
+	Calendar         *string   `json:"calendar" example:"gregorian"`
+	Notes            *string   `json:"notes" example:"Likes chess and dark chocolate"`
+	Tags             *[]string `json:"tags" example:"family,football club"`
+	TelegramUsername *string   `json:"telegram_username" example:"johndoe"`
+	Phone            *string   `json:"phone" example:"+1 555 0100"`
+	Email            *string   `json:"email" example:"john@example.com"`
 }
 
 type BirthdayNameDateAdd struct {
-	Name string `json:"name" binding:"required" example:"John Doe"`
-	Date string `json:"date" binding:"required" example:"2021-01-01"`
+	Name             string   `json:"name" binding:"required" example:"John Doe"`
+	Date             string   `json:"date" binding:"required" example:"2021-01-01"`
+	EventType        string   `json:"event_type" example:"birthday"`
+	YearsLabel       string   `json:"years_label" example:"Turns {years}"`
+	Calendar         string   `json:"calendar" example:"gregorian"`
+	Notes            string   `json:"notes" example:"Likes chess and dark chocolate"`
+	Tags             []string `json:"tags" example:"family,football club"`
+	TelegramUsername string   `json:"telegram_username" example:"johndoe"`
+	Phone            string   `json:"phone" example:"+1 555 0100"`
+	Email            string   `json:"email" example:"john@example.com"`
+	ListID           int64    `json:"list_id" example:"1"`
 }
 
 type BirthdayFull struct {
-	ID   int64  `json:"id" example:"1"`
-	Name string `json:"name" example:"John Doe"`
-	Date string `json:"date" example:"2021-01-01"`
+	ID               int64    `json:"id" example:"1"`
+	Name             string   `json:"name" example:"John Doe"`
+	Date             string   `json:"date" example:"2021-01-01"`
+	YearKnown        bool     `json:"year_known" example:"true"`
+	EventType        string   `json:"event_type" example:"birthday"`
+	YearsLabel       string   `json:"years_label" example:"Turns {years}"`
+	Calendar         string   `json:"calendar" example:"gregorian"`
+	CalendarDate     string   `json:"calendar_date,omitempty" example:"15 Nisan 5750"`
+	Notes            string   `json:"notes" example:"Likes chess and dark chocolate"`
+	Tags             []string `json:"tags" example:"family,football club"`
+	TelegramUsername string   `json:"telegram_username" example:"johndoe"`
+	Phone            string   `json:"phone" example:"+1 555 0100"`
+	Email            string   `json:"email" example:"john@example.com"`
+	ListID           int64    `json:"list_id,omitempty" example:"1"`
 }
 
 type BirthdayID struct {
-	ID int64 `json:"id" example:"1"`
+	ID int64 `json:"id" binding:"required" example:"1"`
+}
+
+// Lead days are the days before each birthday on which the reminder is sent, 0 is the day itself
+// The destination is the Telegram chat that receives the reminders of the group, the user's chat if empty
+// The template is the line shown for each birthday in the reminder, with {name}, {years}, {date}, {days} and {group} replaced
+// When modifying, the optional fields are kept if omitted
+type GroupAdd struct {
+	Name        string  `json:"name" binding:"required" example:"Close family"`
+	LeadDays    []int   `json:"lead_days" example:"0,7"`
+	Destination string  `json:"destination" example:"-1001234567890"`
+	Template    string  `json:"template" example:"{name} - {years}"`
+	BirthdayIDs []int64 `json:"birthday_ids" example:"1,2"`
+}
+
+type GroupModify struct {
+	ID          int64    `json:"id" binding:"required" example:"1"`
+	Name        string   `json:"name" binding:"required" example:"Close family"`
+	LeadDays    *[]int   `json:"lead_days" example:"0,7"`
+	Destination *string  `json:"destination" example:"-1001234567890"`
+	Template    *string  `json:"template" example:"{name} - {years}"`
+	BirthdayIDs *[]int64 `json:"birthday_ids" example:"1,2"`
+}
+
+type GroupID struct {
+	ID int64 `json:"id" binding:"required" example:"1"`
+}
+
+// The birthday to keep takes the notes, tags, contact details and groups of the merged birthday, which is deleted
+type MergeBirthdaysRequest struct {
+	KeepID  int64 `json:"keep_id" binding:"required" example:"1"`
+	MergeID int64 `json:"merge_id" binding:"required" example:"2"`
+}
+
+// Reverting to a change restores the values the birthday had right after it, or right before it for deletions
+type RevertBirthdayRequest struct {
+	HistoryID int64 `json:"history_id" binding:"required" example:"3"`
+}
+
+// Each operation sets exactly one of create, update and delete, and a birthday can only be changed by one operation per batch
+// The operations are applied in order and either all of them are applied or none
+type BatchOperation struct {
+	Create *BirthdayNameDateAdd    `json:"create"`
+	Update *BirthdayNameDateModify `json:"update"`
+	Delete *BirthdayID             `json:"delete"`
+}
+
+type BatchBirthdaysRequest struct {
+	Operations []BatchOperation `json:"operations" binding:"required,min=1,max=100"`
+}
+
+// Statuses are idea (default), bought and given, the year given defaults to the current year when a gift is given
+// The link must be an http or https URL
+type GiftAdd struct {
+	Name      string   `json:"name" binding:"required" example:"Chess set"`
+	Status    string   `json:"status" example:"idea"`
+	Price     *float64 `json:"price" example:"49.90"`
+	Link      string   `json:"link" example:"https://example.com/chess-set"`
+	YearGiven *int     `json:"year_given" example:"2024"`
+	Notes     string   `json:"notes" example:"The wooden one"`
+}
+
+// Only the provided fields are changed
+type GiftPatch struct {
+	Name      *string  `json:"name" example:"Chess set"`
+	Status    *string  `json:"status" example:"bought"`
+	Price     *float64 `json:"price" example:"49.90"`
+	Link      *string  `json:"link" example:"https://example.com/chess-set"`
+	YearGiven *int     `json:"year_given" example:"2024"`
+	Notes     *string  `json:"notes" example:"The wooden one"`
+}
+
+// Milestones are the ages whose birthdays are highlighted, the lead days are the days before them on which an extra reminder
+// is sent, 0 disables it. The omitted fields are kept, an empty list of milestones disables them
+type MilestonesModify struct {
+	Milestones *[]int `json:"milestones" example:"18,21,30,40,50"`
+	LeadDays   *int   `json:"lead_days" example:"30"`
+}
+
+// Members are added by the email of their account, with the editor or viewer role, or owner to let them manage the list
+type ListAdd struct {
+	Name string `json:"name" binding:"required" example:"Family"`
+}
+
+type ListModify struct {
+	ID   int64  `json:"id" binding:"required" example:"1"`
+	Name string `json:"name" binding:"required" example:"Family"`
+}
+
+type ListID struct {
+	ID int64 `json:"id" binding:"required" example:"1"`
+}
+
+type ListMemberModify struct {
+	ListID int64  `json:"list_id" binding:"required" example:"1"`
+	Email  string `json:"email" binding:"required" example:"partner@example.com"`
+	Role   string `json:"role" binding:"required" example:"editor"`
+}
+
+// Members can remove themselves by omitting the email, the owner can remove anyone
+type ListMemberRemove struct {
+	ListID int64  `json:"list_id" binding:"required" example:"1"`
+	Email  string `json:"email" example:"partner@example.com"`
+}
+
+// Moves a birthday into a shared list, or out of it to the user's own birthdays with a list ID of 0
+type BirthdayMove struct {
+	ID     int64 `json:"id" binding:"required" example:"1"`
+	ListID int64 `json:"list_id" example:"1"`
+}
+
+// Shares give read-only access to a list without an account, they never expire if the expiry time is omitted
+// Hiding the years leaves out the birth years and ages
+type ListShareAdd struct {
+	ListID    int64  `json:"list_id" binding:"required" example:"1"`
+	ExpiresAt string `json:"expires_at" example:"2025-12-31T23:59:59Z"`
+	HideYears bool   `json:"hide_years" example:"true"`
+}
+
+type ListShareID struct {
+	ID int64 `json:"id" binding:"required" example:"1"`
+}
+
+// Members are added by the email of their account, with the member role or admin to let them manage the organization
+type OrganizationAdd struct {
+	Name string `json:"name" binding:"required" example:"Acme"`
+}
+
+type OrganizationModify struct {
+	ID   int64  `json:"id" binding:"required" example:"1"`
+	Name string `json:"name" binding:"required" example:"Acme"`
+}
+
+type OrganizationID struct {
+	ID int64 `json:"id" binding:"required" example:"1"`
+}
+
+type OrganizationMemberModify struct {
+	OrganizationID int64  `json:"organization_id" binding:"required" example:"1"`
+	Email          string `json:"email" binding:"required" example:"colleague@example.com"`
+	Role           string `json:"role" binding:"required" example:"member"`
+}
+
+// Members can remove themselves by omitting the email, the admins can remove anyone
+type OrganizationMemberRemove struct {
+	OrganizationID int64  `json:"organization_id" binding:"required" example:"1"`
+	Email          string `json:"email" example:"colleague@example.com"`
+}
+
+// The entry of the user in the directory of an organization, the year is kept private with a --MM-DD date
+type DirectoryEntryModify struct {
+	OrganizationID int64  `json:"organization_id" binding:"required" example:"1"`
+	Name           string `json:"name" binding:"required" example:"John Doe"`
+	Date           string `json:"date" binding:"required" example:"--03-02"`
+}
+
+type DirectoryEntryRemove struct {
+	OrganizationID int64 `json:"organization_id" binding:"required" example:"1"`
+}
+
+// Channels are Telegram chats that receive the birthdays of the directory of an organization, through their own bot
+// The reminder time is in UTC, and the lead days are the days before the birthdays on which they're announced
+type OrganizationChannelAdd struct {
+	OrganizationID    int64  `json:"organization_id" binding:"required" example:"1"`
+	Name              string `json:"name" binding:"required" example:"Company chat"`
+	TelegramBotAPIKey string `json:"telegram_bot_api_key" binding:"required" example:"270485614:AAHfiqksKZ8WmR2zSjiQ7jd8Eud81ggE3e-3"`
+	TelegramChatID    string `json:"telegram_chat_id" binding:"required" example:"-1001234567890"`
+	ReminderTime      string `json:"reminder_time" binding:"required" example:"09:00"`
+	LeadDays          int    `json:"lead_days" example:"0"`
+}
+
+type OrganizationChannelID struct {
+	ID int64 `json:"id" binding:"required" example:"1"`
+}
+
+// Invitations are for either a shared list or an organization, with the viewer or member role by default
+// Invitations sent to an email can only be accepted by the user with that email, otherwise anyone with the link can
+// accept them once. They expire after 7 days by default and 30 days at most
+type InvitationAdd struct {
+	ListID         int64  `json:"list_id" example:"1"`
+	OrganizationID int64  `json:"organization_id" example:"0"`
+	Role           string `json:"role" example:"editor"`
+	Email          string `json:"email" example:"partner@example.com"`
+	ExpiresAt      string `json:"expires_at" example:"2025-12-31T23:59:59Z"`
+}
+
+type InvitationID struct {
+	ID int64 `json:"id" binding:"required" example:"1"`
+}
+
+// Invitations are accepted with their token, or with their ID for the ones sent to the email of the user
+type InvitationAccept struct {
+	Token string `json:"token" example:"q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"`
+	ID    int64  `json:"id" example:"1"`
+}
+
+type ICSImportRequest struct {
+	ICS      string   `json:"ics" binding:"required" example:"BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:19900302\nRRULE:FREQ=YEARLY\nSUMMARY:John Doe's birthday\nEND:VEVENT\nEND:VCALENDAR"`
+	Patterns []string `json:"patterns" example:"{name}'s birthday,Birthday of {name}"`
 }
 
 // RESPONSES
@@ -61,8 +348,11 @@ type Success struct {
 	Success bool `json:"success"`
 }
 
+// The token is a short-lived access token, which expires at the given time, and the refresh token gets new ones
 type LoginSuccess struct {
 	Token             string         `json:"token"`
+	RefreshToken      string         `json:"refresh_token" example:"q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"`
+	ExpiresAt         string         `json:"expires_at" example:"2025-01-01T12:15:00Z"`
 	TelegramBotAPIKey string         `json:"telegram_bot_api_key" example:"270485614:AAHfiqksKZ8WmR2zSjiQ7jd8Eud81ggE3e-3"`
 	TelegramUserID    string         `json:"telegram_user_id" example:"123456789"`
 	ReminderTime      string         `json:"reminder_time" example:"15:04"`
@@ -70,6 +360,50 @@ type LoginSuccess struct {
 	Birthdays         []BirthdayFull `json:"birthdays"`
 }
 
+// Returned by the login when the user has two-factor authentication enabled and no code was sent
+type TOTPChallenge struct {
+	Error        string `json:"error" example:"two-factor authentication code required"`
+	TOTPRequired bool   `json:"totp_required" example:"true"`
+}
+
+type TOTPSetup struct {
+	Secret string `json:"secret" example:"JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+	URI    string `json:"uri" example:"otpauth://totp/HBD:example@lotiguere.com?algorithm=SHA1&digits=6&issuer=HBD&period=30&secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+}
+
+// Recovery codes are only shown once, each one can be used once instead of a TOTP code
+type RecoveryCodes struct {
+	Codes []string `json:"codes" example:"7kqmz-2xw4p,b3n5t-q6hya"`
+}
+
+type SessionTokens struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token" example:"q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"`
+	ExpiresAt    string `json:"expires_at" example:"2025-01-01T12:15:00Z"`
+}
+
+// The current session is the one of the access token of the request
+type SessionFull struct {
+	ID         int64  `json:"id" example:"1"`
+	UserAgent  string `json:"user_agent" example:"Mozilla/5.0"`
+	IP         string `json:"ip" example:"203.0.113.7"`
+	CreatedAt  string `json:"created_at" example:"2025-01-01T12:00:00Z"`
+	LastUsedAt string `json:"last_used_at" example:"2025-01-02T08:30:00Z"`
+	ExpiresAt  string `json:"expires_at" example:"2025-01-31T12:00:00Z"`
+	Current    bool   `json:"current" example:"true"`
+}
+
+type APITokenFull struct {
+	ID         int64    `json:"id" example:"1"`
+	Name       string   `json:"name" example:"hbd-cli"`
+	Scopes     []string `json:"scopes" example:"birthdays:read,birthdays:write"`
+	ExpiresAt  string   `json:"expires_at,omitempty" example:"2025-12-31T23:59:59Z"`
+	Expired    bool     `json:"expired" example:"false"`
+	LastUsedAt string   `json:"last_used_at,omitempty" example:"2025-01-02T08:30:00Z"`
+	CreatedAt  string   `json:"created_at" example:"2025-01-01T12:00:00Z"`
+	Token      string   `json:"token,omitempty" example:"hbd_pat_q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"`
+}
+
 type UserData struct {
 	ID                int64          `json:"id" example:"1"`
 	TelegramBotAPIKey string         `json:"telegram_bot_api_key" example:"270485614:AAHfiqksKZ8WmR2zSjiQ7jd8Eud81ggE3e-3"`
@@ -77,6 +411,254 @@ type UserData struct {
 	ReminderTime      string         `json:"reminder_time" example:"15:04"`
 	Timezone          string         `json:"timezone" example:"America/New_York"`
 	Birthdays         []BirthdayFull `json:"birthdays"`
+	Milestones        []int          `json:"milestones" example:"18,21,30,40,50"`
+	MilestoneLeadDays int            `json:"milestone_lead_days" example:"30"`
+	TOTPEnabled       bool           `json:"totp_enabled" example:"false"`
+	// Version of the user settings, sent in the ETag header
+	Version int64 `json:"-"`
+}
+
+type UpcomingBirthday struct {
+	ID        int64    `json:"id" example:"1"`
+	Name      string   `json:"name" example:"John Doe"`
+	EventType string   `json:"event_type" example:"birthday"`
+	Calendar  string   `json:"calendar" example:"gregorian"`
+	Date      string   `json:"date" example:"2025-03-02"`
+	DaysUntil int      `json:"days_until" example:"3"`
+	Years     int      `json:"years,omitempty" example:"35"`
+	YearKnown bool     `json:"year_known" example:"true"`
+	Tags      []string `json:"tags" example:"family,football club"`
+	Milestone bool     `json:"milestone" example:"false"`
+}
+
+type GroupFull struct {
+	ID          int64   `json:"id" example:"1"`
+	Name        string  `json:"name" example:"Close family"`
+	LeadDays    []int   `json:"lead_days" example:"0,7"`
+	Destination string  `json:"destination" example:"-1001234567890"`
+	Template    string  `json:"template" example:"{name} - {years}"`
+	BirthdayIDs []int64 `json:"birthday_ids" example:"1,2"`
+}
+
+type BirthdayDuplicate struct {
+	Birthdays  []BirthdayFull `json:"birthdays"`
+	Similarity float64        `json:"similarity" example:"0.9"`
+}
+
+type TrashedBirthday struct {
+	Birthday  BirthdayFull `json:"birthday"`
+	DeletedAt string       `json:"deleted_at" example:"2024-03-02T15:04:05Z"`
+	PurgeAt   string       `json:"purge_at" example:"2024-04-01T15:04:05Z"`
+}
+
+// Actions are insert, update, delete, restore and revert, the old values are empty for inserts and restores
+// and the new values are empty for deletions
+type BirthdayHistoryEntry struct {
+	ID         int64         `json:"id" example:"3"`
+	BirthdayID int64         `json:"birthday_id" example:"1"`
+	UserID     int64         `json:"user_id,omitempty" example:"1"`
+	Action     string        `json:"action" example:"update"`
+	OldValues  *BirthdayFull `json:"old_values"`
+	NewValues  *BirthdayFull `json:"new_values"`
+	CreatedAt  string        `json:"created_at" example:"2024-03-02T15:04:05Z"`
+}
+
+// The results are in the order of the operations, the error is set for the operations that failed validation
+// and the birthday for the birthdays that were created or updated
+type BatchOperationResult struct {
+	Index    int           `json:"index" example:"0"`
+	Op       string        `json:"op" example:"create"`
+	ID       int64         `json:"id,omitempty" example:"1"`
+	Birthday *BirthdayFull `json:"birthday,omitempty"`
+	Error    string        `json:"error,omitempty" example:"invalid date format"`
+}
+
+type BatchBirthdaysResult struct {
+	Success bool                   `json:"success" example:"true"`
+	Results []BatchOperationResult `json:"results"`
+}
+
+type GiftFull struct {
+	ID         int64    `json:"id" example:"1"`
+	BirthdayID int64    `json:"birthday_id" example:"1"`
+	Name       string   `json:"name" example:"Chess set"`
+	Status     string   `json:"status" example:"idea"`
+	Price      *float64 `json:"price,omitempty" example:"49.90"`
+	Link       string   `json:"link" example:"https://example.com/chess-set"`
+	YearGiven  *int     `json:"year_given,omitempty" example:"2024"`
+	Notes      string   `json:"notes" example:"The wooden one"`
+	CreatedAt  string   `json:"created_at" example:"2024-03-02T15:04:05Z"`
+}
+
+type GiftHistoryEntry struct {
+	Gift         GiftFull `json:"gift"`
+	BirthdayName string   `json:"birthday_name" example:"John Doe"`
+}
+
+// Statistics of the birthdays of a user, computed from the Gregorian dates of the birthday events
+// Dates without year are counted by month and day only, and ages are in Gregorian years
+type BirthdayStats struct {
+	Total              int                 `json:"total" example:"42"`
+	PerMonth           []MonthCount        `json:"per_month"`
+	BusiestWeeks       []WeekCount         `json:"busiest_weeks"`
+	AgeDistribution    []AgeBucket         `json:"age_distribution"`
+	UpcomingMilestones []MilestoneBirthday `json:"upcoming_milestones"`
+	SharedBirthdays    []SharedBirthday    `json:"shared_birthdays"`
+}
+
+type MonthCount struct {
+	Month int `json:"month" example:"3"`
+	Count int `json:"count" example:"5"`
+}
+
+// Weeks are counted from January 1st, the start and end are month and day
+type WeekCount struct {
+	Week  int    `json:"week" example:"10"`
+	Start string `json:"start" example:"--03-04"`
+	End   string `json:"end" example:"--03-10"`
+	Count int    `json:"count" example:"3"`
+}
+
+// Ages from the lower bound up to the upper bound, both included
+type AgeBucket struct {
+	From  int `json:"from" example:"30"`
+	To    int `json:"to" example:"39"`
+	Count int `json:"count" example:"7"`
+}
+
+type MilestoneBirthday struct {
+	ID      int64  `json:"id" example:"1"`
+	Name    string `json:"name" example:"John Doe"`
+	Date    string `json:"date" example:"1994-03-02"`
+	Turning int    `json:"turning" example:"30"`
+}
+
+type SharedBirthday struct {
+	Date  string   `json:"date" example:"--03-02"`
+	Names []string `json:"names" example:"John Doe,Jane Doe"`
+}
+
+// The role is the one of the authenticated user, members are identified by their user ID since emails are only stored hashed
+type ListFull struct {
+	ID      int64            `json:"id" example:"1"`
+	Name    string           `json:"name" example:"Family"`
+	Role    string           `json:"role" example:"owner"`
+	Members []ListMemberFull `json:"members"`
+}
+
+type ListMemberFull struct {
+	UserID int64  `json:"user_id" example:"2"`
+	Role   string `json:"role" example:"editor"`
+	You    bool   `json:"you" example:"false"`
+}
+
+// The token and URL are only returned when the share is created
+type ListShareFull struct {
+	ID        int64  `json:"id" example:"1"`
+	ListID    int64  `json:"list_id" example:"1"`
+	HideYears bool   `json:"hide_years" example:"true"`
+	ExpiresAt string `json:"expires_at,omitempty" example:"2025-12-31T23:59:59Z"`
+	Expired   bool   `json:"expired" example:"false"`
+	CreatedAt string `json:"created_at" example:"2025-01-01T12:00:00Z"`
+	Token     string `json:"token,omitempty" example:"q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"`
+	URL       string `json:"url,omitempty" example:"/api/shared-lists/q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"`
+}
+
+// Read-only view of a shared list, sorted by the next date of each birthday
+type SharedList struct {
+	Name      string               `json:"name" example:"Family"`
+	Birthdays []SharedListBirthday `json:"birthdays"`
+}
+
+// When the share hides the years, dates have no year and the years are left out. Dates in calendars other than gregorian
+// are left out too then, since they can't be shown without the year
+type SharedListBirthday struct {
+	Name      string `json:"name" example:"John Doe"`
+	EventType string `json:"event_type" example:"birthday"`
+	Calendar  string `json:"calendar" example:"gregorian"`
+	Date      string `json:"date,omitempty" example:"1990-03-02"`
+	NextDate  string `json:"next_date" example:"2025-03-02"`
+	Years     int    `json:"years,omitempty" example:"35"`
+}
+
+// The role is the one of the authenticated user, members are identified by their user ID since emails are only stored hashed
+type OrganizationFull struct {
+	ID      int64                    `json:"id" example:"1"`
+	Name    string                   `json:"name" example:"Acme"`
+	Role    string                   `json:"role" example:"admin"`
+	Members []OrganizationMemberFull `json:"members"`
+}
+
+type OrganizationMemberFull struct {
+	UserID      int64  `json:"user_id" example:"2"`
+	Role        string `json:"role" example:"member"`
+	InDirectory bool   `json:"in_directory" example:"true"`
+	You         bool   `json:"you" example:"false"`
+}
+
+// Entry of the directory of an organization, the date has no year and the years are left out if the member keeps the year private
+type DirectoryEntry struct {
+	UserID   int64  `json:"user_id" example:"2"`
+	Name     string `json:"name" example:"John Doe"`
+	Date     string `json:"date" example:"--03-02"`
+	NextDate string `json:"next_date" example:"2025-03-02"`
+	Years    int    `json:"years,omitempty" example:"35"`
+	You      bool   `json:"you" example:"false"`
+}
+
+// The bot API key is never returned
+type OrganizationChannelFull struct {
+	ID             int64  `json:"id" example:"1"`
+	OrganizationID int64  `json:"organization_id" example:"1"`
+	Name           string `json:"name" example:"Company chat"`
+	TelegramChatID string `json:"telegram_chat_id" example:"-1001234567890"`
+	ReminderTime   string `json:"reminder_time" example:"09:00"`
+	LeadDays       int    `json:"lead_days" example:"0"`
+}
+
+// The token and URL are only returned when the invitation is created, the email is only stored hashed
+type InvitationFull struct {
+	ID             int64  `json:"id" example:"1"`
+	ListID         int64  `json:"list_id,omitempty" example:"1"`
+	OrganizationID int64  `json:"organization_id,omitempty" example:"0"`
+	Name           string `json:"name" example:"Family"`
+	Role           string `json:"role" example:"editor"`
+	ByEmail        bool   `json:"by_email" example:"true"`
+	ExpiresAt      string `json:"expires_at" example:"2025-12-31T23:59:59Z"`
+	Expired        bool   `json:"expired" example:"false"`
+	Accepted       bool   `json:"accepted" example:"false"`
+	CreatedAt      string `json:"created_at" example:"2025-01-01T12:00:00Z"`
+	Token          string `json:"token,omitempty" example:"q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"`
+	URL            string `json:"url,omitempty" example:"/api/invitations/q3Zr0c1n0VvJ8m2Yw5PZ3kq7kYp1XoWlN9sH4tq6d2E"`
+}
+
+// Public view of an invitation, shown before registering or logging in to accept it
+type InvitationPreview struct {
+	Kind      string `json:"kind" example:"list"`
+	Name      string `json:"name" example:"Family"`
+	Role      string `json:"role" example:"editor"`
+	ByEmail   bool   `json:"by_email" example:"true"`
+	ExpiresAt string `json:"expires_at" example:"2025-12-31T23:59:59Z"`
+}
+
+type MilestoneSettings struct {
+	Milestones []int `json:"milestones" example:"18,21,30,40,50"`
+	LeadDays   int   `json:"lead_days" example:"30"`
+}
+
+type ICSSkippedEvent struct {
+	Summary string `json:"summary" example:"Team meeting"`
+	Reason  string `json:"reason" example:"not a yearly recurring event"`
+}
+
+type ICSImportPreview struct {
+	Birthdays []BirthdayNameDateAdd `json:"birthdays"`
+	Skipped   []ICSSkippedEvent     `json:"skipped"`
+}
+
+type ICSImportResult struct {
+	Birthdays []BirthdayFull    `json:"birthdays"`
+	Skipped   []ICSSkippedEvent `json:"skipped"`
 }
 
 type Password struct {
@@ -95,3 +677,16 @@ type UserDataShort struct {
 type Ready struct {
 	Status string `json:"status"`
 }
+
+type JWK struct {
+	KeyType string `json:"kty" example:"OKP"`
+	Curve   string `json:"crv" example:"Ed25519"`
+	X       string `json:"x" example:"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"`
+	KeyID   string `json:"kid" example:"2026-10"`
+	Alg     string `json:"alg" example:"EdDSA"`
+	Use     string `json:"use" example:"sig"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
@@ -8,6 +8,11 @@ import (
 
 // SendTelegramMessage sends a message via the Telegram bot API.
 func SendTelegramMessage(botAPIKey, telegramUserID, message string) {
+	// Accounts created through single sign-on have no bot until the user sets it up
+	if botAPIKey == "" {
+		return
+	}
+
 	// Create a new Telegram bot instance using the provided API key
 	bot, err := tgbotapi.NewBotAPI(botAPIKey)
 	if err != nil {